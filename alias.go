@@ -0,0 +1,67 @@
+package wasmexec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mlctrez/wasmexec/gover"
+)
+
+// Aliases accepted by Resolve and Store lookups in place of a tag.
+const (
+	// AliasLatest names the newest tag, including betas and release candidates.
+	AliasLatest = "latest"
+	// AliasStable names the newest release tag.
+	AliasStable = "stable"
+)
+
+// Resolve returns the tag in the embedded table named by version, which is either a tag
+// or an alias: "latest", "stable", a language version such as go1.22 for its newest
+// patch release, or a patch wildcard such as go1.21.x.
+func Resolve(version string) (tag string, err error) {
+	version = strings.TrimSpace(version)
+	if TagToSha(version) != "" {
+		return version, nil
+	}
+	if tag, ok := resolveAlias(version); ok {
+		return tag, nil
+	}
+	return "", fmt.Errorf("%w %q", ErrUnknownVersion, version)
+}
+
+// resolveAlias returns the tag an alias names. Tags are not aliases.
+func resolveAlias(alias string) (tag string, ok bool) {
+	known := knownVersions()
+	switch alias {
+	case AliasLatest:
+		if len(known) > 0 {
+			return known[len(known)-1].Tag(), true
+		}
+		return "", false
+	case AliasStable:
+		return newest(known, func(v gover.Version) bool { return v.Kind == gover.Release })
+	}
+
+	lang := strings.TrimSuffix(alias, ".x")
+	parsed, err := gover.Parse(lang)
+	if err != nil || len(parsed.Experiments) > 0 {
+		return "", false
+	}
+	// go1.20 parses as a release, it is only an alias when written go1.20.x
+	if parsed.Kind != gover.Lang && (lang == alias || parsed.Kind != gover.Release || parsed.Lang() != lang) {
+		return "", false
+	}
+	return newest(known, func(v gover.Version) bool {
+		return v.Kind == gover.Release && v.Major == parsed.Major && v.Minor == parsed.Minor
+	})
+}
+
+// newest returns the tag of the last version in ascending versions that matches.
+func newest(versions []gover.Version, match func(gover.Version) bool) (tag string, ok bool) {
+	for i := len(versions) - 1; i >= 0; i-- {
+		if match(versions[i]) {
+			return versions[i].Tag(), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,64 @@
+package wasmexec
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		version string
+		tag     string
+	}{
+		{"go1.21.5", "go1.21.5"},
+		{"go1.20", "go1.20"},
+		{"go1.20.x", "go1.20.14"},
+		{"go1.21", "go1.21.13"},
+		{"go1.21.x", "go1.21.13"},
+		{" go1.22 ", "go1.22.12"},
+		{"go1.21.5.x", ""},
+		{"go1.99", ""},
+		{"go1.21 X:boringcrypto", ""},
+		{"unstable", ""},
+	}
+	for _, test := range tests {
+		tag, err := Resolve(test.version)
+		if test.tag == "" {
+			if !errors.Is(err, ErrUnknownVersion) {
+				t.Errorf("%q: expected %v, got %q %v", test.version, ErrUnknownVersion, tag, err)
+			}
+			continue
+		}
+		if err != nil || tag != test.tag {
+			t.Errorf("%q: expected %s, got %q %v", test.version, test.tag, tag, err)
+		}
+	}
+}
+
+func TestResolveLatestAndStable(t *testing.T) {
+	known := knownVersions()
+	latest, err := Resolve(AliasLatest)
+	if err != nil || latest != known[len(known)-1].Tag() {
+		t.Fatalf("unexpected latest %q %v", latest, err)
+	}
+	stable, err := Resolve(AliasStable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range known {
+		if !v.Prerelease() && v.Tag() == stable {
+			return
+		}
+	}
+	t.Fatalf("stable %q is not a release", stable)
+}
+
+func TestStoreResolvesAliases(t *testing.T) {
+	_, info, err := NewStore().VersionInfo("go1.21.x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Version != "go1.21.13" || info.Requested != "go1.21.x" || info.Sha != TagToSha("go1.21.13") {
+		t.Fatalf("unexpected info %+v", info)
+	}
+}
@@ -0,0 +1,27 @@
+package wasmexec
+
+import (
+	"sync"
+
+	"github.com/mlctrez/wasmexec/internal/codec"
+)
+
+var (
+	// ErrCorruptArchive is returned when the embedded archive is truncated or malformed.
+	ErrCorruptArchive = codec.ErrCorruptArchive
+	// ErrUnsupportedVersion is returned when the embedded archive was written with an unknown format version.
+	ErrUnsupportedVersion = codec.ErrUnsupportedVersion
+	// ErrShaMismatch is returned when an archive entry does not decompress to the content its sha describes.
+	ErrShaMismatch = codec.ErrShaMismatch
+)
+
+var embeddedOnce sync.Once
+var embedded *codec.Decoder
+var embeddedErr error
+
+func embeddedDecoder() (*codec.Decoder, error) {
+	embeddedOnce.Do(func() {
+		embedded, embeddedErr = codec.NewDecoder(compressed)
+	})
+	return embedded, embeddedErr
+}
@@ -0,0 +1,36 @@
+package wasmexec
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mlctrez/wasmexec/internal/codec"
+)
+
+func FuzzEmbeddedArchive(f *testing.F) {
+	f.Add(compressed)
+	f.Add(compressed[:codec.HeaderSize])
+	// corrupt the header, the offset and size of the first index entry, and the last entry
+	for _, offset := range []int{4, 9, codec.HeaderSize - 1, codec.HeaderSize + 35, codec.HeaderSize + 40, len(compressed) - 1} {
+		data := make([]byte, len(compressed))
+		copy(data, compressed)
+		data[offset] ^= 0xff
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		decoder, err := codec.NewDecoder(data)
+		if err != nil {
+			if !errors.Is(err, ErrCorruptArchive) && !errors.Is(err, ErrUnsupportedVersion) {
+				t.Fatalf("unexpected error type %v", err)
+			}
+			return
+		}
+		for _, sha := range decoder.Shas() {
+			_, err = decoder.Entry(sha)
+			if err != nil && !errors.Is(err, ErrCorruptArchive) && !errors.Is(err, ErrShaMismatch) {
+				t.Fatalf("unexpected error type %v", err)
+			}
+		}
+	})
+}
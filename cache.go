@@ -0,0 +1,63 @@
+package wasmexec
+
+import "sync/atomic"
+
+// CachePolicy controls which contents a Store keeps in memory.
+type CachePolicy struct {
+	// MaxEntries bounds the number of versions kept, evicting the least recently used.
+	// Zero keeps every version.
+	MaxEntries int
+	// Disabled turns caching off so every lookup goes to the sources.
+	Disabled bool
+}
+
+type cacheEntry struct {
+	// used is the clock value of the last get, updated atomically so gets can share a read lock.
+	// It comes first because 64-bit atomics need 64-bit alignment on 386, arm and 32-bit mips,
+	// which sync/atomic only guarantees for the first word of an allocated struct.
+	used     int64
+	version  string
+	contents []byte
+	info     Info
+}
+
+// contentCache is a least recently used cache of contents by version. Calls to get may run
+// concurrently with each other, put and flush need exclusive access.
+type contentCache struct {
+	// clock comes first for the alignment of its atomic updates, see cacheEntry.used.
+	clock   int64
+	policy  CachePolicy
+	entries map[string]*cacheEntry
+}
+
+func newContentCache(policy CachePolicy) *contentCache {
+	return &contentCache{policy: policy, entries: map[string]*cacheEntry{}}
+}
+
+func (c *contentCache) get(version string) (entry *cacheEntry, ok bool) {
+	if entry, ok = c.entries[version]; ok {
+		atomic.StoreInt64(&entry.used, atomic.AddInt64(&c.clock, 1))
+	}
+	return entry, ok
+}
+
+func (c *contentCache) put(entry *cacheEntry) {
+	if c.policy.Disabled {
+		return
+	}
+	entry.used = atomic.AddInt64(&c.clock, 1)
+	c.entries[entry.version] = entry
+	if c.policy.MaxEntries > 0 && len(c.entries) > c.policy.MaxEntries {
+		var oldest *cacheEntry
+		for _, candidate := range c.entries {
+			if oldest == nil || atomic.LoadInt64(&candidate.used) < atomic.LoadInt64(&oldest.used) {
+				oldest = candidate
+			}
+		}
+		delete(c.entries, oldest.version)
+	}
+}
+
+func (c *contentCache) flush() {
+	c.entries = map[string]*cacheEntry{}
+}
@@ -0,0 +1,97 @@
+package wasmexec
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/mlctrez/wasmexec/gover"
+)
+
+// TagList is a list of tags in ascending version order.
+type TagList []string
+
+// First returns the oldest tag in the list.
+func (t TagList) First() string {
+	if len(t) == 0 {
+		return ""
+	}
+	return t[0]
+}
+
+// Last returns the newest tag in the list.
+func (t TagList) Last() string {
+	if len(t) == 0 {
+		return ""
+	}
+	return t[len(t)-1]
+}
+
+// Class is one distinct wasm_exec.js in the embedded table and the tags that share it.
+type Class struct {
+	Sha  string
+	Size int
+	Tags TagList
+}
+
+// Classes returns every distinct wasm_exec.js in the embedded table, ordered by the first tag of each.
+func Classes() (classes []Class, err error) {
+	decoder, err := embeddedDecoder()
+	if err != nil {
+		return nil, err
+	}
+	byFirstTag := map[string]Class{}
+	for sha, tags := range shaTags() {
+		var shaBytes [32]byte
+		if _, err = hex.Decode(shaBytes[:], []byte(sha)); err != nil {
+			return nil, err
+		}
+		size, ok := decoder.Size(shaBytes)
+		if !ok {
+			return nil, fmt.Errorf("%w: no entry for sha %s", ErrCorruptArchive, sha)
+		}
+		byFirstTag[tags.First()] = Class{Sha: sha, Size: size, Tags: append(TagList(nil), tags...)}
+	}
+	for _, version := range knownVersions() {
+		if class, ok := byFirstTag[version.Tag()]; ok {
+			classes = append(classes, class)
+		}
+	}
+	return classes, nil
+}
+
+var shaTagsOnce sync.Once
+var shaTagList map[string]TagList
+
+// shaTags returns the tags in tagToShaMap grouped by sha.
+func shaTags() map[string]TagList {
+	shaTagsOnce.Do(func() {
+		shaTagList = map[string]TagList{}
+		for _, version := range knownVersions() {
+			tag := version.Tag()
+			shaTagList[tagToShaMap[tag]] = append(shaTagList[tagToShaMap[tag]], tag)
+		}
+	})
+	return shaTagList
+}
+
+// tagsFor returns a copy of the tags in the embedded table whose content has sha.
+func tagsFor(sha string) TagList {
+	if tags, ok := shaTags()[sha]; ok {
+		return append(TagList(nil), tags...)
+	}
+	return nil
+}
+
+// wasmExecPath returns where the toolchain for version keeps wasm_exec.js,
+// lib/wasm from go1.24 and misc/wasm before.
+func wasmExecPath(version string) string {
+	parsed, err := gover.Parse(version)
+	if err != nil {
+		return ""
+	}
+	if parsed.Major > 1 || parsed.Minor >= 24 {
+		return wasmExecPaths[0]
+	}
+	return wasmExecPaths[1]
+}
@@ -0,0 +1,56 @@
+package wasmexec
+
+import "testing"
+
+func TestClasses(t *testing.T) {
+	classes, err := Classes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags := 0
+	for i, class := range classes {
+		tags += len(class.Tags)
+		content, err := Version(class.Tags.First())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if shaString(content) != class.Sha || len(content) != class.Size {
+			t.Errorf("%s: class %s size %d does not match content", class.Tags.First(), class.Sha, class.Size)
+		}
+		for _, tag := range class.Tags {
+			if TagToSha(tag) != class.Sha {
+				t.Errorf("%s: sha %s is not %s", tag, TagToSha(tag), class.Sha)
+			}
+		}
+		if i > 0 && classes[i-1].Tags.First() == class.Tags.First() {
+			t.Errorf("duplicate class for %s", class.Tags.First())
+		}
+	}
+	if tags != len(tagToShaMap) {
+		t.Fatalf("classes hold %d tags, expected %d", tags, len(tagToShaMap))
+	}
+}
+
+func TestLookup(t *testing.T) {
+	info, err := Lookup("go1.21.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Sha != TagToSha("go1.21.5") || info.Size == 0 || info.Path != "misc/wasm/wasm_exec.js" {
+		t.Fatalf("unexpected info %+v", info)
+	}
+	found := false
+	for _, tag := range info.Tags {
+		found = found || tag == "go1.21.5"
+		if TagToSha(tag) != info.Sha {
+			t.Errorf("%s does not share the content of go1.21.5", tag)
+		}
+	}
+	if !found || info.Tags.First() == "" || info.Tags.Last() == "" {
+		t.Fatalf("unexpected tags %v", info.Tags)
+	}
+
+	if info, err = Lookup("go1.24.0"); err != nil || info.Path != "lib/wasm/wasm_exec.js" {
+		t.Fatalf("unexpected info %+v %v", info, err)
+	}
+}
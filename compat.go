@@ -0,0 +1,180 @@
+package wasmexec
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mlctrez/wasmexec/wasmbin"
+)
+
+// ErrIncompatible is matched by the *CompatibilityError returned from CheckCompatibility.
+var ErrIncompatible = errors.New("wasmexec: wasm binary is incompatible with wasm_exec.js")
+
+// CompatibilityError describes the imports and exports that do not line up between
+// a wasm binary and the wasm_exec.js of a Go version.
+type CompatibilityError struct {
+	Version string
+	// Provided lists the import modules wasm_exec.js supplies in its importObject.
+	Provided []string
+	// MissingImports are Go runtime imports of the wasm binary that wasm_exec.js does not supply.
+	MissingImports []wasmbin.Import
+	// MissingExports are exports wasm_exec.js calls that the wasm binary does not export.
+	MissingExports []string
+}
+
+func (e *CompatibilityError) Error() string {
+	var reasons []string
+	if len(e.MissingImports) > 0 {
+		modules := map[string]bool{}
+		for _, imp := range e.MissingImports {
+			modules[imp.Module] = true
+		}
+		for module := range modules {
+			if !contains(e.Provided, module) {
+				reasons = append(reasons, fmt.Sprintf("wasm binary imports module %q but wasm_exec.js %s provides %s",
+					module, e.Version, strings.Join(quoteAll(e.Provided), ", ")))
+			}
+		}
+		if len(reasons) == 0 {
+			reasons = append(reasons, fmt.Sprintf("wasm_exec.js %s does not provide %d imports, first %s",
+				e.Version, len(e.MissingImports), e.MissingImports[0]))
+		}
+	}
+	if len(e.MissingExports) > 0 {
+		reasons = append(reasons, fmt.Sprintf("wasm binary does not export %s used by wasm_exec.js %s",
+			strings.Join(quoteAll(e.MissingExports), ", "), e.Version))
+	}
+	sort.Strings(reasons)
+	return fmt.Sprintf("%v: %s", ErrIncompatible, strings.Join(reasons, "; "))
+}
+
+func (e *CompatibilityError) Is(target error) bool {
+	return target == ErrIncompatible
+}
+
+// goImportModules are the import modules used by the Go js/wasm runtime.
+// Go 1.21 renamed the runtime module from go to gojs.
+var goImportModules = []string{"go", "gojs", "_gotest"}
+
+var importObjectPattern = regexp.MustCompile(`^(\s*)this\.importObject = \{\s*$`)
+var importModulePattern = regexp.MustCompile(`^\s*([A-Za-z_$][\w$]*): \{\s*$`)
+var importFunctionPattern = regexp.MustCompile(`^\s*"?([\w$./]+)"?: \(`)
+var exportPattern = regexp.MustCompile(`_inst\.exports\.([A-Za-z_$][\w$]*)`)
+
+// CheckCompatibility compares the imports and exports of the wasm binary with what the
+// wasm_exec.js of version provides and uses. A mismatch is returned as a *CompatibilityError.
+//
+// Imports from modules other than the Go runtime modules, such as //go:wasmimport functions,
+// are not checked since they are supplied by the page.
+func CheckCompatibility(wasm io.ReaderAt, version string) (err error) {
+	var module *wasmbin.Module
+	if module, err = wasmbin.Open(wasm); err != nil {
+		return err
+	}
+
+	var imports []wasmbin.Import
+	if imports, err = module.Imports(); err != nil {
+		return err
+	}
+	var exports []wasmbin.Export
+	if exports, err = module.Exports(); err != nil {
+		return err
+	}
+
+	var content []byte
+	if content, err = Version(version); err != nil {
+		return err
+	}
+	provided, used := scanWasmExec(content)
+
+	result := &CompatibilityError{Version: version}
+	for module := range provided {
+		result.Provided = append(result.Provided, module)
+	}
+	sort.Strings(result.Provided)
+
+	for _, imp := range imports {
+		if !contains(goImportModules, imp.Module) {
+			continue
+		}
+		if !provided[imp.Module][imp.Name] {
+			result.MissingImports = append(result.MissingImports, imp)
+		}
+	}
+
+	exported := map[string]bool{}
+	for _, export := range exports {
+		exported[export.Name] = true
+	}
+	for _, name := range used {
+		if !exported[name] {
+			result.MissingExports = append(result.MissingExports, name)
+		}
+	}
+
+	if len(result.MissingImports) > 0 || len(result.MissingExports) > 0 {
+		return result
+	}
+	return nil
+}
+
+// scanWasmExec returns the functions wasm_exec.js provides in its importObject by module,
+// and the instance exports it uses.
+func scanWasmExec(content []byte) (provided map[string]map[string]bool, used []string) {
+	provided = map[string]map[string]bool{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	var indent, module string
+	inImportObject := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if match := importObjectPattern.FindStringSubmatch(line); match != nil {
+			indent, inImportObject = match[1], true
+			continue
+		}
+		if !inImportObject {
+			continue
+		}
+		if strings.HasPrefix(line, indent+"}") {
+			inImportObject = false
+		} else if match := importModulePattern.FindStringSubmatch(line); match != nil {
+			module = match[1]
+			provided[module] = map[string]bool{}
+		} else if match = importFunctionPattern.FindStringSubmatch(line); match != nil && module != "" {
+			provided[module][match[1]] = true
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, match := range exportPattern.FindAllSubmatch(content, -1) {
+		// testExport functions are only called through the _gotest imports of Go's own tests
+		if name := string(match[1]); !seen[name] && !strings.HasPrefix(name, "testExport") {
+			seen[name] = true
+			used = append(used, name)
+		}
+	}
+	sort.Strings(used)
+	return provided, used
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteAll(values []string) (quoted []string) {
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	return quoted
+}
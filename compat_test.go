@@ -0,0 +1,51 @@
+package wasmexec
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mlctrez/wasmexec/internal/wasmtest"
+)
+
+// runtimeModule returns a wasm module importing functions from the go runtime module
+// and exporting what wasm_exec.js calls.
+func runtimeModule(importModule string, exports ...string) []byte {
+	imports := [][]byte{wasmtest.U32(2)}
+	for _, name := range []string{"runtime.wasmExit", "syscall/js.valueGet"} {
+		imports = append(imports, wasmtest.Name(importModule), wasmtest.Name(name), []byte{0x00, 0x00})
+	}
+	exportSection := [][]byte{wasmtest.U32(uint32(len(exports)))}
+	for i, name := range exports {
+		exportSection = append(exportSection, wasmtest.Name(name), []byte{0x00}, wasmtest.U32(uint32(i)))
+	}
+	return wasmtest.Module(wasmtest.Section(2, imports...), wasmtest.Section(7, exportSection...))
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	exports := []string{"run", "resume", "getsp", "mem"}
+	if err := CheckCompatibility(bytes.NewReader(runtimeModule("go", exports...)), "go1.20"); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckCompatibility(bytes.NewReader(runtimeModule("gojs", exports...)), "go1.21.0"); err != nil {
+		t.Fatal(err)
+	}
+
+	err := CheckCompatibility(bytes.NewReader(runtimeModule("go", exports...)), "go1.21.0")
+	if !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("expected %v, got %v", ErrIncompatible, err)
+	}
+	var compatibilityError *CompatibilityError
+	if !errors.As(err, &compatibilityError) || len(compatibilityError.MissingImports) != 2 {
+		t.Fatalf("expected two missing imports, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `imports module "go" but wasm_exec.js go1.21.0 provides "_gotest", "gojs"`) {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+
+	err = CheckCompatibility(bytes.NewReader(runtimeModule("go", "run", "mem")), "go1.20")
+	if !errors.As(err, &compatibilityError) || strings.Join(compatibilityError.MissingExports, ",") != "getsp,resume" {
+		t.Fatalf("expected missing exports, got %v", err)
+	}
+}
@@ -1,95 +1,33 @@
 package wasmexec
 
 import (
-	"bytes"
-	"compress/zlib"
 	"crypto/sha256"
-	"encoding/binary"
+	"encoding/hex"
 	"fmt"
-	"io"
-	"runtime"
-	"sync"
-)
-
-var mu = &sync.Mutex{}
-var cached map[string][]byte
-
-func Current() (content []byte, err error) {
-	return Version(runtime.Version())
-}
-
-func Version(version string) (contents []byte, err error) {
-	mu.Lock()
-	defer mu.Unlock()
-
-	if cached == nil {
-		cached = make(map[string][]byte)
-	}
-
-	var data []byte
-	var ok bool
-	if data, ok = cached[version]; ok {
-		return data, nil
-	}
 
-	if data, err = readContents(version); err != nil {
-		return nil, err
-	}
-	cached[version] = data
-	return data, nil
-}
+	"github.com/mlctrez/wasmexec/internal/codec"
+)
 
 func readContents(version string) (contents []byte, err error) {
 	wantedSha := TagToSha(version)
 	if wantedSha == "" {
-		return nil, fmt.Errorf("unsupported version %q", version)
+		return nil, fmt.Errorf("%w %q", ErrUnknownVersion, version)
 	}
+	return readSha(wantedSha)
+}
 
-	var total uint32
-	var sha = make([]byte, 32)
-	var length int64
-	var read int
-
-	var reader io.ReadCloser
-	reader, err = zlib.NewReader(bytes.NewBuffer(compressed))
-
-	if err = binary.Read(reader, binary.BigEndian, &total); err != nil {
+// readSha returns the embedded content with the hex encoded wantedSha.
+func readSha(wantedSha string) (contents []byte, err error) {
+	var sha [32]byte
+	if _, err = hex.Decode(sha[:], []byte(wantedSha)); err != nil {
 		return nil, err
 	}
 
-	var ti uint32
-	for ti = 0; ti < total; ti++ {
-		if read, err = reader.Read(sha); err != nil {
-			return nil, err
-		}
-		if read != 32 {
-			return nil, fmt.Errorf("unable to read full sha")
-		}
-		if err = binary.Read(reader, binary.BigEndian, &length); err != nil {
-			return nil, err
-		}
-
-		contents = make([]byte, length)
-		if read, err = io.ReadFull(reader, contents); err != nil {
-			return nil, err
-		}
-		if read != int(length) {
-			return nil, fmt.Errorf("unable to read full content, expected %d but read only %d", length, read)
-		}
-
-		if wantedSha == shaString(contents) {
-			return contents, nil
-		}
-
-	}
-
-	read, err = reader.Read([]byte{0})
-	if read != 0 || err != io.EOF {
-		return nil, fmt.Errorf("data did not end conrrectly")
+	var decoder *codec.Decoder
+	if decoder, err = embeddedDecoder(); err != nil {
+		return nil, err
 	}
-
-	return nil, fmt.Errorf("unable to match sha %q", wantedSha)
-
+	return decoder.Entry(sha)
 }
 
 func shaString(contents []byte) (sum string) {
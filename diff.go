@@ -0,0 +1,34 @@
+package wasmexec
+
+import "github.com/mlctrez/wasmexec/internal/diff"
+
+// Diff returns the unified diff from the wasm_exec.js of fromVersion to that of toVersion.
+// The diff is empty when both versions have the same content.
+func Diff(fromVersion, toVersion string) (string, error) {
+	return defaultStore.Diff(fromVersion, toVersion)
+}
+
+// Diff returns the unified diff from the wasm_exec.js of fromVersion to that of toVersion.
+// The diff is empty when both versions have the same content.
+func (s *Store) Diff(fromVersion, toVersion string) (string, error) {
+	from, fromInfo, err := s.VersionInfo(fromVersion)
+	if err != nil {
+		return "", err
+	}
+	to, toInfo, err := s.VersionInfo(toVersion)
+	if err != nil {
+		return "", err
+	}
+	if fromInfo.Sha == toInfo.Sha {
+		return "", nil
+	}
+	return diff.Unified(diffName(fromInfo), diffName(toInfo), from, to), nil
+}
+
+// diffName labels a side of a diff with the version and the path of wasm_exec.js in its toolchain.
+func diffName(info Info) string {
+	if info.Path == "" {
+		return info.Version + "/wasm_exec.js"
+	}
+	return info.Version + "/" + info.Path
+}
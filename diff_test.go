@@ -0,0 +1,34 @@
+package wasmexec
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	classes, err := Classes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var class Class
+	for _, class = range classes {
+		if len(class.Tags) > 1 {
+			break
+		}
+	}
+	if unified, err := Diff(class.Tags.First(), class.Tags.Last()); err != nil || unified != "" {
+		t.Fatalf("expected an empty diff between %s and %s, got %q %v", class.Tags.First(), class.Tags.Last(), unified, err)
+	}
+
+	unified, err := Diff("go1.23.0", "go1.24.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(unified, "--- go1.23.0/misc/wasm/wasm_exec.js\n+++ go1.24.0/lib/wasm/wasm_exec.js\n@@ ") {
+		t.Fatalf("unexpected diff header %q", unified[:100])
+	}
+
+	if _, err = Diff("go1.23.0", "go0.1"); err == nil {
+		t.Fatal("expected error for unknown version")
+	}
+}
@@ -0,0 +1,123 @@
+package wasmexec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// encodings supported by a Handler in order of preference.
+var encodings = []string{"gzip", "deflate"}
+
+// encodedContent holds the compressed encodings of one content, built on first use.
+type encodedContent struct {
+	once    sync.Once
+	encoded map[string][]byte
+	err     error
+}
+
+// encodingCache keeps the encodings of every content a Handler served by sha.
+type encodingCache struct {
+	mu      sync.Mutex
+	entries map[string]*encodedContent
+}
+
+// encoded returns contents in encoding, compressing them once per sha.
+func (c *encodingCache) encoded(sha, encoding string, contents []byte) ([]byte, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = map[string]*encodedContent{}
+	}
+	entry, ok := c.entries[sha]
+	if !ok {
+		entry = &encodedContent{}
+		c.entries[sha] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.encoded = map[string][]byte{}
+		for _, name := range encodings {
+			if entry.encoded[name], entry.err = compress(name, contents); entry.err != nil {
+				return
+			}
+		}
+	})
+	return entry.encoded[encoding], entry.err
+}
+
+func compress(encoding string, contents []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	var writer io.WriteCloser
+	var err error
+	switch encoding {
+	case "gzip":
+		writer, err = gzip.NewWriterLevel(buf, gzip.BestCompression)
+	default:
+		// the deflate content coding is the zlib format
+		writer, err = zlib.NewWriterLevel(buf, zlib.BestCompression)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err = writer.Write(contents); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// negotiateEncoding returns the supported encoding the Accept-Encoding header prefers,
+// or an empty string for the identity encoding. A * entry only applies to encodings the
+// header does not name, and encodings with a quality of 0 are never chosen.
+func negotiateEncoding(acceptEncoding string) string {
+	type candidate struct {
+		name    string
+		quality float64
+	}
+	var candidates []candidate
+	listed := map[string]bool{}
+	wildcard := -1.0
+	for _, accepted := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(accepted, ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if key, value, ok := strings.Cut(strings.TrimSpace(param), "="); ok && strings.ToLower(key) == "q" {
+				if q, err := strconv.ParseFloat(value, 64); err == nil {
+					quality = q
+				}
+			}
+		}
+		if name == "*" {
+			wildcard = quality
+			continue
+		}
+		listed[name] = true
+		candidates = append(candidates, candidate{name, quality})
+	}
+	if wildcard >= 0 {
+		for _, encoding := range encodings {
+			if !listed[encoding] {
+				candidates = append(candidates, candidate{encoding, wildcard})
+			}
+		}
+	}
+
+	best, bestQuality := "", 0.0
+	for _, c := range candidates {
+		for _, encoding := range encodings {
+			// the encoding listed first wins a tie
+			if c.name == encoding && c.quality > bestQuality {
+				best, bestQuality = encoding, c.quality
+			}
+		}
+	}
+	return best
+}
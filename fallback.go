@@ -0,0 +1,71 @@
+package wasmexec
+
+import (
+	"sync"
+
+	"github.com/mlctrez/wasmexec/gover"
+)
+
+// FallbackPolicy decides which known version a Store serves for a version no source has.
+type FallbackPolicy int
+
+const (
+	// FallbackExact serves only the version requested.
+	FallbackExact FallbackPolicy = iota
+	// FallbackSameMinor serves the newest known version of the same minor release
+	// at or below the version requested, such as go1.27.0 for go1.27.1.
+	FallbackSameMinor
+	// FallbackLatestBelow serves the newest known version at or below the version requested.
+	FallbackLatestBelow
+)
+
+func (p FallbackPolicy) String() string {
+	switch p {
+	case FallbackExact:
+		return "exact"
+	case FallbackSameMinor:
+		return "same minor"
+	case FallbackLatestBelow:
+		return "latest below"
+	}
+	return "unknown"
+}
+
+// version returns the known version the policy serves for requested.
+func (p FallbackPolicy) version(requested string) (tag string, ok bool) {
+	if p == FallbackExact {
+		return "", false
+	}
+	parsed, err := gover.Parse(requested)
+	if err != nil {
+		return "", false
+	}
+	known := knownVersions()
+	for i := len(known) - 1; i >= 0; i-- {
+		candidate := known[i]
+		if candidate.Compare(parsed) > 0 {
+			continue
+		}
+		if p == FallbackSameMinor && (candidate.Major != parsed.Major || candidate.Minor != parsed.Minor) {
+			return "", false
+		}
+		return candidate.Tag(), true
+	}
+	return "", false
+}
+
+var knownOnce sync.Once
+var known []gover.Version
+
+// knownVersions returns the versions in tagToShaMap in ascending order.
+func knownVersions() []gover.Version {
+	knownOnce.Do(func() {
+		for tag := range tagToShaMap {
+			if v, err := gover.Parse(tag); err == nil {
+				known = append(known, v)
+			}
+		}
+		gover.Sort(known)
+	})
+	return known
+}
@@ -0,0 +1,236 @@
+package wasmexec
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"sync"
+	"time"
+)
+
+// shaDir is the directory of the FS holding each distinct content by sha.
+const shaDir = "sha"
+
+// FS returns a read only file system of the embedded wasm_exec.js contents. It holds
+// <tag>/wasm_exec.js for every tag and sha/<sha256>.js for every distinct content.
+// Contents are decompressed when a file is first read.
+func FS() fs.FS {
+	return archiveFS{}
+}
+
+type archiveFS struct{}
+
+var fsIndexOnce sync.Once
+var fsIndex map[string]*archiveEntry
+var fsIndexErr error
+
+// archiveIndex returns the entry for every path of the FS.
+func archiveIndex() (map[string]*archiveEntry, error) {
+	fsIndexOnce.Do(func() {
+		var classes []Class
+		if classes, fsIndexErr = Classes(); fsIndexErr != nil {
+			return
+		}
+		root := &archiveEntry{name: ".", dir: true}
+		shas := &archiveEntry{name: shaDir, dir: true}
+		fsIndex = map[string]*archiveEntry{".": root, shaDir: shas}
+		root.children = append(root.children, shas)
+		for _, class := range classes {
+			file := &archiveEntry{name: class.Sha + ".js", size: int64(class.Size), sha: class.Sha}
+			fsIndex[path.Join(shaDir, file.name)] = file
+			shas.children = append(shas.children, file)
+			for _, tag := range class.Tags {
+				file := &archiveEntry{name: "wasm_exec.js", size: int64(class.Size), sha: class.Sha}
+				dir := &archiveEntry{name: tag, dir: true, children: []*archiveEntry{file}}
+				fsIndex[tag] = dir
+				fsIndex[path.Join(tag, file.name)] = file
+				root.children = append(root.children, dir)
+			}
+		}
+		for _, entry := range fsIndex {
+			sort.Slice(entry.children, func(i, j int) bool { return entry.children[i].name < entry.children[j].name })
+		}
+	})
+	return fsIndex, fsIndexErr
+}
+
+func (archiveFS) lookup(op, name string) (*archiveEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	index, err := archiveIndex()
+	if err != nil {
+		return nil, &fs.PathError{Op: op, Path: name, Err: err}
+	}
+	entry, ok := index[name]
+	if !ok {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+	}
+	return entry, nil
+}
+
+func (f archiveFS) Open(name string) (fs.File, error) {
+	entry, err := f.lookup("open", name)
+	if err != nil {
+		return nil, err
+	}
+	if entry.dir {
+		return &archiveDir{archiveEntry: entry, path: name}, nil
+	}
+	return &archiveFile{archiveEntry: entry, path: name}, nil
+}
+
+func (f archiveFS) Stat(name string) (fs.FileInfo, error) {
+	return f.lookup("stat", name)
+}
+
+func (f archiveFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	entry, err := f.lookup("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.dir {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: errNotDir}
+	}
+	return dirEntries(entry.children), nil
+}
+
+func (f archiveFS) ReadFile(name string) ([]byte, error) {
+	entry, err := f.lookup("read", name)
+	if err != nil {
+		return nil, err
+	}
+	if entry.dir {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: errIsDir}
+	}
+	contents, err := readSha(entry.sha)
+	if err != nil {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: err}
+	}
+	return contents, nil
+}
+
+var errNotDir = errors.New("not a directory")
+var errIsDir = errors.New("is a directory")
+
+// archiveEntry is a file or directory of the FS, it is both its fs.FileInfo and fs.DirEntry.
+type archiveEntry struct {
+	name     string
+	dir      bool
+	size     int64
+	sha      string
+	children []*archiveEntry
+}
+
+func (e *archiveEntry) Name() string               { return e.name }
+func (e *archiveEntry) Size() int64                { return e.size }
+func (e *archiveEntry) ModTime() time.Time         { return time.Time{} }
+func (e *archiveEntry) IsDir() bool                { return e.dir }
+func (e *archiveEntry) Sys() any                   { return nil }
+func (e *archiveEntry) Type() fs.FileMode          { return e.Mode().Type() }
+func (e *archiveEntry) Info() (fs.FileInfo, error) { return e, nil }
+
+func (e *archiveEntry) Mode() fs.FileMode {
+	if e.dir {
+		return fs.ModeDir | 0555
+	}
+	return 0444
+}
+
+func dirEntries(children []*archiveEntry) []fs.DirEntry {
+	entries := make([]fs.DirEntry, len(children))
+	for i, child := range children {
+		entries[i] = child
+	}
+	return entries
+}
+
+// archiveFile is an open file of the FS. Its content is decompressed on the first read.
+type archiveFile struct {
+	*archiveEntry
+	path   string
+	reader *bytes.Reader
+	closed bool
+}
+
+func (f *archiveFile) open(op string) error {
+	if f.closed {
+		return &fs.PathError{Op: op, Path: f.path, Err: fs.ErrClosed}
+	}
+	if f.reader == nil {
+		contents, err := readSha(f.sha)
+		if err != nil {
+			return &fs.PathError{Op: op, Path: f.path, Err: err}
+		}
+		f.reader = bytes.NewReader(contents)
+	}
+	return nil
+}
+
+func (f *archiveFile) Read(p []byte) (int, error) {
+	if err := f.open("read"); err != nil {
+		return 0, err
+	}
+	return f.reader.Read(p)
+}
+
+func (f *archiveFile) ReadAt(p []byte, off int64) (int, error) {
+	if err := f.open("read"); err != nil {
+		return 0, err
+	}
+	return f.reader.ReadAt(p, off)
+}
+
+func (f *archiveFile) Seek(offset int64, whence int) (int64, error) {
+	if err := f.open("seek"); err != nil {
+		return 0, err
+	}
+	return f.reader.Seek(offset, whence)
+}
+
+func (f *archiveFile) Stat() (fs.FileInfo, error) { return f.archiveEntry, nil }
+
+func (f *archiveFile) Close() error {
+	if f.closed {
+		return &fs.PathError{Op: "close", Path: f.path, Err: fs.ErrClosed}
+	}
+	f.closed = true
+	return nil
+}
+
+// archiveDir is an open directory of the FS.
+type archiveDir struct {
+	*archiveEntry
+	path   string
+	offset int
+}
+
+func (d *archiveDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.path, Err: errIsDir}
+}
+
+func (d *archiveDir) Stat() (fs.FileInfo, error) { return d.archiveEntry, nil }
+
+func (d *archiveDir) Close() error { return nil }
+
+// ReadDir returns the next n entries, or all remaining entries when n <= 0.
+func (d *archiveDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	remaining := d.children[d.offset:]
+	if n > 0 && len(remaining) == 0 {
+		return nil, io.EOF
+	}
+	if n > 0 && n < len(remaining) {
+		remaining = remaining[:n]
+	}
+	d.offset += len(remaining)
+	return dirEntries(remaining), nil
+}
+
+var _ interface {
+	fs.ReadDirFS
+	fs.ReadFileFS
+	fs.StatFS
+} = archiveFS{}
@@ -0,0 +1,36 @@
+package wasmexec
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFS(t *testing.T) {
+	fsys := FS()
+	if err := fstest.TestFS(fsys, "go1.21.5/wasm_exec.js", "sha/"+TagToSha("go1.21.5")+".js"); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != len(tagToShaMap)+1 {
+		t.Fatalf("expected a directory per tag and the sha directory, got %d entries", len(entries))
+	}
+
+	contents, err := fs.ReadFile(fsys, "go1.21.5/wasm_exec.js")
+	if err != nil || shaString(contents) != TagToSha("go1.21.5") {
+		t.Fatalf("unexpected content %v", err)
+	}
+	info, err := fs.Stat(fsys, "sha/"+TagToSha("go1.21.5")+".js")
+	if err != nil || info.Size() != int64(len(contents)) {
+		t.Fatalf("unexpected stat %v %v", info, err)
+	}
+
+	if _, err = fs.Stat(fsys, "go0.1/wasm_exec.js"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected %v, got %v", fs.ErrNotExist, err)
+	}
+}
@@ -0,0 +1,207 @@
+// Package gover parses and orders Go toolchain version strings.
+//
+// It understands release tags (go1.20, go1.21.0, go1.21.5), pre-releases (go1.21rc2, go1.18beta1),
+// language versions (go1.22), development builds (devel go1.23-abc123 Tue Feb 6 10:00:00 2024 +0000)
+// and GOEXPERIMENT suffixes (go1.21.0 X:boringcrypto) as reported by runtime.Version.
+package gover
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalid is returned by Parse for strings that are not Go versions.
+var ErrInvalid = errors.New("gover: invalid go version")
+
+// Kind orders the builds within one minor version.
+type Kind int
+
+const (
+	// Lang is a language version such as go1.22, which precedes all builds of go1.22.
+	Lang Kind = iota
+	// Devel is a development build before the first pre-release.
+	Devel
+	Beta
+	RC
+	Release
+)
+
+// firstPatchTagMinor is the first minor version whose initial release is tagged with a .0 patch.
+// Before go1.21 the initial release of go1.N was tagged go1.N.
+const firstPatchTagMinor = 21
+
+// Version is a parsed Go version.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+	Kind  Kind
+	// Pre is the beta or rc number.
+	Pre int
+	// Commit is the commit of a Devel build when known.
+	Commit string
+	// Experiments lists the GOEXPERIMENT settings of the build.
+	Experiments []string
+}
+
+// Parse parses a Go version string.
+func Parse(s string) (v Version, err error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return Version{}, fmt.Errorf("%w %q", ErrInvalid, s)
+	}
+
+	if fields[0] == "devel" {
+		// devel go1.23-abc123 Tue Feb 6 10:00:00 2024 +0000
+		if len(fields) < 2 {
+			return Version{}, fmt.Errorf("%w %q: devel build without a version", ErrInvalid, s)
+		}
+		base, commit, _ := strings.Cut(fields[1], "-")
+		if v, err = parseRelease(base); err != nil || (v.Kind != Release && v.Kind != Lang) || v.Patch != 0 {
+			return Version{}, fmt.Errorf("%w %q: devel build without a version", ErrInvalid, s)
+		}
+		v.Kind, v.Commit = Devel, commit
+		v.Experiments = experiments(fields[2:])
+		return v, nil
+	}
+
+	if v, err = parseRelease(fields[0]); err != nil {
+		return Version{}, fmt.Errorf("%w %q", ErrInvalid, s)
+	}
+	v.Experiments = experiments(fields[1:])
+	return v, nil
+}
+
+// parseRelease parses go1, go1.N, go1.N.P, go1.NbetaB and go1.NrcR.
+func parseRelease(s string) (v Version, err error) {
+	if !strings.HasPrefix(s, "go") {
+		return Version{}, ErrInvalid
+	}
+	s = s[2:]
+
+	v.Kind = Release
+	for _, pre := range []struct {
+		marker string
+		kind   Kind
+	}{{"beta", Beta}, {"rc", RC}} {
+		if index := strings.Index(s, pre.marker); index > 0 {
+			if v.Pre, err = number(s[index+len(pre.marker):]); err != nil || v.Pre == 0 {
+				return Version{}, ErrInvalid
+			}
+			v.Kind, s = pre.kind, s[:index]
+			break
+		}
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 || (v.Kind != Release && len(parts) > 2) {
+		return Version{}, ErrInvalid
+	}
+	values := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		if *values[i], err = number(part); err != nil {
+			return Version{}, ErrInvalid
+		}
+	}
+
+	if v.Kind == Release && len(parts) == 2 && v.Major == 1 && v.Minor >= firstPatchTagMinor {
+		v.Kind = Lang
+	}
+	return v, nil
+}
+
+func number(s string) (int, error) {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return 0, ErrInvalid
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, ErrInvalid
+		}
+	}
+	return strconv.Atoi(s)
+}
+
+func experiments(fields []string) (list []string) {
+	for _, field := range fields {
+		if strings.HasPrefix(field, "X:") {
+			list = append(list, strings.Split(field[2:], ",")...)
+		}
+	}
+	return list
+}
+
+// Compare returns -1, 0 or 1 as v orders before, equal to or after other.
+// Commits and experiments do not take part in the ordering.
+func (v Version) Compare(other Version) int {
+	for _, pair := range [][2]int{
+		{v.Major, other.Major}, {v.Minor, other.Minor}, {int(v.Kind), int(other.Kind)},
+		{v.Pre, other.Pre}, {v.Patch, other.Patch},
+	} {
+		if pair[0] < pair[1] {
+			return -1
+		}
+		if pair[0] > pair[1] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Lang returns the language version of v, such as go1.21 for go1.21.5.
+func (v Version) Lang() string {
+	if v.Minor == 0 && v.Major == 1 {
+		return "go1"
+	}
+	return fmt.Sprintf("go%d.%d", v.Major, v.Minor)
+}
+
+// Prerelease reports whether v is a beta or release candidate.
+func (v Version) Prerelease() bool {
+	return v.Kind == Beta || v.Kind == RC
+}
+
+// Tag returns the git tag of the Go release v, without experiments.
+// Language versions and development builds have no tag and return an empty string.
+func (v Version) Tag() string {
+	switch v.Kind {
+	case Beta:
+		return fmt.Sprintf("%sbeta%d", v.Lang(), v.Pre)
+	case RC:
+		return fmt.Sprintf("%src%d", v.Lang(), v.Pre)
+	case Release:
+		if v.Patch == 0 && (v.Major != 1 || v.Minor < firstPatchTagMinor) {
+			return v.Lang()
+		}
+		return fmt.Sprintf("go%d.%d.%d", v.Major, v.Minor, v.Patch)
+	}
+	return ""
+}
+
+// String returns v in the form reported by runtime.Version.
+func (v Version) String() string {
+	var s string
+	switch v.Kind {
+	case Lang:
+		s = v.Lang()
+	case Devel:
+		s = "devel " + v.Lang()
+		if v.Commit != "" {
+			s += "-" + v.Commit
+		}
+	default:
+		s = v.Tag()
+	}
+	if len(v.Experiments) > 0 {
+		s += " X:" + strings.Join(v.Experiments, ",")
+	}
+	return s
+}
+
+// Sort sorts versions in ascending order.
+func Sort(versions []Version) {
+	sort.SliceStable(versions, func(i, j int) bool { return versions[i].Compare(versions[j]) < 0 })
+}
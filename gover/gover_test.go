@@ -0,0 +1,82 @@
+package gover
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Version
+		tag   string
+	}{
+		{"go1", Version{Major: 1, Kind: Release}, "go1"},
+		{"go1.11", Version{Major: 1, Minor: 11, Kind: Release}, "go1.11"},
+		{"go1.20.14", Version{Major: 1, Minor: 20, Patch: 14, Kind: Release}, "go1.20.14"},
+		{"go1.21.0", Version{Major: 1, Minor: 21, Kind: Release}, "go1.21.0"},
+		{"go1.22", Version{Major: 1, Minor: 22, Kind: Lang}, ""},
+		{"go1.11beta3", Version{Major: 1, Minor: 11, Kind: Beta, Pre: 3}, "go1.11beta3"},
+		{"go1.27rc2", Version{Major: 1, Minor: 27, Kind: RC, Pre: 2}, "go1.27rc2"},
+		{"go1.21.0 X:boringcrypto", Version{Major: 1, Minor: 21, Kind: Release, Experiments: []string{"boringcrypto"}}, "go1.21.0"},
+		{
+			"devel go1.23-abc123 Tue Feb 6 10:00:00 2024 +0000 X:loopvar,rangefunc",
+			Version{Major: 1, Minor: 23, Kind: Devel, Commit: "abc123", Experiments: []string{"loopvar", "rangefunc"}}, "",
+		},
+	}
+	for _, test := range tests {
+		got, err := Parse(test.input)
+		if err != nil {
+			t.Errorf("%s: %v", test.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %+v, got %+v", test.input, test.want, got)
+		}
+		if got.Tag() != test.tag {
+			t.Errorf("%s: expected tag %q, got %q", test.input, test.tag, got.Tag())
+		}
+	}
+
+	for _, input := range []string{"", "1.21", "go", "go1.", "go1.21.x", "go1.21rc", "go1.21.0rc1", "go1.021", "devel +abc123", "gotip"} {
+		if _, err := Parse(input); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%q: expected %v, got %v", input, ErrInvalid, err)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	for _, input := range []string{"go1.20", "go1.21.5", "go1.22", "go1.21rc2", "devel go1.23-abc123", "go1.21.0 X:boringcrypto"} {
+		if got := mustParse(t, input).String(); got != input {
+			t.Errorf("expected %q, got %q", input, got)
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	ordered := []string{
+		"go1", "go1.0.1", "go1.9", "go1.11beta1", "go1.11beta3", "go1.11rc1", "go1.11", "go1.11.1", "go1.11.10",
+		"go1.20rc3", "go1.20", "go1.20.14", "go1.21", "devel go1.21-abc", "go1.21rc2", "go1.21.0", "go1.21.1",
+		"go1.27rc3", "go1.27.0",
+	}
+	var versions []Version
+	for i := len(ordered) - 1; i >= 0; i-- {
+		versions = append(versions, mustParse(t, ordered[i]))
+	}
+	Sort(versions)
+	for i, v := range versions {
+		if v.String() != ordered[i] {
+			t.Fatalf("position %d: expected %s, got %s", i, ordered[i], v)
+		}
+	}
+}
+
+func mustParse(t *testing.T, s string) Version {
+	t.Helper()
+	v, err := Parse(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
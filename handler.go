@@ -0,0 +1,307 @@
+package wasmexec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheControl is the Cache-Control header of a Handler without one. Clients may
+// keep the content but revalidate it with the ETag before each use.
+const DefaultCacheControl = "no-cache"
+
+// ImmutableCacheControl is the Cache-Control header of content addressed responses.
+const ImmutableCacheControl = "public, max-age=31536000, immutable"
+
+// contentPrefixLength is the number of sha hex digits in a content addressed name.
+const contentPrefixLength = 16
+
+const javascriptContentType = "application/javascript"
+
+// startTime is the Last-Modified time of served content, which only changes when the process restarts.
+var startTime = time.Now()
+
+// Handler serves the wasm_exec.js of one version. Responses carry a strong ETag of the
+// content sha and conditional, HEAD and Range requests are answered by http.ServeContent.
+// Clients accepting gzip or deflate are sent a compressed encoding, which the Handler
+// builds once per content. A Handler must not be copied after first use.
+type Handler struct {
+	// Version is the version served, the version of the running toolchain when empty.
+	// Aliases accepted by Resolve may be used.
+	Version string
+	// Store looks up the content, DefaultStore when nil.
+	Store *Store
+	// CacheControl is the Cache-Control header of successful responses, DefaultCacheControl when empty.
+	CacheControl string
+	// ModTime is the Last-Modified time of the content, the time the process started when zero.
+	ModTime time.Time
+	// Immutable serves content at content addressed paths ending in wasm_exec.<sha prefix>.js,
+	// as returned by ContentURL, with ImmutableCacheControl. Other paths are not found.
+	// Embedded contents of other versions are served by their sha as well, so pages
+	// referencing the previous version keep working after an upgrade.
+	Immutable bool
+	// Launcher serves the wasm_exec.js followed by the launcher written by WriteLauncher
+	// with LauncherOptions. In Worker mode requests for WorkerScriptName next to the
+	// launcher, or for its content addressed name when Immutable, get the worker script.
+	Launcher        bool
+	LauncherOptions LauncherOptions
+
+	encodings encodingCache
+	rendered  scriptCache
+}
+
+// ErrorResponse is the JSON body of a failed Handler response.
+type ErrorResponse struct {
+	Status  int    `json:"status"`
+	Error   string `json:"error"`
+	Version string `json:"version,omitempty"`
+}
+
+func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	version := h.version()
+	if request.Method != http.MethodGet && request.Method != http.MethodHead {
+		writer.Header().Set("Allow", "GET, HEAD")
+		writeError(writer, http.StatusMethodNotAllowed, version, errors.New("method not allowed"))
+		return
+	}
+
+	scripts, err := h.scripts(request.Context(), version)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrUnknownVersion) {
+			status = http.StatusNotFound
+		}
+		writeError(writer, status, version, err)
+		return
+	}
+
+	if h.Immutable {
+		h.serveImmutable(writer, request, version, scripts)
+		return
+	}
+	script := scripts[0]
+	for _, other := range scripts[1:] {
+		if other.name == path.Base(request.URL.Path) {
+			script = other
+		}
+	}
+	h.serveContent(writer, request, script.name, h.cacheControl(), script.contents, script.sha)
+}
+
+// servedScript is one script a Handler serves.
+type servedScript struct {
+	name     string
+	contents []byte
+	sha      string
+}
+
+// scripts returns the scripts the Handler serves for version. The first is served
+// unless a request names another, as the launcher in Worker mode does.
+func (h *Handler) scripts(ctx context.Context, version string) (scripts []servedScript, err error) {
+	contents, info, err := h.store().VersionInfoContext(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	if scripts, ok := h.rendered.get(version, info.Sha); ok {
+		return scripts, nil
+	}
+	if scripts, err = h.render(contents, info.Sha); err != nil {
+		return nil, err
+	}
+	h.rendered.put(version, info.Sha, scripts)
+	return scripts, nil
+}
+
+// render returns the scripts served for the wasm_exec.js contents with sha.
+func (h *Handler) render(contents []byte, sha string) (scripts []servedScript, err error) {
+	if !h.Launcher {
+		return []servedScript{{"wasm_exec.js", contents, sha}}, nil
+	}
+
+	if !h.LauncherOptions.Worker {
+		if contents, err = h.LauncherOptions.appendTo(contents, "launcher"); err != nil {
+			return nil, err
+		}
+		return []servedScript{{"wasm_exec.js", contents, shaString(contents)}}, nil
+	}
+
+	worker := servedScript{name: WorkerScriptName}
+	if worker.contents, err = h.LauncherOptions.appendTo(contents, "worker"); err != nil {
+		return nil, err
+	}
+	worker.sha = shaString(worker.contents)
+	workerURL := WorkerScriptName
+	if h.Immutable {
+		workerURL = contentName(worker.sha[:contentPrefixLength])
+	}
+	if contents, err = mainScript(h.LauncherOptions, workerURL); err != nil {
+		return nil, err
+	}
+	return []servedScript{{"wasm_exec.js", contents, shaString(contents)}, worker}, nil
+}
+
+// scriptCache keeps the scripts a Handler rendered for each version, with the sha of
+// the wasm_exec.js they were rendered from so a changed content is rendered again.
+type scriptCache struct {
+	mu      sync.Mutex
+	entries map[string]scriptCacheEntry
+}
+
+type scriptCacheEntry struct {
+	sha     string
+	scripts []servedScript
+}
+
+func (c *scriptCache) get(version, sha string) ([]servedScript, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[version]
+	if !ok || entry.sha != sha {
+		return nil, false
+	}
+	return entry.scripts, true
+}
+
+func (c *scriptCache) put(version, sha string, scripts []servedScript) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = map[string]scriptCacheEntry{}
+	}
+	c.entries[version] = scriptCacheEntry{sha: sha, scripts: scripts}
+}
+
+// serveImmutable serves the script whose sha prefix is in the request path.
+// Launchers of other versions are not kept, only embedded contents are.
+func (h *Handler) serveImmutable(writer http.ResponseWriter, request *http.Request, version string, scripts []servedScript) {
+	name := path.Base(request.URL.Path)
+	prefix := strings.TrimSuffix(strings.TrimPrefix(name, "wasm_exec."), ".js")
+	if len(prefix) != contentPrefixLength || name != contentName(prefix) {
+		writeError(writer, http.StatusNotFound, version, fmt.Errorf("%s is not a content addressed wasm_exec.js", name))
+		return
+	}
+	for _, script := range scripts {
+		if strings.HasPrefix(script.sha, prefix) {
+			h.serveContent(writer, request, name, ImmutableCacheControl, script.contents, script.sha)
+			return
+		}
+	}
+
+	err := fmt.Errorf("%w: no launcher with sha %s", ErrUnknownVersion, prefix)
+	var contents []byte
+	var sha string
+	if !h.Launcher {
+		contents, sha, err = embeddedByPrefix(prefix)
+	}
+	if err != nil {
+		writeError(writer, http.StatusNotFound, version, err)
+		return
+	}
+	h.serveContent(writer, request, name, ImmutableCacheControl, contents, sha)
+}
+
+// embeddedByPrefix returns the embedded content whose sha starts with prefix.
+func embeddedByPrefix(prefix string) (contents []byte, sha string, err error) {
+	for sha = range shaTags() {
+		if strings.HasPrefix(sha, prefix) {
+			contents, err = readSha(sha)
+			return contents, sha, err
+		}
+	}
+	return nil, "", fmt.Errorf("%w: no content with sha %s", ErrUnknownVersion, prefix)
+}
+
+// ContentURL returns the content addressed URL of the wasm_exec.js for version
+// served by an Immutable Handler at base.
+func ContentURL(base, version string) (string, error) {
+	return contentURL(DefaultStore(), base, version)
+}
+
+func contentURL(store *Store, base, version string) (string, error) {
+	info, err := store.Lookup(version)
+	if err != nil {
+		return "", err
+	}
+	return contentPath(base, info.Sha), nil
+}
+
+// ContentURL returns the content addressed URL of the wasm_exec.js the Handler serves
+// when it is Immutable and mounted at base.
+func (h *Handler) ContentURL(base string) (string, error) {
+	scripts, err := h.scripts(context.Background(), h.version())
+	if err != nil {
+		return "", err
+	}
+	return contentPath(base, scripts[0].sha), nil
+}
+
+func contentPath(base, sha string) string {
+	return strings.TrimSuffix(base, "/") + "/" + contentName(sha[:contentPrefixLength])
+}
+
+func contentName(prefix string) string {
+	return "wasm_exec." + prefix + ".js"
+}
+
+func (h *Handler) version() string {
+	if h.Version == "" {
+		return runtime.Version()
+	}
+	return h.Version
+}
+
+func (h *Handler) cacheControl() string {
+	if h.CacheControl == "" {
+		return DefaultCacheControl
+	}
+	return h.CacheControl
+}
+
+func (h *Handler) store() *Store {
+	if h.Store == nil {
+		return DefaultStore()
+	}
+	return h.Store
+}
+
+// serveContent writes contents in the encoding the client prefers with the headers shared
+// by every successful response. Each encoding has its own ETag.
+func (h *Handler) serveContent(writer http.ResponseWriter, request *http.Request, name, cacheControl string, contents []byte, sha string) {
+	header := writer.Header()
+	header.Set("Content-Type", javascriptContentType)
+	header.Set("Cache-Control", cacheControl)
+	header.Add("Vary", "Accept-Encoding")
+
+	etag := sha
+	if encoding := negotiateEncoding(request.Header.Get("Accept-Encoding")); encoding != "" {
+		if encoded, err := h.encodings.encoded(sha, encoding, contents); err == nil {
+			contents, etag = encoded, sha+"-"+encoding
+			header.Set("Content-Encoding", encoding)
+		}
+	}
+	header.Set("ETag", `"`+etag+`"`)
+
+	modTime := h.ModTime
+	if modTime.IsZero() {
+		modTime = startTime
+	}
+	http.ServeContent(writer, request, name, modTime, bytes.NewReader(contents))
+}
+
+// writeError writes an ErrorResponse that clients and caches do not keep.
+func writeError(writer http.ResponseWriter, status int, version string, err error) {
+	header := writer.Header()
+	header.Set("Content-Type", "application/json")
+	header.Set("Cache-Control", "no-store")
+	header.Set("X-Content-Type-Options", "nosniff")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(ErrorResponse{Status: status, Error: err.Error(), Version: version})
+}
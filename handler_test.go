@@ -0,0 +1,227 @@
+package wasmexec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func serve(handler http.Handler, method, target string, headers map[string]string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, target, nil)
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestHandler(t *testing.T) {
+	modTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	handler := &Handler{Version: "go1.21.5", CacheControl: "max-age=60", ModTime: modTime}
+	contents, err := Version("go1.21.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	etag := `"` + TagToSha("go1.21.5") + `"`
+
+	response := serve(handler, http.MethodGet, "/wasm_exec.js", nil)
+	if response.Code != http.StatusOK || response.Body.String() != string(contents) {
+		t.Fatalf("unexpected response %d", response.Code)
+	}
+	for name, want := range map[string]string{
+		"ETag": etag, "Cache-Control": "max-age=60", "Content-Type": "application/javascript",
+		"Last-Modified": modTime.Format(http.TimeFormat),
+	} {
+		if got := response.Header().Get(name); got != want {
+			t.Errorf("%s: expected %q, got %q", name, want, got)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		headers map[string]string
+		status  int
+		length  int
+	}{
+		{"if-none-match", http.MethodGet, map[string]string{"If-None-Match": etag}, http.StatusNotModified, 0},
+		{"stale etag", http.MethodGet, map[string]string{"If-None-Match": `"stale"`}, http.StatusOK, len(contents)},
+		{"if-modified-since", http.MethodGet, map[string]string{"If-Modified-Since": modTime.Format(http.TimeFormat)}, http.StatusNotModified, 0},
+		{"modified", http.MethodGet, map[string]string{"If-Modified-Since": modTime.Add(-time.Hour).Format(http.TimeFormat)}, http.StatusOK, len(contents)},
+		{"range", http.MethodGet, map[string]string{"Range": "bytes=0-99"}, http.StatusPartialContent, 100},
+		{"head", http.MethodHead, nil, http.StatusOK, 0},
+	}
+	for _, test := range tests {
+		response = serve(handler, test.method, "/wasm_exec.js", test.headers)
+		if response.Code != test.status || response.Body.Len() != test.length {
+			t.Errorf("%s: expected %d with %d bytes, got %d with %d bytes", test.name, test.status, test.length, response.Code, response.Body.Len())
+		}
+	}
+}
+
+func TestHandlerErrors(t *testing.T) {
+	tests := []struct {
+		handler *Handler
+		method  string
+		status  int
+	}{
+		{&Handler{Version: "go0.1"}, http.MethodGet, http.StatusNotFound},
+		{&Handler{Version: "go1.21.5"}, http.MethodPost, http.StatusMethodNotAllowed},
+	}
+	for _, test := range tests {
+		response := serve(test.handler, test.method, "/wasm_exec.js", nil)
+		var body ErrorResponse
+		if err := json.Unmarshal(response.Body.Bytes(), &body); err != nil {
+			t.Fatal(err)
+		}
+		if response.Code != test.status || body.Status != test.status || body.Error == "" || body.Version != test.handler.Version {
+			t.Errorf("%s %s: unexpected response %d %+v", test.method, test.handler.Version, response.Code, body)
+		}
+		if response.Header().Get("Cache-Control") != "no-store" {
+			t.Errorf("%s %s: error response may be cached", test.method, test.handler.Version)
+		}
+	}
+}
+
+func TestHandlerImmutable(t *testing.T) {
+	handler := &Handler{Version: "go1.21.5", Immutable: true}
+	url, err := handler.ContentURL("/static/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "/static/wasm_exec." + TagToSha("go1.21.5")[:16] + ".js"; url != want {
+		t.Fatalf("expected %s, got %s", want, url)
+	}
+	if packageURL, _ := ContentURL("/static", "go1.21.5"); packageURL != url {
+		t.Fatalf("expected %s, got %s", url, packageURL)
+	}
+
+	previous, err := ContentURL("/static", "go1.20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path   string
+		status int
+		sha    string
+	}{
+		{url, http.StatusOK, TagToSha("go1.21.5")},
+		{previous, http.StatusOK, TagToSha("go1.20")},
+		{"/static/wasm_exec.js", http.StatusNotFound, ""},
+		{"/static/wasm_exec.0000000000000000.js", http.StatusNotFound, ""},
+		{"/static/wasm_exec." + TagToSha("go1.21.5")[:8] + ".js", http.StatusNotFound, ""},
+	}
+	for _, test := range tests {
+		recorder := serve(handler, http.MethodGet, test.path, nil)
+		if recorder.Code != test.status {
+			t.Errorf("%s: expected %d, got %d", test.path, test.status, recorder.Code)
+			continue
+		}
+		if test.status != http.StatusOK {
+			continue
+		}
+		if shaString(recorder.Body.Bytes()) != test.sha || recorder.Header().Get("Cache-Control") != ImmutableCacheControl {
+			t.Errorf("%s: unexpected response %v", test.path, recorder.Header())
+		}
+	}
+}
+
+func TestHandlerEncodings(t *testing.T) {
+	handler := &Handler{Version: "go1.21.5"}
+	contents, _ := Version("go1.21.5")
+	sha := TagToSha("go1.21.5")
+
+	tests := []struct {
+		acceptEncoding string
+		encoding       string
+	}{
+		{"", ""},
+		{"gzip, deflate, br", "gzip"},
+		{"deflate", "deflate"},
+		{"gzip;q=0.5, deflate;q=0.8", "deflate"},
+		{"gzip;q=0", ""},
+		{"*", "gzip"},
+		{"gzip;q=0, *", "deflate"},
+		{"gzip;q=0, deflate;q=0, *", ""},
+		{"br", ""},
+	}
+	for _, test := range tests {
+		response := serve(handler, http.MethodGet, "/wasm_exec.js", map[string]string{"Accept-Encoding": test.acceptEncoding})
+		if response.Header().Get("Vary") != "Accept-Encoding" || response.Header().Get("Content-Encoding") != test.encoding {
+			t.Errorf("%q: unexpected headers %v", test.acceptEncoding, response.Header())
+			continue
+		}
+
+		var reader io.Reader = response.Body
+		wantETag := `"` + sha + `"`
+		switch test.encoding {
+		case "gzip":
+			reader, _ = gzip.NewReader(reader)
+			wantETag = `"` + sha + `-gzip"`
+		case "deflate":
+			reader, _ = zlib.NewReader(reader)
+			wantETag = `"` + sha + `-deflate"`
+		}
+		if etag := response.Header().Get("ETag"); etag != wantETag {
+			t.Errorf("%q: expected ETag %s, got %s", test.acceptEncoding, wantETag, etag)
+		}
+		if body, err := io.ReadAll(reader); err != nil || !bytes.Equal(body, contents) {
+			t.Errorf("%q: body does not decode to the content: %v", test.acceptEncoding, err)
+		}
+
+		headers := map[string]string{"Accept-Encoding": test.acceptEncoding, "If-None-Match": wantETag}
+		if response = serve(handler, http.MethodGet, "/wasm_exec.js", headers); response.Code != http.StatusNotModified {
+			t.Errorf("%q: expected %d, got %d", test.acceptEncoding, http.StatusNotModified, response.Code)
+		}
+	}
+}
+
+func TestHandlerLauncher(t *testing.T) {
+	handler := &Handler{Version: "go1.21.5", Launcher: true, Immutable: true}
+	url, err := handler.ContentURL("/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := serve(handler, http.MethodGet, "/wasm_exec.js", nil)
+	if response.Code != http.StatusNotFound {
+		t.Fatalf("expected %d for a path without the sha, got %d", http.StatusNotFound, response.Code)
+	}
+
+	recorder := serve(handler, http.MethodGet, url, nil)
+	want, _ := launcherScript(context.Background(), DefaultStore(), "go1.21.5", LauncherOptions{})
+	if recorder.Code != http.StatusOK || !bytes.Equal(recorder.Body.Bytes(), want) {
+		t.Fatalf("unexpected launcher response %d", recorder.Code)
+	}
+}
+
+func TestHandlerScriptCache(t *testing.T) {
+	static := &StaticSource{}
+	static.Register("go1.99.0", []byte("first"))
+	store := NewStore(WithSources(static))
+	handler := &Handler{Version: "go1.99.0", Store: store, Launcher: true}
+
+	first, err := handler.scripts(context.Background(), "go1.99.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, _ := handler.scripts(context.Background(), "go1.99.0")
+	if &again[0] != &first[0] {
+		t.Fatal("expected the rendered launcher to be reused")
+	}
+
+	// a changed content is rendered again
+	static.Register("go1.99.0", []byte("second"))
+	store.Flush()
+	response := serve(handler, http.MethodGet, "/wasm_exec.js", nil)
+	if !bytes.HasPrefix(response.Body.Bytes(), []byte("second")) {
+		t.Fatalf("expected the launcher for the new content, got %q", response.Body.Bytes()[:16])
+	}
+}
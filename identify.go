@@ -0,0 +1,79 @@
+package wasmexec
+
+import (
+	"bytes"
+	"sort"
+)
+
+// maxIdentifyMatches limits the similar variants returned when there is no exact match.
+const maxIdentifyMatches = 5
+
+// Match is an embedded wasm_exec.js that identified content matches or resembles.
+type Match struct {
+	Class
+	// Exact is set when the content has the sha of the class.
+	Exact bool
+	// Similarity is the fraction of lines the content and the class have in common, from 0 to 1.
+	Similarity float64
+}
+
+// Identify returns the class of embedded wasm_exec.js with the sha of content, whose tags are
+// the Go versions that shipped it. When no class matches exactly, the most similar classes are
+// returned, ranked by the lines they share with content.
+func Identify(content []byte) (matches []Match, err error) {
+	var classes []Class
+	if classes, err = Classes(); err != nil {
+		return nil, err
+	}
+
+	sha := shaString(content)
+	for _, class := range classes {
+		if class.Sha == sha {
+			return []Match{{Class: class, Exact: true, Similarity: 1}}, nil
+		}
+	}
+
+	lines := countLines(content)
+	for _, class := range classes {
+		var classContent []byte
+		if classContent, err = readContents(class.Tags.First()); err != nil {
+			return nil, err
+		}
+		matches = append(matches, Match{Class: class, Similarity: lineSimilarity(lines, countLines(classContent))})
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Similarity > matches[j].Similarity })
+	if len(matches) > maxIdentifyMatches {
+		matches = matches[:maxIdentifyMatches]
+	}
+	return matches, nil
+}
+
+// countLines counts each line of content, ignoring carriage returns so copies with
+// windows line endings still resemble the original.
+func countLines(content []byte) map[string]int {
+	counts := map[string]int{}
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		counts[string(bytes.TrimRight(line, "\r"))]++
+	}
+	return counts
+}
+
+// lineSimilarity returns twice the number of lines a and b share over their total number of lines.
+func lineSimilarity(a, b map[string]int) float64 {
+	var shared, total int
+	for line, count := range a {
+		total += count
+		if other := b[line]; other < count {
+			shared += other
+		} else {
+			shared += count
+		}
+	}
+	for _, count := range b {
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+	return 2 * float64(shared) / float64(total)
+}
@@ -0,0 +1,41 @@
+package wasmexec
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestIdentify(t *testing.T) {
+	content, err := Version("go1.21.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	matches, err := Identify(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 || !matches[0].Exact || matches[0].Sha != TagToSha("go1.21.5") {
+		t.Fatalf("unexpected matches %+v", matches)
+	}
+
+	// a vendored copy with windows line endings and a local edit
+	vendored := bytes.ReplaceAll(content, []byte("\n"), []byte("\r\n"))
+	vendored = append([]byte("// vendored\r\n"), vendored...)
+	if matches, err = Identify(vendored); err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) == 0 || matches[0].Exact || matches[0].Sha != TagToSha("go1.21.5") {
+		t.Fatalf("expected go1.21.5 to rank first, got %+v", matches)
+	}
+	if matches[0].Similarity < 0.99 || matches[0].Similarity >= 1 {
+		t.Fatalf("unexpected similarity %f", matches[0].Similarity)
+	}
+	for i := 1; i < len(matches); i++ {
+		if matches[i].Similarity > matches[i-1].Similarity {
+			t.Fatalf("matches are not ranked %+v", matches)
+		}
+	}
+	if len(matches) > maxIdentifyMatches {
+		t.Fatalf("expected at most %d matches, got %d", maxIdentifyMatches, len(matches))
+	}
+}
@@ -0,0 +1,273 @@
+// Package codec reads and writes the archive of wasm_exec.js contents embedded in versions.go.
+//
+// The archive starts with an uncompressed header and index followed by the zlib
+// compressed dictionary and one zlib stream per entry, each compressed against the
+// dictionary. Entry offsets are relative to the end of the dictionary.
+package codec
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+var (
+	// ErrCorruptArchive is returned when the archive is truncated or its header, index or entries are malformed.
+	ErrCorruptArchive = errors.New("wasmexec: corrupt archive")
+	// ErrUnsupportedVersion is returned when the archive was written with an unknown format version.
+	ErrUnsupportedVersion = errors.New("wasmexec: unsupported archive version")
+	// ErrShaMismatch is returned when an entry does not decompress to the content its sha describes.
+	ErrShaMismatch = errors.New("wasmexec: sha mismatch")
+)
+
+const archiveMagic = "WXJS"
+const archiveVersion = 1
+
+// maxArchiveEntries and maxEntrySize bound what a Decoder will allocate.
+const maxArchiveEntries = 4096
+const maxEntrySize = 1 << 20
+
+type archiveHeader struct {
+	Magic      [4]byte
+	Version    uint16
+	Count      uint32
+	DictLength uint32
+}
+
+type archiveIndexEntry struct {
+	Sha    [32]byte
+	Offset uint32
+	Length uint32
+	Size   uint32
+}
+
+// HeaderSize is the encoded size of the archive header, IndexEntrySize that of one index
+// entry. The first index entry starts at HeaderSize.
+var HeaderSize = binary.Size(archiveHeader{})
+var IndexEntrySize = binary.Size(archiveIndexEntry{})
+
+// Encoder collects contents and writes them as an archive.
+type Encoder struct {
+	entries map[[32]byte][]byte
+	dict    []byte
+}
+
+func NewEncoder() *Encoder {
+	return &Encoder{entries: map[[32]byte][]byte{}}
+}
+
+// Add adds content to the archive and returns its sha.
+func (e *Encoder) Add(content []byte) (sha [32]byte) {
+	sha = sha256.Sum256(content)
+	e.entries[sha] = content
+	return sha
+}
+
+// SetDictionary sets the content every entry is compressed against.
+// Compression is best when the dictionary is similar to most entries.
+func (e *Encoder) SetDictionary(dict []byte) {
+	e.dict = dict
+}
+
+// Encode writes the archive to writer with entries in sha order.
+func (e *Encoder) Encode(writer io.Writer) (err error) {
+	if len(e.entries) > maxArchiveEntries {
+		return fmt.Errorf("%d entries exceeds limit of %d", len(e.entries), maxArchiveEntries)
+	}
+
+	var shas [][32]byte
+	for sha := range e.entries {
+		shas = append(shas, sha)
+	}
+	sort.Slice(shas, func(i, j int) bool { return bytes.Compare(shas[i][:], shas[j][:]) < 0 })
+
+	var dictData []byte
+	if dictData, err = deflate(e.dict, nil); err != nil {
+		return err
+	}
+
+	header := archiveHeader{Version: archiveVersion, Count: uint32(len(shas)), DictLength: uint32(len(dictData))}
+	copy(header.Magic[:], archiveMagic)
+
+	var index []archiveIndexEntry
+	data := &bytes.Buffer{}
+	for _, sha := range shas {
+		content := e.entries[sha]
+		if len(content) > maxEntrySize {
+			return fmt.Errorf("entry %x size %d exceeds limit of %d", sha, len(content), maxEntrySize)
+		}
+		var entryData []byte
+		if entryData, err = deflate(content, e.dict); err != nil {
+			return err
+		}
+		index = append(index, archiveIndexEntry{
+			Sha: sha, Offset: uint32(data.Len()), Length: uint32(len(entryData)), Size: uint32(len(content)),
+		})
+		data.Write(entryData)
+	}
+
+	if err = binary.Write(writer, binary.BigEndian, header); err != nil {
+		return err
+	}
+	if err = binary.Write(writer, binary.BigEndian, index); err != nil {
+		return err
+	}
+	if _, err = writer.Write(dictData); err != nil {
+		return err
+	}
+	_, err = writer.Write(data.Bytes())
+	return err
+}
+
+// Decoder provides random access to the entries of an archive.
+type Decoder struct {
+	data      []byte
+	index     []archiveIndexEntry
+	dictStart int
+	dataStart int
+
+	dictOnce sync.Once
+	dict     []byte
+	dictErr  error
+}
+
+// NewDecoder validates the header and index of data.
+// Entries are only decompressed when requested.
+func NewDecoder(data []byte) (d *Decoder, err error) {
+	reader := bytes.NewReader(data)
+
+	var header archiveHeader
+	if err = binary.Read(reader, binary.BigEndian, &header); err != nil {
+		return nil, fmt.Errorf("%w: reading header: %v", ErrCorruptArchive, err)
+	}
+	if string(header.Magic[:]) != archiveMagic {
+		return nil, fmt.Errorf("%w: magic %q is not %q", ErrCorruptArchive, header.Magic[:], archiveMagic)
+	}
+	if header.Version != archiveVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, header.Version)
+	}
+	if header.Count > maxArchiveEntries {
+		return nil, fmt.Errorf("%w: %d entries exceeds limit of %d", ErrCorruptArchive, header.Count, maxArchiveEntries)
+	}
+	if int64(header.Count)*int64(IndexEntrySize) > int64(reader.Len()) {
+		return nil, fmt.Errorf("%w: index exceeds archive length", ErrCorruptArchive)
+	}
+
+	d = &Decoder{data: data}
+	d.index = make([]archiveIndexEntry, header.Count)
+	if err = binary.Read(reader, binary.BigEndian, d.index); err != nil {
+		return nil, fmt.Errorf("%w: reading index: %v", ErrCorruptArchive, err)
+	}
+
+	d.dictStart = len(data) - reader.Len()
+	if int64(header.DictLength) > int64(reader.Len()) {
+		return nil, fmt.Errorf("%w: dictionary exceeds archive length", ErrCorruptArchive)
+	}
+	d.dataStart = d.dictStart + int(header.DictLength)
+
+	for _, entry := range d.index {
+		if int64(entry.Offset)+int64(entry.Length) > int64(len(data)-d.dataStart) {
+			return nil, fmt.Errorf("%w: entry %x exceeds archive length", ErrCorruptArchive, entry.Sha)
+		}
+		if entry.Size > maxEntrySize {
+			return nil, fmt.Errorf("%w: entry %x size %d exceeds limit of %d", ErrCorruptArchive, entry.Sha, entry.Size, maxEntrySize)
+		}
+	}
+
+	return d, nil
+}
+
+// Shas returns the sha of every entry in index order.
+func (d *Decoder) Shas() (shas [][32]byte) {
+	for _, entry := range d.index {
+		shas = append(shas, entry.Sha)
+	}
+	return shas
+}
+
+// Size returns the decompressed size of the entry for sha.
+func (d *Decoder) Size(sha [32]byte) (size int, ok bool) {
+	for _, entry := range d.index {
+		if entry.Sha == sha {
+			return int(entry.Size), true
+		}
+	}
+	return 0, false
+}
+
+// Entry returns the decompressed content for sha, verifying the decompressed content matches it.
+func (d *Decoder) Entry(sha [32]byte) (contents []byte, err error) {
+	for _, entry := range d.index {
+		if entry.Sha != sha {
+			continue
+		}
+
+		var dict []byte
+		if dict, err = d.dictionary(); err != nil {
+			return nil, err
+		}
+
+		start := d.dataStart + int(entry.Offset)
+		end := start + int(entry.Length)
+		if contents, err = inflate(d.data[start:end], dict, int(entry.Size)); err != nil {
+			return nil, fmt.Errorf("%w: entry %x: %v", ErrCorruptArchive, sha, err)
+		}
+		if len(contents) != int(entry.Size) {
+			return nil, fmt.Errorf("%w: entry %x size %d is not %d", ErrCorruptArchive, sha, len(contents), entry.Size)
+		}
+		if sha256.Sum256(contents) != sha {
+			return nil, fmt.Errorf("%w: entry %x", ErrShaMismatch, sha)
+		}
+		return contents, nil
+	}
+	return nil, fmt.Errorf("unable to match sha %x", sha)
+}
+
+func (d *Decoder) dictionary() ([]byte, error) {
+	d.dictOnce.Do(func() {
+		if d.dict, d.dictErr = inflate(d.data[d.dictStart:d.dataStart], nil, maxEntrySize); d.dictErr != nil {
+			d.dictErr = fmt.Errorf("%w: dictionary: %v", ErrCorruptArchive, d.dictErr)
+		}
+	})
+	return d.dict, d.dictErr
+}
+
+func deflate(content, dict []byte) (data []byte, err error) {
+	buf := &bytes.Buffer{}
+	var writer *zlib.Writer
+	if writer, err = zlib.NewWriterLevelDict(buf, zlib.BestCompression, dict); err != nil {
+		return nil, err
+	}
+	if _, err = writer.Write(content); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// inflate decompresses data, failing if the result is larger than limit.
+func inflate(data, dict []byte, limit int) (contents []byte, err error) {
+	var reader io.ReadCloser
+	if reader, err = zlib.NewReaderDict(bytes.NewReader(data), dict); err != nil {
+		return nil, err
+	}
+	defer func() { _ = reader.Close() }()
+
+	buf := &bytes.Buffer{}
+	var read int64
+	if read, err = io.Copy(buf, io.LimitReader(reader, int64(limit)+1)); err != nil {
+		return nil, err
+	}
+	if read > int64(limit) {
+		return nil, fmt.Errorf("decompressed size exceeds %d", limit)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,114 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"testing/quick"
+)
+
+func encode(t *testing.T, dict []byte, contents ...[]byte) []byte {
+	t.Helper()
+	encoder := NewEncoder()
+	encoder.SetDictionary(dict)
+	for _, content := range contents {
+		encoder.Add(content)
+	}
+	buf := &bytes.Buffer{}
+	if err := encoder.Encode(buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	roundTrip := func(dict []byte, contents [][]byte) bool {
+		encoder := NewEncoder()
+		encoder.SetDictionary(dict)
+		shas := map[[32]byte][]byte{}
+		for _, content := range contents {
+			shas[encoder.Add(content)] = content
+		}
+		buf := &bytes.Buffer{}
+		if err := encoder.Encode(buf); err != nil {
+			t.Log(err)
+			return false
+		}
+
+		decoder, err := NewDecoder(buf.Bytes())
+		if err != nil {
+			t.Log(err)
+			return false
+		}
+		if len(decoder.Shas()) != len(shas) {
+			return false
+		}
+		for _, sha := range decoder.Shas() {
+			content, err := decoder.Entry(sha)
+			if err != nil {
+				t.Log(err)
+				return false
+			}
+			if !bytes.Equal(content, shas[sha]) {
+				return false
+			}
+			if size, ok := decoder.Size(sha); !ok || size != len(content) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	archive := encode(t, []byte("dictionary"), []byte("first entry"), []byte("second entry"))
+	mutate := func(f func(data []byte)) []byte {
+		data := make([]byte, len(archive))
+		copy(data, archive)
+		f(data)
+		return data
+	}
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"empty", nil, ErrCorruptArchive},
+		{"magic", mutate(func(data []byte) { data[0] = 'X' }), ErrCorruptArchive},
+		{"version", mutate(func(data []byte) { data[5] = 99 }), ErrUnsupportedVersion},
+		{"count", mutate(func(data []byte) { binary.BigEndian.PutUint32(data[6:], maxArchiveEntries+1) }), ErrCorruptArchive},
+		{"truncated index", archive[:HeaderSize+IndexEntrySize/2], ErrCorruptArchive},
+		{"truncated data", archive[:len(archive)-1], ErrCorruptArchive},
+		{"dictionary length", mutate(func(data []byte) { binary.BigEndian.PutUint32(data[10:], uint32(len(data))) }), ErrCorruptArchive},
+		{"entry size", mutate(func(data []byte) {
+			binary.BigEndian.PutUint32(data[HeaderSize+IndexEntrySize-4:], maxEntrySize+1)
+		}), ErrCorruptArchive},
+	}
+	for _, test := range tests {
+		if _, err := NewDecoder(test.data); !errors.Is(err, test.want) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, err)
+		}
+	}
+}
+
+func TestDecoderShaMismatch(t *testing.T) {
+	data := encode(t, nil, []byte("first entry"), []byte("second entry"))
+	// swap the index shas so each entry decompresses to the other's content
+	first := data[HeaderSize : HeaderSize+32]
+	second := data[HeaderSize+IndexEntrySize : HeaderSize+IndexEntrySize+32]
+	for i := range first {
+		first[i], second[i] = second[i], first[i]
+	}
+
+	decoder, err := NewDecoder(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = decoder.Entry(decoder.Shas()[0]); !errors.Is(err, ErrShaMismatch) {
+		t.Fatalf("expected %v, got %v", ErrShaMismatch, err)
+	}
+}
@@ -0,0 +1,178 @@
+// Package diff writes unified diffs of text.
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// context is the number of unchanged lines shown around each change.
+const context = 3
+
+type op byte
+
+const (
+	opEqual  op = ' '
+	opDelete op = '-'
+	opInsert op = '+'
+)
+
+// edit is one line of the edit script. fromLine and toLine are the zero based
+// line numbers before the edit in from and to.
+type edit struct {
+	op               op
+	line             string
+	fromLine, toLine int
+}
+
+// Unified returns the unified diff of from and to, labelled with fromName and toName.
+// Equal texts have an empty diff.
+func Unified(fromName, toName string, from, to []byte) string {
+	if string(from) == string(to) {
+		return ""
+	}
+	edits := myers(splitLines(string(from)), splitLines(string(to)))
+
+	out := &strings.Builder{}
+	fmt.Fprintf(out, "--- %s\n+++ %s\n", fromName, toName)
+	for start := 0; start < len(edits); {
+		// find the next change and extend the hunk until context lines separate it from the following change
+		first := start
+		for first < len(edits) && edits[first].op == opEqual {
+			first++
+		}
+		if first == len(edits) {
+			break
+		}
+		end := first
+		for i := first; i < len(edits); i++ {
+			if edits[i].op != opEqual {
+				end = i + 1
+			} else if i-end >= 2*context {
+				break
+			}
+		}
+		hunkStart, hunkEnd := first-context, end+context
+		if hunkStart < start {
+			hunkStart = start
+		}
+		if hunkEnd > len(edits) {
+			hunkEnd = len(edits)
+		}
+		writeHunk(out, edits[hunkStart:hunkEnd])
+		start = hunkEnd
+	}
+	return out.String()
+}
+
+func writeHunk(out *strings.Builder, edits []edit) {
+	var fromCount, toCount int
+	for _, e := range edits {
+		if e.op != opInsert {
+			fromCount++
+		}
+		if e.op != opDelete {
+			toCount++
+		}
+	}
+	fmt.Fprintf(out, "@@ -%s +%s @@\n", hunkRange(edits[0].fromLine, fromCount), hunkRange(edits[0].toLine, toCount))
+	for _, e := range edits {
+		out.WriteByte(byte(e.op))
+		out.WriteString(e.line)
+		if !strings.HasSuffix(e.line, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange formats the range of a hunk as diff does, the start of an empty range is the line before it.
+func hunkRange(line, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", line)
+	case 1:
+		return fmt.Sprintf("%d", line+1)
+	}
+	return fmt.Sprintf("%d,%d", line+1, count)
+}
+
+// splitLines splits text after each newline.
+func splitLines(text string) (lines []string) {
+	for text != "" {
+		end := strings.IndexByte(text, '\n') + 1
+		if end == 0 {
+			end = len(text)
+		}
+		lines = append(lines, text[:end])
+		text = text[end:]
+	}
+	return lines
+}
+
+// myers returns the shortest edit script from a to b, see
+// "An O(ND) Difference Algorithm and Its Variations" by Eugene W. Myers.
+func myers(a, b []string) (edits []edit) {
+	n, m := len(a), len(b)
+	maxD := n + m
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+
+	// trace holds v[-d-1..d+1] as it was before each round d
+	var trace [][]int
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(a, b, trace)
+			}
+		}
+	}
+	return nil
+}
+
+func backtrack(a, b []string, trace [][]int) []edit {
+	var reversed []edit
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int { return v[k+d+1] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x, y = x-1, y-1
+			reversed = append(reversed, edit{op: opEqual, line: a[x], fromLine: x, toLine: y})
+		}
+		if d > 0 {
+			if x == prevX {
+				reversed = append(reversed, edit{op: opInsert, line: b[prevY], fromLine: x, toLine: prevY})
+			} else {
+				reversed = append(reversed, edit{op: opDelete, line: a[prevX], fromLine: prevX, toLine: y})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	edits := make([]edit, len(reversed))
+	for i, e := range reversed {
+		edits[len(reversed)-1-i] = e
+	}
+	return edits
+}
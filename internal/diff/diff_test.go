@@ -0,0 +1,74 @@
+package diff
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"testing/quick"
+)
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		want     string
+	}{
+		{"equal", "a\nb\n", "a\nb\n", ""},
+		{"change", "a\nb\nc\n", "a\nB\nc\n", "--- from\n+++ to\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n"},
+		{"insert into empty", "", "a\n", "--- from\n+++ to\n@@ -0,0 +1 @@\n+a\n"},
+		{"delete all", "a\nb\n", "", "--- from\n+++ to\n@@ -1,2 +0,0 @@\n-a\n-b\n"},
+		{"no newline", "a\nb", "a\nb\n", "--- from\n+++ to\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n"},
+		{"context", "1\n2\n3\n4\n5\n6\n7\n8\n9\n", "1\n2\n3\n4\nfive\n6\n7\n8\n9\n",
+			"--- from\n+++ to\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n"},
+		{"separate hunks", lines(1, 20), strings.Replace(strings.Replace(lines(1, 20), "2\n", "two\n", 1), "19\n", "nineteen\n", 1),
+			"--- from\n+++ to\n@@ -1,5 +1,5 @@\n 1\n-2\n+two\n 3\n 4\n 5\n@@ -16,5 +16,5 @@\n 16\n 17\n 18\n-19\n+nineteen\n 20\n"},
+	}
+	for _, test := range tests {
+		if got := Unified("from", "to", []byte(test.from), []byte(test.to)); got != test.want {
+			t.Errorf("%s: expected\n%s\ngot\n%s", test.name, test.want, got)
+		}
+	}
+}
+
+// lines returns the numbers from first to last, one per line.
+func lines(first, last int) string {
+	out := &strings.Builder{}
+	for i := first; i <= last; i++ {
+		out.WriteString(strconv.Itoa(i) + "\n")
+	}
+	return out.String()
+}
+
+func TestEditScript(t *testing.T) {
+	apply := func(a, b []byte) bool {
+		// map bytes to a small alphabet of lines so the inputs share lines
+		from, to := &strings.Builder{}, &strings.Builder{}
+		for _, c := range a {
+			from.WriteString(strconv.Itoa(int(c%4)) + "\n")
+		}
+		for _, c := range b {
+			to.WriteString(strconv.Itoa(int(c%4)) + "\n")
+		}
+		fromLines, toLines := splitLines(from.String()), splitLines(to.String())
+
+		var gotFrom, gotTo []string
+		for _, e := range myers(fromLines, toLines) {
+			if e.op != opInsert {
+				if e.fromLine != len(gotFrom) {
+					return false
+				}
+				gotFrom = append(gotFrom, e.line)
+			}
+			if e.op != opDelete {
+				if e.toLine != len(gotTo) {
+					return false
+				}
+				gotTo = append(gotTo, e.line)
+			}
+		}
+		return strings.Join(gotFrom, "") == from.String() && strings.Join(gotTo, "") == to.String()
+	}
+	if err := quick.Check(apply, nil); err != nil {
+		t.Fatal(err)
+	}
+}
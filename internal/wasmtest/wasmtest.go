@@ -0,0 +1,43 @@
+// Package wasmtest builds wasm binaries for tests.
+package wasmtest
+
+import "bytes"
+
+// Header is the wasm magic number and version every module starts with.
+var Header = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+// U32 returns value as an unsigned LEB128.
+func U32(value uint32) (data []byte) {
+	for {
+		b := byte(value & 0x7f)
+		value >>= 7
+		if value != 0 {
+			b |= 0x80
+		}
+		data = append(data, b)
+		if value == 0 {
+			return data
+		}
+	}
+}
+
+// Name returns value prefixed with its length.
+func Name(value string) []byte {
+	return append(U32(uint32(len(value))), value...)
+}
+
+// Section returns a section with id and the joined content.
+func Section(id byte, content ...[]byte) []byte {
+	joined := bytes.Join(content, nil)
+	return append(append([]byte{id}, U32(uint32(len(joined)))...), joined...)
+}
+
+// Module returns the Header followed by sections.
+func Module(sections ...[]byte) []byte {
+	return append(append([]byte{}, Header...), bytes.Join(sections, nil)...)
+}
+
+// Producers returns a producers custom section naming the Go version.
+func Producers(version string) []byte {
+	return Section(0, Name("producers"), U32(1), Name("language"), U32(1), Name("Go"), Name(version))
+}
@@ -1,31 +1,144 @@
 package wasmexec
 
-import "net/http"
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"sort"
+	"strings"
+)
 
-// WriteLauncher writes Current wasm js and minimal WebAssembly instantiation code.
-func WriteLauncher(writer http.ResponseWriter) {
+// DefaultWasmURL is the wasm binary a launcher without a WasmURL fetches.
+const DefaultWasmURL = "app.wasm"
+
+// LauncherOptions configures the launcher written by WriteLauncher.
+type LauncherOptions struct {
+	// WasmURL is the url of the wasm binary, DefaultWasmURL when empty.
+	WasmURL string
+	// BasePath is prepended to a relative WasmURL, such as /app/ for a page below /app.
+	BasePath string
+	// Args are the arguments of the program, os.Args[1:] in the wasm binary.
+	Args []string
+	// Env is the environment of the program.
+	Env map[string]string
+	// Imports adds modules to the import object of the wasm binary. Each value is a
+	// javascript expression for the module object, such as window.myImports, and is
+	// written to the launcher as is.
+	Imports map[string]string
+	// Progress reads the wasm binary as a stream and reports the bytes loaded against its
+	// Content-Length with go:progress events, to ProgressElement and to ProgressCallback.
+	Progress bool
+	// ProgressElement is the id of an element showing the progress. A progress element
+	// gets its value and max set, any other element its text.
+	ProgressElement string
+	// ProgressCallback is a javascript expression for a function called with the bytes loaded
+	// and the total bytes, which is 0 when unknown. It is written to the launcher as is.
+	ProgressCallback string
+	// Worker runs the program in a dedicated Web Worker so it does not block the page. The
+	// launcher is then a main thread script that starts the worker script, which holds the
+	// wasm js and instantiates the program. Both are served by a Handler with Launcher set.
+	//
+	// The main thread script forwards the output of the program to the console and as
+	// go:stdout and go:stderr events, and exposes goWorker.call(name, ...args), which
+	// resolves to the result of the function the program set as goRPC[name] in the worker.
+	Worker bool
+	// ImportScripts are loaded with importScripts in the worker before the program starts,
+	// so the expressions of Imports can use them.
+	ImportScripts []string
+}
+
+// WriteLauncher writes Current wasm js and WebAssembly instantiation code configured by
+// the first of options, or with the default options when there are none.
+func WriteLauncher(writer http.ResponseWriter, options ...LauncherOptions) {
 	var data []byte
 	var err error
-	if data, err = Current(); err != nil {
+	if data, err = launcherScript(context.Background(), defaultStore, runtime.Version(), firstOptions(options)); err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	data = append(data, []byte(appJs)...)
 	writer.Header().Set("Content-Type", "application/javascript")
 	_, _ = writer.Write(data)
 }
 
-var appJs = `
-//
-// web assembly launcher
-//
-(() => {
-  const go = new Go();
-  WebAssembly.instantiateStreaming(fetch("app.wasm"), go.importObject)
-    .then((result) => {
-      go.run(result.instance)
-        .then(() => console.log("go.run exited"))
-        .catch(err => console.log("error ", err));
-    }).catch(err => console.log("error ", err))
-})();
-`
+func firstOptions(options []LauncherOptions) LauncherOptions {
+	if len(options) == 0 {
+		return LauncherOptions{}
+	}
+	return options[0]
+}
+
+// WorkerScriptName is the name of the worker script started by a launcher in Worker mode,
+// relative to the launcher.
+const WorkerScriptName = "wasm_exec.worker.js"
+
+// launcherScript returns the wasm js for version followed by the launcher, or the main
+// thread script starting WorkerScriptName in Worker mode.
+func launcherScript(ctx context.Context, store *Store, version string, options LauncherOptions) (data []byte, err error) {
+	if options.Worker {
+		return mainScript(options, WorkerScriptName)
+	}
+	if data, _, err = store.VersionInfoContext(ctx, version); err != nil {
+		return nil, err
+	}
+	return options.appendTo(data, "launcher")
+}
+
+// mainScript returns the main thread script starting the worker at workerURL.
+func mainScript(options LauncherOptions, workerURL string) (data []byte, err error) {
+	buf := &bytes.Buffer{}
+	if err = options.render(buf, "main", workerURL); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// appendTo returns the wasm js in data followed by the named launcher template.
+func (o LauncherOptions) appendTo(data []byte, name string) ([]byte, error) {
+	buf := bytes.NewBuffer(data[:len(data):len(data)])
+	if err := o.render(buf, name, ""); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// wasmURL returns WasmURL below BasePath unless it is absolute.
+func (o LauncherOptions) wasmURL() string {
+	wasmURL := o.WasmURL
+	if wasmURL == "" {
+		wasmURL = DefaultWasmURL
+	}
+	if o.BasePath == "" || strings.HasPrefix(wasmURL, "/") || strings.Contains(wasmURL, "://") {
+		return wasmURL
+	}
+	return strings.TrimSuffix(o.BasePath, "/") + "/" + wasmURL
+}
+
+type launcherImport struct {
+	Module     string
+	Expression string
+}
+
+// render executes the named launcher template.
+func (o LauncherOptions) render(buf *bytes.Buffer, name, workerURL string) error {
+	var imports []launcherImport
+	for module, expression := range o.Imports {
+		imports = append(imports, launcherImport{Module: module, Expression: expression})
+	}
+	sort.Slice(imports, func(i, j int) bool { return imports[i].Module < imports[j].Module })
+
+	return launcherTemplates.ExecuteTemplate(buf, name, struct {
+		LauncherOptions
+		WasmURL   string
+		WorkerURL string
+		Imports   []launcherImport
+	}{o, o.wasmURL(), workerURL, imports})
+}
+
+// jsLiteral encodes v as a javascript literal. encoding/json escapes <, > and &
+// as well as U+2028 and U+2029, so the literal is safe inside a script element.
+func jsLiteral(v any) (string, error) {
+	data, err := json.Marshal(v)
+	return string(data), err
+}
@@ -0,0 +1,267 @@
+package wasmexec
+
+import "text/template"
+
+// launcherTemplates render the launchers. The launcher template starts the wasm binary on the
+// page, the worker template starts it in a Web Worker started by the main template. Both dispatch
+// the events go:loading, go:progress, go:ready, go:exit and go:error on window.
+var launcherTemplates = template.Must(template.New("launcher").Funcs(template.FuncMap{"literal": jsLiteral}).Parse(`
+{{- define "options"}}
+{{- if .Args}}
+  go.argv = go.argv.concat({{literal .Args}});
+{{- end}}
+{{- if .Env}}
+  Object.assign(go.env, {{literal .Env}});
+{{- end}}
+{{- range .Imports}}
+  go.importObject[{{literal .Module}}] = {{.Expression}};
+{{- end}}
+  let exitCode = 0;
+  const exit = go.exit;
+  go.exit = (code) => {
+    exitCode = code;
+    exit.call(go, code);
+  };
+{{- end}}
+
+{{- define "dispatch"}}
+  const dispatch = (name, detail) => {
+    if (typeof globalThis.dispatchEvent === "function" && typeof CustomEvent === "function") {
+      globalThis.dispatchEvent(new CustomEvent(name, { detail }));
+    }
+  };
+{{- end}}
+
+{{- define "progress"}}
+  const progress = (loaded, total) => {
+    dispatch("go:progress", { loaded, total });
+{{- if .ProgressCallback}}
+    ({{.ProgressCallback}})(loaded, total);
+{{- end}}
+{{- if .ProgressElement}}
+    const element = typeof document === "undefined" ? null : document.getElementById({{literal .ProgressElement}});
+    if (element === null) {
+      return;
+    }
+    if (typeof HTMLProgressElement !== "undefined" && element instanceof HTMLProgressElement) {
+      element.max = total || 1;
+      element.value = total ? Math.min(loaded, total) : 0;
+    } else {
+      element.textContent = total ? Math.min(100, Math.floor(loaded * 100 / total)) + "%" : loaded + " bytes";
+    }
+{{- end}}
+  };
+{{- end}}
+
+{{- define "instantiate"}}
+{{- if .Progress}}
+  // track counts the bytes of the response body as they are read, the Content-Length of
+  // a compressed response is smaller than the bytes read so loaded is capped to it
+  const track = (response) => {
+    const total = Number(response.headers.get("Content-Length")) || 0;
+    if (response.body === null || typeof ReadableStream !== "function") {
+      return response;
+    }
+    const reader = response.body.getReader();
+    let loaded = 0;
+    progress(loaded, total);
+    const body = new ReadableStream({
+      async pull(controller) {
+        const { done, value } = await reader.read();
+        if (done) {
+          progress(total || loaded, total);
+          controller.close();
+          return;
+        }
+        loaded += value.byteLength;
+        progress(total ? Math.min(loaded, total) : loaded, total);
+        controller.enqueue(value);
+      },
+      cancel(reason) {
+        return reader.cancel(reason);
+      },
+    });
+    return new Response(body, { status: response.status, statusText: response.statusText, headers: response.headers });
+  };
+{{- end}}
+  // instantiateStreaming compiles while downloading but needs the application/wasm content type,
+  // older browsers and misconfigured servers get the binary compiled after it is downloaded
+  const instantiate = async (url) => {
+    dispatch("go:loading", { url });
+    let response = await fetch(url);
+    if (!response.ok) {
+      throw new Error("fetching " + url + ": " + response.status + " " + response.statusText);
+    }
+{{- if .Progress}}
+    response = track(response);
+{{- end}}
+    const type = response.headers.get("Content-Type") || "";
+    let cause = "";
+    if (typeof WebAssembly.instantiateStreaming !== "function") {
+      cause = "WebAssembly.instantiateStreaming is unavailable";
+    } else if (!type.startsWith("application/wasm")) {
+      cause = url + " is served as " + (type || "no content type") + " instead of application/wasm";
+    }
+    if (cause === "") {
+      return WebAssembly.instantiateStreaming(response, go.importObject);
+    }
+    console.warn("wasmexec: " + cause + ", falling back to WebAssembly.instantiate");
+    return WebAssembly.instantiate(await response.arrayBuffer(), go.importObject);
+  };
+{{- end}}
+
+{{- define "launcher"}}
+//
+// web assembly launcher
+//
+(() => {
+  const go = new Go();
+{{- template "options" .}}
+{{- template "dispatch" .}}
+{{- if .Progress}}
+{{- template "progress" .}}
+{{- end}}
+{{- template "instantiate" .}}
+  const fail = (error) => {
+    console.error("wasmexec:", error);
+    dispatch("go:error", { error });
+  };
+  instantiate({{literal .WasmURL}})
+    .then((result) => {
+      dispatch("go:ready", { instance: result.instance });
+      go.run(result.instance)
+        .then(() => dispatch("go:exit", { code: exitCode }))
+        .catch(fail);
+    }).catch(fail);
+})();
+{{end}}
+
+{{- define "worker"}}
+//
+// web assembly launcher, worker side
+//
+(() => {
+  // events and output are posted to the main thread script
+  const dispatch = (name, detail) => postMessage({ type: "event", name, detail });
+  const output = {};
+  globalThis.fs.writeSync = (fd, buf) => {
+    const stream = output[fd] = output[fd] || { decoder: new TextDecoder("utf-8"), text: "" };
+    stream.text += stream.decoder.decode(buf, { stream: true });
+    const nl = stream.text.lastIndexOf("\n");
+    if (nl !== -1) {
+      postMessage({ type: fd === 2 ? "stderr" : "stdout", text: stream.text.substring(0, nl) });
+      stream.text = stream.text.substring(nl + 1);
+    }
+    return buf.length;
+  };
+{{- if .ImportScripts}}
+  importScripts(...{{literal .ImportScripts}});
+{{- end}}
+  const go = new Go();
+{{- template "options" .}}
+{{- if .Progress}}
+  const progress = (loaded, total) => dispatch("go:progress", { loaded, total });
+{{- end}}
+{{- template "instantiate" .}}
+  // calls from the main thread are answered by the functions the program sets in goRPC
+  globalThis.goRPC = globalThis.goRPC || {};
+  addEventListener("message", async (event) => {
+    const { type, id, name, args } = event.data || {};
+    if (type !== "call") {
+      return;
+    }
+    try {
+      const handler = globalThis.goRPC[name];
+      if (typeof handler !== "function") {
+        throw new Error("goRPC." + name + " is not a function");
+      }
+      postMessage({ type: "result", id, result: await handler(...args) });
+    } catch (error) {
+      postMessage({ type: "result", id, error: String(error) });
+    }
+  });
+  const fail = (error) => {
+    console.error("wasmexec:", error);
+    dispatch("go:error", { error: String(error) });
+  };
+  instantiate({{literal .WasmURL}})
+    .then((result) => {
+      const run = go.run(result.instance);
+      // the program has registered its goRPC functions once main blocks
+      dispatch("go:ready", {});
+      run.then(() => dispatch("go:exit", { code: exitCode })).catch(fail);
+    }).catch(fail);
+})();
+{{end}}
+
+{{- define "main"}}
+//
+// web assembly launcher, main thread side
+//
+(() => {
+  const script = typeof document !== "undefined" && document.currentScript ? document.currentScript.src : location.href;
+  const worker = new Worker(new URL({{literal .WorkerURL}}, script));
+{{- template "dispatch" .}}
+{{- if .Progress}}
+{{- template "progress" .}}
+{{- end}}
+  let ready;
+  const started = new Promise((resolve) => {
+    ready = resolve;
+  });
+  let nextID = 0;
+  const pending = new Map();
+  worker.addEventListener("message", (event) => {
+    const message = event.data;
+    switch (message.type) {
+    case "stdout":
+      console.log(message.text);
+      dispatch("go:stdout", { text: message.text });
+      break;
+    case "stderr":
+      console.error(message.text);
+      dispatch("go:stderr", { text: message.text });
+      break;
+    case "event":
+{{- if .Progress}}
+      if (message.name === "go:progress") {
+        progress(message.detail.loaded, message.detail.total);
+        break;
+      }
+{{- end}}
+      if (message.name === "go:ready") {
+        ready();
+      }
+      dispatch(message.name, message.detail);
+      break;
+    case "result": {
+      const call = pending.get(message.id);
+      if (call === undefined) {
+        break;
+      }
+      pending.delete(message.id);
+      if ("error" in message) {
+        call.reject(new Error(message.error));
+      } else {
+        call.resolve(message.result);
+      }
+      break;
+    }
+    }
+  });
+  worker.addEventListener("error", (event) => {
+    console.error("wasmexec:", event.message);
+    dispatch("go:error", { error: event.message });
+  });
+  // calls wait for the program to start so its goRPC functions are registered
+  globalThis.goWorker = {
+    worker,
+    call: (name, ...args) => started.then(() => new Promise((resolve, reject) => {
+      const id = nextID++;
+      pending.set(id, { resolve, reject });
+      worker.postMessage({ type: "call", id, name, args });
+    })),
+  };
+})();
+{{end}}
+`))
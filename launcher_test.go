@@ -0,0 +1,158 @@
+package wasmexec
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func renderLauncher(t *testing.T, options LauncherOptions) string {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	if err := options.render(buf, "launcher", ""); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestLauncherOptions(t *testing.T) {
+	launcher := renderLauncher(t, LauncherOptions{})
+	if !strings.Contains(launcher, `instantiate("app.wasm")`) || strings.Contains(launcher, "go.argv") || strings.Contains(launcher, "go.env") {
+		t.Fatalf("unexpected default launcher %s", launcher)
+	}
+
+	launcher = renderLauncher(t, LauncherOptions{
+		WasmURL:  "main.wasm",
+		BasePath: "/app/",
+		Args:     []string{"-v", `quote"d`},
+		Env:      map[string]string{"HOME": "/"},
+		Imports:  map[string]string{"env": "window.envImports", "a": "{}"},
+	})
+	for _, want := range []string{
+		`instantiate("/app/main.wasm")`,
+		`go.argv = go.argv.concat(["-v","quote\"d"]);`,
+		`Object.assign(go.env, {"HOME":"/"});`,
+		"go.importObject[\"a\"] = {};\n  go.importObject[\"env\"] = window.envImports;",
+	} {
+		if !strings.Contains(launcher, want) {
+			t.Errorf("expected %s in %s", want, launcher)
+		}
+	}
+}
+
+func TestLauncherEscaping(t *testing.T) {
+	launcher := renderLauncher(t, LauncherOptions{
+		WasmURL: "</script><script>alert(1)</script>",
+		Args:    []string{"\u2028", "&"},
+	})
+	if strings.Contains(launcher, "</script>") || strings.Contains(launcher, "\u2028") {
+		t.Errorf("unsafe characters are not escaped in %s", launcher)
+	}
+	if !strings.Contains(launcher, `go.argv.concat(["\u2028","\u0026"])`) {
+		t.Errorf("arguments are not escaped in %s", launcher)
+	}
+}
+
+func TestLauncherWasmURL(t *testing.T) {
+	tests := []struct {
+		options LauncherOptions
+		want    string
+	}{
+		{LauncherOptions{}, "app.wasm"},
+		{LauncherOptions{BasePath: "/app"}, "/app/app.wasm"},
+		{LauncherOptions{BasePath: "/app", WasmURL: "/root.wasm"}, "/root.wasm"},
+		{LauncherOptions{BasePath: "/app", WasmURL: "https://cdn.example.com/app.wasm"}, "https://cdn.example.com/app.wasm"},
+	}
+	for _, test := range tests {
+		if got := test.options.wasmURL(); got != test.want {
+			t.Errorf("%+v: expected %s, got %s", test.options, test.want, got)
+		}
+	}
+}
+
+func TestLauncherInstantiateFallback(t *testing.T) {
+	launcher := renderLauncher(t, LauncherOptions{})
+	streaming := strings.Index(launcher, "WebAssembly.instantiateStreaming(response, go.importObject)")
+	fallback := strings.Index(launcher, "WebAssembly.instantiate(await response.arrayBuffer(), go.importObject)")
+	if streaming < 0 || fallback < 0 {
+		t.Fatalf("expected a streaming and an array buffer path in %s", launcher)
+	}
+	for _, cause := range []string{
+		`typeof WebAssembly.instantiateStreaming !== "function"`,
+		`!type.startsWith("application/wasm")`,
+		"console.warn(",
+	} {
+		if index := strings.Index(launcher, cause); index < 0 || index > fallback {
+			t.Errorf("expected %s before the fallback in %s", cause, launcher)
+		}
+	}
+}
+
+func TestLauncherProgress(t *testing.T) {
+	launcher := renderLauncher(t, LauncherOptions{})
+	if strings.Contains(launcher, "go:progress") || strings.Contains(launcher, "getReader") {
+		t.Fatalf("expected no progress reporting without the option in %s", launcher)
+	}
+	for _, event := range []string{"go:loading", "go:ready", "go:exit", "go:error"} {
+		if !strings.Contains(launcher, `dispatch("`+event+`"`) {
+			t.Errorf("expected a %s event in %s", event, launcher)
+		}
+	}
+
+	launcher = renderLauncher(t, LauncherOptions{Progress: true, ProgressElement: "loading", ProgressCallback: "window.onProgress"})
+	for _, want := range []string{
+		`dispatch("go:progress", { loaded, total });`,
+		"(window.onProgress)(loaded, total);",
+		`document.getElementById("loading")`,
+		`response.headers.get("Content-Length")`,
+		"response = track(response);",
+	} {
+		if !strings.Contains(launcher, want) {
+			t.Errorf("expected %s in %s", want, launcher)
+		}
+	}
+}
+
+func TestLauncherWorker(t *testing.T) {
+	options := LauncherOptions{Worker: true, ImportScripts: []string{"imports.js"}, Progress: true}
+	handler := &Handler{Version: "go1.21.5", Launcher: true, LauncherOptions: options}
+	contents, _ := Version("go1.21.5")
+
+	main := serve(handler, http.MethodGet, "/wasm/launcher.js", nil)
+	worker := serve(handler, http.MethodGet, "/wasm/"+WorkerScriptName, nil)
+	if main.Code != http.StatusOK || worker.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d %d", main.Code, worker.Code)
+	}
+	for _, want := range []string{`new Worker(new URL("` + WorkerScriptName + `"`, "globalThis.goWorker", `case "stderr":`} {
+		if !strings.Contains(main.Body.String(), want) {
+			t.Errorf("expected %s in the main thread script", want)
+		}
+	}
+	if strings.Contains(main.Body.String(), string(contents)) {
+		t.Error("the main thread script holds the wasm js")
+	}
+	if !bytes.HasPrefix(worker.Body.Bytes(), contents) {
+		t.Error("the worker script does not start with the wasm js")
+	}
+	for _, want := range []string{`importScripts(...["imports.js"]);`, "globalThis.goRPC", "globalThis.fs.writeSync", `dispatch("go:progress"`} {
+		if !strings.Contains(worker.Body.String(), want) {
+			t.Errorf("expected %s in the worker script", want)
+		}
+	}
+
+	// content addressed, the main thread script names the worker script by its sha
+	handler = &Handler{Version: "go1.21.5", Launcher: true, LauncherOptions: options, Immutable: true}
+	url, err := handler.ContentURL("/wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	main = serve(handler, http.MethodGet, url, nil)
+	workerName := contentName(shaString(worker.Body.Bytes())[:contentPrefixLength])
+	if main.Code != http.StatusOK || !strings.Contains(main.Body.String(), `new URL("`+workerName+`"`) {
+		t.Fatalf("expected the main thread script to start %s", workerName)
+	}
+	if worker = serve(handler, http.MethodGet, "/wasm/"+workerName, nil); worker.Code != http.StatusOK || !bytes.HasPrefix(worker.Body.Bytes(), contents) {
+		t.Fatalf("unexpected worker response %d", worker.Code)
+	}
+}
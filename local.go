@@ -0,0 +1,59 @@
+package wasmexec
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+var wasmExecPaths = []string{"lib/wasm/wasm_exec.js", "misc/wasm/wasm_exec.js"}
+
+func localToolchainDirs(version string) (dirs []string) {
+	for _, goRoot := range []string{os.Getenv("GOROOT"), runtime.GOROOT()} {
+		if goRoot != "" && goRootVersion(goRoot) == version {
+			dirs = append(dirs, goRoot)
+		}
+	}
+
+	if modCache := goModCache(); modCache != "" {
+		// the toolchain module is named for the os and arch, but wasm_exec.js is the same in all of them
+		pattern := filepath.Join(modCache, "golang.org", "toolchain@v0.0.1-"+version+".*")
+		if matches, err := filepath.Glob(pattern); err == nil {
+			dirs = append(dirs, matches...)
+		}
+	}
+
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, filepath.Join(home, "sdk", version))
+	}
+	return dirs
+}
+
+// goRootVersion returns the version on the first line of the VERSION file in goRoot.
+func goRootVersion(goRoot string) string {
+	file, err := os.Open(filepath.Join(goRoot, "VERSION"))
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = file.Close() }()
+	scanner := bufio.NewScanner(file)
+	scanner.Scan()
+	return strings.TrimSpace(scanner.Text())
+}
+
+func goModCache() string {
+	if modCache := os.Getenv("GOMODCACHE"); modCache != "" {
+		return modCache
+	}
+	goPath := os.Getenv("GOPATH")
+	if goPath == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		goPath = filepath.Join(home, "go")
+	}
+	return filepath.Join(filepath.SplitList(goPath)[0], "pkg", "mod")
+}
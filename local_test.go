@@ -0,0 +1,53 @@
+package wasmexec
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSearchLocalToolchains(t *testing.T) {
+	modCache := t.TempDir()
+	home := t.TempDir()
+	t.Setenv("GOMODCACHE", modCache)
+	t.Setenv("HOME", home)
+
+	write := func(path, content string) {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(filepath.Join(modCache, "golang.org", "toolchain@v0.0.1-go1.99.1.linux-amd64", "lib", "wasm", "wasm_exec.js"), "modcache")
+	write(filepath.Join(home, "sdk", "go1.99.2", "misc", "wasm", "wasm_exec.js"), "sdk")
+
+	t.Cleanup(func() { SearchLocalToolchains(false) })
+
+	if _, err := Version("go1.99.1"); err == nil {
+		t.Fatal("expected error with local search disabled")
+	}
+
+	SearchLocalToolchains(true)
+	for version, want := range map[string]string{"go1.99.1": "modcache", "go1.99.2": "sdk"} {
+		content, err := Version(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != want {
+			t.Fatalf("%s: expected %q, got %q", version, want, content)
+		}
+	}
+	if _, err := Version("go1.99.3"); err == nil {
+		t.Fatal("expected error for a version without a local toolchain")
+	}
+	// versions that would escape the sdk directory or match other toolchains are rejected
+	for _, version := range []string{"../sdk/go1.99.2", "go1.99.*", "go1.99.[12]"} {
+		if _, _, err := LocalToolchainSource().Lookup(context.Background(), version); !errors.Is(err, ErrUnknownVersion) {
+			t.Fatalf("%s: expected ErrUnknownVersion, got %v", version, err)
+		}
+	}
+}
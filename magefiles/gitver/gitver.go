@@ -3,11 +3,10 @@ package gitver
 import (
 	"bufio"
 	"bytes"
-	"compress/zlib"
-	"encoding/binary"
 	"fmt"
-	"github.com/mlctrez/wasmexec/shautil"
-	"github.com/mlctrez/wasmexec/sourcefile"
+	"github.com/mlctrez/wasmexec/internal/codec"
+	"github.com/mlctrez/wasmexec/magefiles/shautil"
+	"github.com/mlctrez/wasmexec/magefiles/sourcefile"
 	"github.com/pkg/errors"
 	"io"
 	"os"
@@ -130,47 +129,34 @@ func (g *gitVer) getMappings() error {
 }
 
 func (g *gitVer) compress() error {
-	buf := &bytes.Buffer{}
+	encoder := codec.NewEncoder()
+	for _, content := range g.shaToContent {
+		encoder.Add(content)
+	}
+	encoder.SetDictionary(g.shaToContent[g.dictionarySha()])
 
-	var err error
-	var writer *zlib.Writer
-	if writer, err = zlib.NewWriterLevel(buf, zlib.BestCompression); err != nil {
+	buf := &bytes.Buffer{}
+	if err := encoder.Encode(buf); err != nil {
 		return err
 	}
+	g.compressed = buf.Bytes()
 
-	var shaKeys []string
-	for k := range g.shaToContent {
-		shaKeys = append(shaKeys, k)
-	}
-	sort.Strings(shaKeys)
+	return nil
+}
 
-	if err = binary.Write(writer, binary.BigEndian, uint32(len(g.shaToContent))); err != nil {
-		return err
+// dictionarySha returns the sha of the content shared by the most tags, which is used
+// as the compression dictionary for every entry.
+func (g *gitVer) dictionarySha() (sha string) {
+	counts := map[string]int{}
+	for _, tagSha := range g.tagMapping {
+		counts[tagSha]++
 	}
-
-	for _, key := range shaKeys {
-		content := g.shaToContent[key]
-		contentLength := int64(len(content))
-		shaBytes := shautil.ShaBytes(content)
-		if _, err = writer.Write(shaBytes); err != nil {
-			return err
+	for tagSha, count := range counts {
+		if count > counts[sha] || (count == counts[sha] && tagSha < sha) {
+			sha = tagSha
 		}
-		if err = binary.Write(writer, binary.BigEndian, contentLength); err != nil {
-			return err
-		}
-		if _, err = writer.Write(content); err != nil {
-			return err
-		}
-	}
-	if err = writer.Flush(); err != nil {
-		return err
 	}
-	if err = writer.Close(); err != nil {
-		return err
-	}
-	g.compressed = buf.Bytes()
-
-	return err
+	return sha
 }
 
 func (g *gitVer) tagToSha(sf *sourcefile.SourceFile) {
@@ -1,9 +1,10 @@
-module github.com/mlctrez/wasmexec
+module github.com/mlctrez/wasmexec/magefiles
 
 go 1.18
 
 require (
 	github.com/go-git/go-git/v5 v5.8.1
+	github.com/mlctrez/wasmexec v0.0.0-00010101000000-000000000000
 	github.com/pkg/errors v0.9.1
 	github.com/rogpeppe/go-internal v1.9.0
 )
@@ -31,3 +32,5 @@ require (
 	golang.org/x/tools v0.6.0 // indirect
 	gopkg.in/warnings.v0 v0.1.2 // indirect
 )
+
+replace github.com/mlctrez/wasmexec => ../
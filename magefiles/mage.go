@@ -2,10 +2,10 @@ package main
 
 import (
 	"fmt"
-	"github.com/mlctrez/wasmexec/gitutil"
-	"github.com/mlctrez/wasmexec/gitver"
-	"github.com/mlctrez/wasmexec/shautil"
-	"github.com/mlctrez/wasmexec/sourcefile"
+	"github.com/mlctrez/wasmexec/magefiles/gitutil"
+	"github.com/mlctrez/wasmexec/magefiles/gitver"
+	"github.com/mlctrez/wasmexec/magefiles/shautil"
+	"github.com/mlctrez/wasmexec/magefiles/sourcefile"
 	"github.com/pkg/errors"
 	"os"
 	"os/exec"
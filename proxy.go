@@ -0,0 +1,289 @@
+package wasmexec
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultProxyURL is the module proxy used by a ProxyFetcher without a ProxyURL.
+const DefaultProxyURL = "https://proxy.golang.org"
+
+// DefaultPlatform is the toolchain module platform downloaded by a ProxyFetcher without a Platform.
+// wasm_exec.js is identical in every platform's toolchain.
+const DefaultPlatform = "linux-amd64"
+
+// DefaultProxyTimeout bounds each request of a ProxyFetcher without a Client, including
+// reading the response body.
+const DefaultProxyTimeout = 2 * time.Minute
+
+var defaultProxyClient = &http.Client{Timeout: DefaultProxyTimeout}
+
+var toolchainVersionPattern = regexp.MustCompile(`^go[0-9]+(\.[0-9]+)*((beta|rc)[0-9]+)?$`)
+
+// ProxyFetcher downloads wasm_exec.js from the golang.org/toolchain module zip served by a module proxy.
+//
+// The zip is read with range requests when the proxy supports them so only the central directory
+// and the wasm_exec.js entry are transferred. Fetched files are cached on disk by version and are
+// checked against the embedded sha for versions in tagToShaMap.
+type ProxyFetcher struct {
+	// ProxyURL is the base url of the module proxy, DefaultProxyURL when empty.
+	ProxyURL string
+	// CacheDir holds fetched files, a wasmexec directory in os.UserCacheDir when empty.
+	CacheDir string
+	// Platform is the os-arch suffix of the toolchain module, DefaultPlatform when empty.
+	Platform string
+	// Client is used for requests, a client with DefaultProxyTimeout when nil.
+	Client *http.Client
+}
+
+// Fetch returns the wasm_exec.js for version from the disk cache or the module proxy.
+func (f *ProxyFetcher) Fetch(ctx context.Context, version string) (contents []byte, err error) {
+	if !toolchainVersionPattern.MatchString(version) {
+		return nil, fmt.Errorf("%w %q: invalid toolchain version", ErrUnknownVersion, version)
+	}
+
+	var cacheFile string
+	if cacheFile, err = f.cacheFile(version); err != nil {
+		return nil, err
+	}
+	if contents, err = os.ReadFile(cacheFile); err == nil {
+		if err = verifyFetched(version, contents); err == nil {
+			return contents, nil
+		}
+	}
+
+	if contents, err = f.download(ctx, version); err != nil {
+		return nil, err
+	}
+	if err = verifyFetched(version, contents); err != nil {
+		return nil, err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(cacheFile), 0755); err != nil {
+		return nil, err
+	}
+	if err = writeFileAtomic(cacheFile, contents); err != nil {
+		return nil, err
+	}
+	return contents, nil
+}
+
+func (f *ProxyFetcher) cacheFile(version string) (string, error) {
+	cacheDir := f.CacheDir
+	if cacheDir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		cacheDir = filepath.Join(userCacheDir, "wasmexec")
+	}
+	return filepath.Join(cacheDir, version, "wasm_exec.js"), nil
+}
+
+func (f *ProxyFetcher) client() *http.Client {
+	if f.Client != nil {
+		return f.Client
+	}
+	return defaultProxyClient
+}
+
+func (f *ProxyFetcher) download(ctx context.Context, version string) (contents []byte, err error) {
+	proxyURL, platform := f.ProxyURL, f.Platform
+	if proxyURL == "" {
+		proxyURL = DefaultProxyURL
+	}
+	if platform == "" {
+		platform = DefaultPlatform
+	}
+	moduleVersion := fmt.Sprintf("v0.0.1-%s.%s", version, platform)
+	zipURL := fmt.Sprintf("%s/golang.org/toolchain/@v/%s.zip", strings.TrimSuffix(proxyURL, "/"), moduleVersion)
+
+	var reader io.ReaderAt
+	var size int64
+	if reader, size, err = f.openZip(ctx, zipURL); err != nil {
+		return nil, err
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		defer func() { _ = closer.Close() }()
+	}
+
+	var zipReader *zip.Reader
+	if zipReader, err = zip.NewReader(reader, size); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", zipURL, err)
+	}
+
+	prefix := "golang.org/toolchain@" + moduleVersion + "/"
+	for _, wasmExecPath := range wasmExecPaths {
+		for _, file := range zipReader.File {
+			if file.Name != prefix+wasmExecPath {
+				continue
+			}
+			if file.UncompressedSize64 > maxFetchedSize {
+				return nil, fmt.Errorf("%s in %s is %d bytes", file.Name, zipURL, file.UncompressedSize64)
+			}
+			var entry io.ReadCloser
+			if entry, err = file.Open(); err != nil {
+				return nil, err
+			}
+			defer func() { _ = entry.Close() }()
+			return io.ReadAll(io.LimitReader(entry, maxFetchedSize))
+		}
+	}
+	return nil, fmt.Errorf("wasm_exec.js not found in %s", zipURL)
+}
+
+const maxFetchedSize = 1 << 20
+
+// openZip returns a ReaderAt for the zip at zipURL. Servers that support range requests are
+// read in place, otherwise the zip is downloaded to a temporary file.
+func (f *ProxyFetcher) openZip(ctx context.Context, zipURL string) (reader io.ReaderAt, size int64, err error) {
+	var request *http.Request
+	if request, err = http.NewRequestWithContext(ctx, http.MethodHead, zipURL, nil); err != nil {
+		return nil, 0, err
+	}
+	var response *http.Response
+	if response, err = f.client().Do(request); err != nil {
+		return nil, 0, err
+	}
+	_ = response.Body.Close()
+	if response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusGone {
+		return nil, 0, fmt.Errorf("%w: fetching %s: %s", ErrUnknownVersion, zipURL, response.Status)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("fetching %s: %s", zipURL, response.Status)
+	}
+
+	if response.Header.Get("Accept-Ranges") == "bytes" && response.ContentLength > 0 {
+		return &httpReaderAt{ctx: ctx, client: f.client(), url: response.Request.URL.String()}, response.ContentLength, nil
+	}
+
+	if request, err = http.NewRequestWithContext(ctx, http.MethodGet, zipURL, nil); err != nil {
+		return nil, 0, err
+	}
+	if response, err = f.client().Do(request); err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("fetching %s: %s", zipURL, response.Status)
+	}
+
+	var file *os.File
+	if file, err = os.CreateTemp("", "wasmexec-*.zip"); err != nil {
+		return nil, 0, err
+	}
+	if size, err = io.Copy(file, response.Body); err != nil {
+		_ = tempFile{file}.Close()
+		return nil, 0, err
+	}
+	return tempFile{file}, size, nil
+}
+
+// tempFile removes the file when closed.
+type tempFile struct {
+	*os.File
+}
+
+func (t tempFile) Close() error {
+	err := t.File.Close()
+	_ = os.Remove(t.Name())
+	return err
+}
+
+// httpReaderAt reads a remote file with range requests, fetching at least httpReadAhead
+// bytes at a time since the zip reader reads the central directory in small pieces.
+type httpReaderAt struct {
+	ctx    context.Context
+	client *http.Client
+	url    string
+
+	offset int64
+	buffer []byte
+}
+
+const httpReadAhead = 1 << 20
+
+func (h *httpReaderAt) ReadAt(p []byte, off int64) (n int, err error) {
+	for n < len(p) {
+		position := off + int64(n)
+		if position >= h.offset && position < h.offset+int64(len(h.buffer)) {
+			n += copy(p[n:], h.buffer[position-h.offset:])
+			continue
+		}
+		length := int64(len(p) - n)
+		if length < httpReadAhead {
+			length = httpReadAhead
+		}
+		if err = h.fetch(position, length); err != nil {
+			return n, err
+		}
+		if len(h.buffer) == 0 {
+			return n, io.EOF
+		}
+	}
+	return n, nil
+}
+
+func (h *httpReaderAt) fetch(offset, length int64) (err error) {
+	var request *http.Request
+	if request, err = http.NewRequestWithContext(h.ctx, http.MethodGet, h.url, nil); err != nil {
+		return err
+	}
+	request.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-"+strconv.FormatInt(offset+length-1, 10))
+
+	var response *http.Response
+	if response, err = h.client.Do(request); err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	switch response.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusRequestedRangeNotSatisfiable:
+		h.offset, h.buffer = offset, nil
+		return nil
+	default:
+		return fmt.Errorf("range request for %s: %s", h.url, response.Status)
+	}
+
+	h.offset = offset
+	h.buffer, err = io.ReadAll(io.LimitReader(response.Body, length))
+	return err
+}
+
+// verifyFetched checks contents against the embedded sha when version is known.
+func verifyFetched(version string, contents []byte) error {
+	if wantedSha := TagToSha(version); wantedSha != "" && wantedSha != shaString(contents) {
+		return fmt.Errorf("%w: fetched %s does not match %s", ErrShaMismatch, version, wantedSha)
+	}
+	return nil
+}
+
+func writeFileAtomic(name string, contents []byte) (err error) {
+	var file *os.File
+	if file, err = os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".*"); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(file.Name())
+		}
+	}()
+	if _, err = file.Write(contents); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), name)
+}
@@ -0,0 +1,105 @@
+package wasmexec
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// toolchainProxy serves synthetic golang.org/toolchain module zips holding the given wasm_exec.js by version.
+func toolchainProxy(t *testing.T, ranges bool, contents map[string]string) (server *httptest.Server, requests *int32) {
+	requests = new(int32)
+	server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt32(requests, 1)
+		for version, content := range contents {
+			moduleVersion := "v0.0.1-" + version + ".linux-amd64"
+			if request.URL.Path != "/golang.org/toolchain/@v/"+moduleVersion+".zip" {
+				continue
+			}
+			buf := &bytes.Buffer{}
+			zipWriter := zip.NewWriter(buf)
+			for name, data := range map[string]string{
+				"lib/wasm/wasm_exec.js": content,
+				"VERSION":               version,
+				"src/runtime/extern.go": strings.Repeat("package runtime\n", 1000),
+			} {
+				w, err := zipWriter.Create("golang.org/toolchain@" + moduleVersion + "/" + name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				_, _ = w.Write([]byte(data))
+			}
+			if err := zipWriter.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if ranges {
+				http.ServeContent(writer, request, "toolchain.zip", time.Time{}, bytes.NewReader(buf.Bytes()))
+			} else if request.Method == http.MethodGet {
+				_, _ = writer.Write(buf.Bytes())
+			}
+			return
+		}
+		http.NotFound(writer, request)
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+func TestProxyFetcher(t *testing.T) {
+	for _, ranges := range []bool{true, false} {
+		server, requests := toolchainProxy(t, ranges, map[string]string{"go1.99.0": "fetched"})
+		fetcher := &ProxyFetcher{ProxyURL: server.URL, CacheDir: t.TempDir()}
+
+		content, err := fetcher.Fetch(context.Background(), "go1.99.0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != "fetched" {
+			t.Fatalf("expected fetched content, got %q", content)
+		}
+
+		made := atomic.LoadInt32(requests)
+		if content, err = fetcher.Fetch(context.Background(), "go1.99.0"); err != nil || string(content) != "fetched" {
+			t.Fatalf("expected cached content, got %q %v", content, err)
+		}
+		if atomic.LoadInt32(requests) != made {
+			t.Fatal("expected cached content without requests")
+		}
+
+		if _, err = fetcher.Fetch(context.Background(), "go1.99.1"); err == nil {
+			t.Fatal("expected error for a version the proxy does not have")
+		}
+	}
+}
+
+func TestProxyFetcherVerifiesKnownVersions(t *testing.T) {
+	server, _ := toolchainProxy(t, true, map[string]string{"go1.21.0": "tampered"})
+	fetcher := &ProxyFetcher{ProxyURL: server.URL, CacheDir: t.TempDir()}
+	if _, err := fetcher.Fetch(context.Background(), "go1.21.0"); !errors.Is(err, ErrShaMismatch) {
+		t.Fatalf("expected %v, got %v", ErrShaMismatch, err)
+	}
+	if _, err := fetcher.Fetch(context.Background(), "../go1.21.0"); err == nil {
+		t.Fatal("expected error for an invalid version")
+	}
+}
+
+func TestUseProxy(t *testing.T) {
+	server, _ := toolchainProxy(t, true, map[string]string{"go1.98.0": "proxied"})
+	UseProxy(&ProxyFetcher{ProxyURL: server.URL, CacheDir: t.TempDir()})
+	t.Cleanup(func() { UseProxy(nil) })
+
+	content, err := Version("go1.98.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "proxied" {
+		t.Fatalf("expected proxied content, got %q", content)
+	}
+}
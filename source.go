@@ -0,0 +1,205 @@
+package wasmexec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+)
+
+// ErrUnknownVersion is returned when no source has a wasm_exec.js for a version.
+// A Source returns it to let a Resolver try the next source.
+var ErrUnknownVersion = errors.New("wasmexec: unsupported version")
+
+// Info describes a wasm_exec.js returned by a Source.
+type Info struct {
+	// Version is the version that was served.
+	Version string
+	// Source names the Source that provided the content.
+	Source string
+	Sha    string
+	Size   int
+	// Path is the path of wasm_exec.js in the toolchain, misc/wasm/wasm_exec.js before go1.24
+	// and lib/wasm/wasm_exec.js after.
+	Path string
+	// Tags lists the tags in the embedded table with the same content.
+	Tags TagList
+	// Requested is the version asked of a Store, which differs from Version when the
+	// request was an alias, was normalized to a release tag or was served by the FallbackPolicy.
+	Requested string
+	// Fallback is set when the FallbackPolicy served a different version than requested.
+	Fallback bool
+}
+
+// Source provides the wasm_exec.js for a go version.
+type Source interface {
+	// Lookup returns the content for version, or an error wrapping ErrUnknownVersion
+	// when the source does not have it.
+	Lookup(ctx context.Context, version string) ([]byte, Info, error)
+}
+
+// Resolver tries each of its sources in order and returns the first answer.
+type Resolver struct {
+	sources []Source
+}
+
+func NewResolver(sources ...Source) *Resolver {
+	return &Resolver{sources: sources}
+}
+
+// Lookup returns the content from the first source that has version. When no source has it,
+// the first error other than ErrUnknownVersion is returned.
+func (r *Resolver) Lookup(ctx context.Context, version string) (contents []byte, info Info, err error) {
+	var firstErr error
+	for _, source := range r.sources {
+		if contents, info, err = source.Lookup(ctx, version); err == nil {
+			return contents, info, nil
+		}
+		if firstErr == nil && !errors.Is(err, ErrUnknownVersion) {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return nil, Info{}, firstErr
+	}
+	return nil, Info{}, fmt.Errorf("%w %q", ErrUnknownVersion, version)
+}
+
+func newInfo(version, source string, contents []byte) Info {
+	sha := shaString(contents)
+	return Info{
+		Version: version, Source: source, Sha: sha, Size: len(contents),
+		Path: wasmExecPath(version), Tags: tagsFor(sha),
+	}
+}
+
+type embeddedSource struct{}
+
+// EmbeddedSource returns the Source for the wasm_exec.js contents embedded in this module.
+func EmbeddedSource() Source {
+	return embeddedSource{}
+}
+
+func (embeddedSource) Lookup(_ context.Context, version string) (contents []byte, info Info, err error) {
+	if contents, err = readContents(version); err != nil {
+		return nil, Info{}, err
+	}
+	return contents, newInfo(version, "embedded", contents), nil
+}
+
+type goRootSource struct {
+	dir string
+}
+
+// GoRootSource returns a Source for the toolchain installed in dir. It only answers
+// for the version in the VERSION file of dir.
+func GoRootSource(dir string) Source {
+	return goRootSource{dir: dir}
+}
+
+func (s goRootSource) Lookup(_ context.Context, version string) (contents []byte, info Info, err error) {
+	if goRootVersion(s.dir) != version {
+		return nil, Info{}, fmt.Errorf("%w %q in %s", ErrUnknownVersion, version, s.dir)
+	}
+	var found string
+	var ok bool
+	if contents, found, ok = readWasmExec(s.dir); !ok {
+		return nil, Info{}, fmt.Errorf("%w %q: no wasm_exec.js in %s", ErrUnknownVersion, version, s.dir)
+	}
+	info = newInfo(version, "goroot", contents)
+	info.Path = found
+	return contents, info, nil
+}
+
+type fsSource struct {
+	fsys fs.FS
+}
+
+// FSSource returns a Source reading <version>/wasm_exec.js from fsys.
+func FSSource(fsys fs.FS) Source {
+	return fsSource{fsys: fsys}
+}
+
+func (s fsSource) Lookup(_ context.Context, version string) (contents []byte, info Info, err error) {
+	name := path.Join(version, "wasm_exec.js")
+	if !fs.ValidPath(name) {
+		return nil, Info{}, fmt.Errorf("%w %q", ErrUnknownVersion, version)
+	}
+	if contents, err = fs.ReadFile(s.fsys, name); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, Info{}, fmt.Errorf("%w %q: %v", ErrUnknownVersion, version, err)
+		}
+		return nil, Info{}, err
+	}
+	return contents, newInfo(version, "fs", contents), nil
+}
+
+// StaticSource is a Source of registered contents. The zero value is ready to use.
+type StaticSource struct {
+	mu       sync.RWMutex
+	contents map[string][]byte
+}
+
+// Register sets the content returned for version.
+func (s *StaticSource) Register(version string, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.contents == nil {
+		s.contents = map[string][]byte{}
+	}
+	s.contents[version] = content
+}
+
+func (s *StaticSource) Lookup(_ context.Context, version string) ([]byte, Info, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	contents, ok := s.contents[version]
+	if !ok {
+		return nil, Info{}, fmt.Errorf("%w %q", ErrUnknownVersion, version)
+	}
+	return contents, newInfo(version, "static", contents), nil
+}
+
+type localToolchainSource struct{}
+
+// LocalToolchainSource returns a Source for toolchains installed in GOROOT, downloaded
+// by GOTOOLCHAIN into GOMODCACHE or installed by golang.org/dl into ~/sdk.
+func LocalToolchainSource() Source {
+	return localToolchainSource{}
+}
+
+func (localToolchainSource) Lookup(_ context.Context, version string) ([]byte, Info, error) {
+	if !toolchainVersionPattern.MatchString(version) {
+		return nil, Info{}, fmt.Errorf("%w %q: invalid toolchain version", ErrUnknownVersion, version)
+	}
+	for _, dir := range localToolchainDirs(version) {
+		if contents, found, ok := readWasmExec(dir); ok {
+			info := newInfo(version, "local", contents)
+			info.Path = found
+			return contents, info, nil
+		}
+	}
+	return nil, Info{}, fmt.Errorf("%w %q: no local toolchain", ErrUnknownVersion, version)
+}
+
+// Lookup makes a ProxyFetcher a Source.
+func (f *ProxyFetcher) Lookup(ctx context.Context, version string) (contents []byte, info Info, err error) {
+	if contents, err = f.Fetch(ctx, version); err != nil {
+		return nil, Info{}, err
+	}
+	return contents, newInfo(version, "proxy", contents), nil
+}
+
+// readWasmExec reads wasm_exec.js from the toolchain in dir and returns the path it was found at.
+func readWasmExec(dir string) (contents []byte, found string, ok bool) {
+	for _, wasmExecPath := range wasmExecPaths {
+		if data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(wasmExecPath))); err == nil {
+			return data, wasmExecPath, true
+		}
+	}
+	return nil, "", false
+}
@@ -0,0 +1,79 @@
+package wasmexec
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+type failingSource struct{}
+
+func (failingSource) Lookup(context.Context, string) ([]byte, Info, error) {
+	return nil, Info{}, errors.New("source unavailable")
+}
+
+func TestResolver(t *testing.T) {
+	static := &StaticSource{}
+	static.Register("go1.21.0", []byte("patched"))
+	fsys := fstest.MapFS{"go1.99.0/wasm_exec.js": {Data: []byte("from fs")}}
+
+	goRoot := t.TempDir()
+	for name, content := range map[string]string{"VERSION": "go1.99.1\ntime 2030-01-01", "lib/wasm/wasm_exec.js": "from goroot"} {
+		path := filepath.Join(goRoot, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resolver := NewResolver(static, FSSource(fsys), GoRootSource(goRoot), EmbeddedSource())
+	tests := []struct {
+		version, source string
+		content         string
+	}{
+		{"go1.21.0", "static", "patched"},
+		{"go1.99.0", "fs", "from fs"},
+		{"go1.99.1", "goroot", "from goroot"},
+		{"go1.20", "embedded", ""},
+	}
+	for _, test := range tests {
+		content, info, err := resolver.Lookup(context.Background(), test.version)
+		if err != nil {
+			t.Fatal(test.version, err)
+		}
+		if info.Source != test.source || info.Version != test.version || info.Sha != shaString(content) {
+			t.Errorf("%s: unexpected info %+v", test.version, info)
+		}
+		if test.content != "" && string(content) != test.content {
+			t.Errorf("%s: expected %q, got %q", test.version, test.content, content)
+		}
+	}
+
+	if _, _, err := resolver.Lookup(context.Background(), "go1.99.2"); !errors.Is(err, ErrUnknownVersion) {
+		t.Fatalf("expected %v, got %v", ErrUnknownVersion, err)
+	}
+	_, _, err := NewResolver(failingSource{}, EmbeddedSource()).Lookup(context.Background(), "go1.99.2")
+	if err == nil || err.Error() != "source unavailable" {
+		t.Fatalf("expected the source error, got %v", err)
+	}
+}
+
+func TestAddSource(t *testing.T) {
+	static := &StaticSource{}
+	static.Register("go1.21.0", []byte("patched"))
+	AddSource(static)
+	t.Cleanup(func() { configure(func() { addedSources = nil }) })
+
+	content, info, err := VersionInfo("go1.21.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "patched" || info.Source != "static" {
+		t.Fatalf("expected the added source to answer, got %q from %q", content, info.Source)
+	}
+}
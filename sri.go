@@ -0,0 +1,98 @@
+package wasmexec
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"html/template"
+	"runtime"
+)
+
+// integrity returns the sha384 subresource integrity value of contents.
+func integrity(contents []byte) string {
+	sum := sha512.Sum384(contents)
+	return "sha384-" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// Integrity returns the subresource integrity value of the wasm_exec.js for version.
+func Integrity(version string) (string, error) {
+	return defaultStore.Integrity(version)
+}
+
+// Integrity returns the subresource integrity value of the wasm_exec.js for version.
+func (s *Store) Integrity(version string) (string, error) {
+	contents, err := s.Version(version)
+	if err != nil {
+		return "", err
+	}
+	return integrity(contents), nil
+}
+
+// LauncherIntegrity returns the subresource integrity value of the script written by WriteLauncher
+// with the same options.
+func LauncherIntegrity(options ...LauncherOptions) (string, error) {
+	data, err := launcherScript(context.Background(), defaultStore, runtime.Version(), firstOptions(options))
+	if err != nil {
+		return "", err
+	}
+	return integrity(data), nil
+}
+
+// Integrity returns the subresource integrity value of the wasm_exec.js the Handler serves,
+// the launcher when Launcher is set.
+func (h *Handler) Integrity() (string, error) {
+	scripts, err := h.scripts(context.Background(), h.version())
+	if err != nil {
+		return "", err
+	}
+	return integrity(scripts[0].contents), nil
+}
+
+// ScriptOptions configures the element rendered by ScriptTag.
+type ScriptOptions struct {
+	// Src is the script URL, the ContentURL under Base when empty.
+	Src string
+	// Base is the path an Immutable Handler is mounted at.
+	Base string
+	// CrossOrigin is the crossorigin attribute, anonymous when empty.
+	CrossOrigin string
+	Async       bool
+	Defer       bool
+}
+
+var scriptTemplate = template.Must(template.New("script").Parse(
+	`<script src="{{.Src}}" integrity="{{.Integrity}}" crossorigin="{{.CrossOrigin}}"` +
+		`{{if .Async}} async{{end}}{{if .Defer}} defer{{end}}></script>`))
+
+// ScriptTag renders a script element loading the wasm_exec.js for version with its integrity value.
+func ScriptTag(version string, options ScriptOptions) (template.HTML, error) {
+	return defaultStore.ScriptTag(version, options)
+}
+
+// ScriptTag renders a script element loading the wasm_exec.js for version with its integrity value.
+func (s *Store) ScriptTag(version string, options ScriptOptions) (tag template.HTML, err error) {
+	var contents []byte
+	if contents, err = s.Version(version); err != nil {
+		return "", err
+	}
+	if options.Src == "" {
+		if options.Src, err = contentURL(s, options.Base, version); err != nil {
+			return "", err
+		}
+	}
+	if options.CrossOrigin == "" {
+		options.CrossOrigin = "anonymous"
+	}
+
+	buf := &bytes.Buffer{}
+	err = scriptTemplate.Execute(buf, struct {
+		ScriptOptions
+		Integrity string
+	}{options, integrity(contents)})
+	if err != nil {
+		return "", err
+	}
+	// the template escapes every attribute
+	return template.HTML(buf.String()), nil
+}
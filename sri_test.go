@@ -0,0 +1,72 @@
+package wasmexec
+
+import (
+	"crypto/sha512"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIntegrity(t *testing.T) {
+	contents, err := Version("go1.21.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha512.Sum384(contents)
+	want := "sha384-" + base64.StdEncoding.EncodeToString(sum[:])
+	if got, err := Integrity("go1.21.5"); err != nil || got != want {
+		t.Fatalf("expected %s, got %s %v", want, got, err)
+	}
+	if _, err = Integrity("go0.1"); err == nil {
+		t.Fatal("expected error for unknown version")
+	}
+}
+
+func TestLauncherIntegrity(t *testing.T) {
+	// serve a release of the same minor when the running toolchain is newer than the table
+	UseFallback(FallbackSameMinor)
+	t.Cleanup(func() { UseFallback(FallbackExact) })
+
+	recorder := httptest.NewRecorder()
+	WriteLauncher(recorder)
+	if got, err := LauncherIntegrity(); err != nil || got != integrity(recorder.Body.Bytes()) {
+		t.Fatalf("integrity %s does not match the launcher %v", got, err)
+	}
+}
+
+func TestHandlerIntegrity(t *testing.T) {
+	for _, handler := range []*Handler{
+		{Version: "go1.21.5"},
+		{Version: "go1.21.5", Launcher: true, LauncherOptions: LauncherOptions{Args: []string{"js", "-v"}}},
+		{Version: "go1.21.5", Launcher: true, LauncherOptions: LauncherOptions{Worker: true}},
+	} {
+		response := serve(handler, http.MethodGet, "/wasm_exec.js", nil)
+		if got, err := handler.Integrity(); err != nil || got != integrity(response.Body.Bytes()) {
+			t.Fatalf("integrity %s does not match the served script %v", got, err)
+		}
+	}
+}
+
+func TestScriptTag(t *testing.T) {
+	sri, _ := Integrity("go1.21.5")
+	url, _ := ContentURL("/static", "go1.21.5")
+
+	tag, err := ScriptTag("go1.21.5", ScriptOptions{Base: "/static", Defer: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<script src="` + url + `" integrity="` + sri + `" crossorigin="anonymous" defer></script>`
+	if string(tag) != want {
+		t.Fatalf("expected %s, got %s", want, tag)
+	}
+
+	tag, err = ScriptTag("go1.21.5", ScriptOptions{Src: `javascript:alert("x")`, CrossOrigin: `" onload="alert(1)`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(tag), "javascript:") || strings.Contains(string(tag), `" onload`) {
+		t.Fatalf("attributes are not escaped: %s", tag)
+	}
+}
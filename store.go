@@ -0,0 +1,282 @@
+package wasmexec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/mlctrez/wasmexec/gover"
+)
+
+// Logger receives a line for every lookup a Store makes to its sources. *log.Logger implements it.
+type Logger interface {
+	Printf(format string, v ...any)
+}
+
+// Store looks up wasm_exec.js contents through its sources and caches the results.
+// The package level functions use a default Store.
+//
+// Cached versions are served under a shared read lock. Concurrent lookups of the same version
+// share one call to the sources, and lookups never block requests for other versions.
+type Store struct {
+	source Source
+	logger Logger
+
+	mu         sync.RWMutex
+	fallback   FallbackPolicy
+	cache      *contentCache
+	inflight   map[string]*lookupCall
+	generation int
+}
+
+// lookupCall is a source lookup shared by every request for a version while it runs.
+type lookupCall struct {
+	done     chan struct{}
+	contents []byte
+	info     Info
+	err      error
+}
+
+// StoreOption configures a Store.
+type StoreOption func(s *Store)
+
+// WithSources sets the sources a Store tries in order, EmbeddedSource by default.
+func WithSources(sources ...Source) StoreOption {
+	return func(s *Store) { s.source = NewResolver(sources...) }
+}
+
+// WithCachePolicy sets how a Store caches contents, every version is kept by default.
+func WithCachePolicy(policy CachePolicy) StoreOption {
+	return func(s *Store) { s.cache = newContentCache(policy) }
+}
+
+// WithFallback sets the FallbackPolicy for versions no source has, FallbackExact by default.
+func WithFallback(policy FallbackPolicy) StoreOption {
+	return func(s *Store) { s.fallback = policy }
+}
+
+// WithLogger sets the Logger a Store reports lookups to.
+func WithLogger(logger Logger) StoreOption {
+	return func(s *Store) { s.logger = logger }
+}
+
+func NewStore(options ...StoreOption) *Store {
+	s := &Store{source: EmbeddedSource(), cache: newContentCache(CachePolicy{}), inflight: map[string]*lookupCall{}}
+	for _, option := range options {
+		option(s)
+	}
+	return s
+}
+
+// Current returns the wasm_exec.js for the go runtime version.
+func (s *Store) Current() (content []byte, err error) {
+	return s.Version(runtime.Version())
+}
+
+// Version returns the wasm_exec.js for version.
+func (s *Store) Version(version string) (contents []byte, err error) {
+	contents, _, err = s.VersionInfo(version)
+	return contents, err
+}
+
+// Lookup returns the Info for version without its content.
+func (s *Store) Lookup(version string) (info Info, err error) {
+	_, info, err = s.VersionInfo(version)
+	return info, err
+}
+
+// VersionInfo returns the wasm_exec.js for version and the Info of the Source that provided it.
+// Version may be an alias accepted by Resolve, Info.Version is then the tag it resolved to.
+func (s *Store) VersionInfo(version string) (contents []byte, info Info, err error) {
+	return s.VersionInfoContext(context.Background(), version)
+}
+
+// VersionInfoContext is VersionInfo with a context passed to the sources. A caller waiting
+// for the lookup of another caller stops waiting when ctx is done, and looks up again
+// when the other caller gave up.
+func (s *Store) VersionInfoContext(ctx context.Context, version string) (contents []byte, info Info, err error) {
+	for {
+		s.mu.RLock()
+		entry, ok := s.cache.get(version)
+		s.mu.RUnlock()
+		if ok {
+			return entry.contents, entry.info, nil
+		}
+
+		s.mu.Lock()
+		if entry, ok = s.cache.get(version); ok {
+			s.mu.Unlock()
+			return entry.contents, entry.info, nil
+		}
+		call, ok := s.inflight[version]
+		if !ok {
+			break
+		}
+		s.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, Info{}, ctx.Err()
+		}
+		if ctx.Err() == nil && (errors.Is(call.err, context.Canceled) || errors.Is(call.err, context.DeadlineExceeded)) {
+			continue
+		}
+		return call.contents, call.info, call.err
+	}
+
+	call := &lookupCall{done: make(chan struct{})}
+	s.inflight[version] = call
+	generation, fallback := s.generation, s.fallback
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.inflight, version)
+		// a Flush during the lookup may have been meant to drop this result
+		if call.err == nil && generation == s.generation {
+			s.cache.put(&cacheEntry{version: version, contents: call.contents, info: call.info})
+		}
+		s.mu.Unlock()
+		close(call.done)
+	}()
+
+	// waiters see this error if the source panics
+	call.err = fmt.Errorf("wasmexec: lookup %s did not complete", version)
+	call.contents, call.info, call.err = s.lookup(ctx, version, fallback)
+	return call.contents, call.info, call.err
+}
+
+// lookup asks the sources for version, or for the tag it names when it is an alias.
+// A version the sources do not know is retried as its release tag, so go1.21.0 X:boringcrypto
+// is served the go1.21.0 content, and then as the version chosen by the fallback policy.
+func (s *Store) lookup(ctx context.Context, version string, fallback FallbackPolicy) (contents []byte, info Info, err error) {
+	if tag, ok := resolveAlias(version); ok {
+		contents, info, err = s.source.Lookup(ctx, tag)
+	} else {
+		contents, info, err = s.source.Lookup(ctx, version)
+	}
+	if errors.Is(err, ErrUnknownVersion) {
+		if parsed, parseErr := gover.Parse(version); parseErr == nil && parsed.Tag() != "" && parsed.Tag() != version {
+			contents, info, err = s.source.Lookup(ctx, parsed.Tag())
+		}
+	}
+	if errors.Is(err, ErrUnknownVersion) {
+		if tag, ok := fallback.version(version); ok {
+			var fallbackErr error
+			if contents, info, fallbackErr = s.source.Lookup(ctx, tag); fallbackErr == nil {
+				err, info.Fallback = nil, true
+				s.logf("wasmexec: serving %s for unknown version %s by %s fallback", tag, version, fallback)
+			}
+		}
+	}
+	if err != nil {
+		s.logf("wasmexec: lookup %s failed: %v", version, err)
+		return nil, Info{}, err
+	}
+	info.Requested = version
+	s.logf("wasmexec: lookup %s answered by %s sha %s", version, info.Source, info.Sha)
+	return contents, info, nil
+}
+
+// Flush drops every cached content.
+func (s *Store) Flush() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.flush()
+	s.generation++
+}
+
+func (s *Store) logf(format string, v ...any) {
+	if s.logger != nil {
+		s.logger.Printf(format, v...)
+	}
+}
+
+var defaultStore = NewStore(WithSources(defaultSource{}))
+
+// DefaultStore returns the Store used by the package level functions.
+func DefaultStore() *Store {
+	return defaultStore
+}
+
+var configMu sync.Mutex
+var addedSources []Source
+var searchLocal bool
+var proxyFetcher *ProxyFetcher
+
+// defaultSource resolves through the sources configured with AddSource,
+// SearchLocalToolchains and UseProxy around the embedded table.
+type defaultSource struct{}
+
+func (defaultSource) Lookup(ctx context.Context, version string) ([]byte, Info, error) {
+	configMu.Lock()
+	sources := append([]Source{}, addedSources...)
+	sources = append(sources, EmbeddedSource())
+	if searchLocal {
+		sources = append(sources, LocalToolchainSource())
+	}
+	if proxyFetcher != nil {
+		sources = append(sources, proxyFetcher)
+	}
+	configMu.Unlock()
+	return NewResolver(sources...).Lookup(ctx, version)
+}
+
+// configure changes the default sources and flushes the default Store.
+func configure(change func()) {
+	configMu.Lock()
+	change()
+	configMu.Unlock()
+	defaultStore.Flush()
+}
+
+// AddSource adds a source that Version consults before the embedded table,
+// after any sources added earlier.
+func AddSource(source Source) {
+	configure(func() { addedSources = append(addedSources, source) })
+}
+
+// SearchLocalToolchains sets whether Version looks for wasm_exec.js in locally installed
+// toolchains when a version is not in the embedded table, see LocalToolchainSource.
+func SearchLocalToolchains(enabled bool) {
+	configure(func() { searchLocal = enabled })
+}
+
+// UseFallback sets the FallbackPolicy of the default Store.
+func UseFallback(policy FallbackPolicy) {
+	defaultStore.mu.Lock()
+	defaultStore.fallback = policy
+	defaultStore.mu.Unlock()
+	defaultStore.Flush()
+}
+
+// UseProxy sets the ProxyFetcher Version uses for versions that are not in the embedded table
+// or a local toolchain. A nil fetcher disables fetching.
+func UseProxy(fetcher *ProxyFetcher) {
+	configure(func() { proxyFetcher = fetcher })
+}
+
+func Current() (content []byte, err error) {
+	return defaultStore.Current()
+}
+
+func Version(version string) (contents []byte, err error) {
+	return defaultStore.Version(version)
+}
+
+// Lookup returns the Info for version without its content.
+func Lookup(version string) (info Info, err error) {
+	return defaultStore.Lookup(version)
+}
+
+// VersionInfoContext is VersionInfo with a context passed to the sources.
+func VersionInfoContext(ctx context.Context, version string) (contents []byte, info Info, err error) {
+	return defaultStore.VersionInfoContext(ctx, version)
+}
+
+// VersionInfo returns the wasm_exec.js for version and the Info of the Source that provided it.
+func VersionInfo(version string) (contents []byte, info Info, err error) {
+	return defaultStore.VersionInfo(version)
+}
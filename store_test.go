@@ -0,0 +1,278 @@
+package wasmexec
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// countingSource counts the lookups that reach it.
+type countingSource struct {
+	Source
+	lookups int32
+}
+
+func (c *countingSource) Lookup(ctx context.Context, version string) ([]byte, Info, error) {
+	atomic.AddInt32(&c.lookups, 1)
+	return c.Source.Lookup(ctx, version)
+}
+
+// blockingSource holds lookups of version until release is closed.
+type blockingSource struct {
+	Source
+	version string
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingSource) Lookup(ctx context.Context, version string) ([]byte, Info, error) {
+	if version == b.version {
+		b.started <- struct{}{}
+		<-b.release
+	}
+	return b.Source.Lookup(ctx, version)
+}
+
+func TestStoreCachePolicy(t *testing.T) {
+	versions := []string{"go1.20", "go1.21.0", "go1.22.0"}
+	tests := []struct {
+		policy  CachePolicy
+		lookups int32
+	}{
+		{CachePolicy{}, 3},
+		{CachePolicy{MaxEntries: 2}, 4},
+		{CachePolicy{Disabled: true}, 6},
+	}
+	for _, test := range tests {
+		source := &countingSource{Source: EmbeddedSource()}
+		store := NewStore(WithSources(source), WithCachePolicy(test.policy))
+		// look up every version and then again in reverse, the second pass is served
+		// from the cache unless the version was evicted
+		for i := 0; i < 2*len(versions); i++ {
+			version := versions[i%len(versions)]
+			if i >= len(versions) {
+				version = versions[2*len(versions)-1-i]
+			}
+			if _, err := store.Version(version); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if source.lookups != test.lookups {
+			t.Errorf("%+v: expected %d lookups, got %d", test.policy, test.lookups, source.lookups)
+		}
+	}
+}
+
+func TestStoreIsolation(t *testing.T) {
+	patched := &StaticSource{}
+	patched.Register("go1.21.0", []byte("patched"))
+	first := NewStore(WithSources(patched, EmbeddedSource()))
+	second := NewStore()
+
+	content, err := first.Version("go1.21.0")
+	if err != nil || string(content) != "patched" {
+		t.Fatalf("expected patched content, got %q %v", content, err)
+	}
+	if content, err = second.Version("go1.21.0"); err != nil || shaString(content) != TagToSha("go1.21.0") {
+		t.Fatalf("expected embedded content, got %v", err)
+	}
+
+	patched.Register("go1.21.0", []byte("patched again"))
+	first.Flush()
+	if content, _ = first.Version("go1.21.0"); string(content) != "patched again" {
+		t.Fatalf("expected flushed store to look up again, got %q", content)
+	}
+}
+
+func TestStoreLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	store := NewStore(WithLogger(log.New(buf, "", 0)))
+	if _, err := store.Version("go1.21.0"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Version("go0.1"); err == nil {
+		t.Fatal("expected error")
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], "answered by embedded") || !strings.Contains(lines[1], "go0.1 failed") {
+		t.Fatalf("unexpected log %q", buf.String())
+	}
+}
+
+func TestStoreSharesConcurrentLookups(t *testing.T) {
+	source := &countingSource{Source: EmbeddedSource()}
+	blocking := &blockingSource{Source: source, version: "go1.21.0", started: make(chan struct{}), release: make(chan struct{})}
+	store := NewStore(WithSources(blocking))
+
+	if _, err := store.Version("go1.20"); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	results := make([][]byte, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = store.Version("go1.21.0")
+		}(i)
+	}
+	<-blocking.started
+
+	// a cached version is served while the cold lookup is in progress
+	if _, err := store.Version("go1.20"); err != nil {
+		t.Fatal(err)
+	}
+
+	close(blocking.release)
+	wg.Wait()
+	for _, result := range results {
+		if shaString(result) != TagToSha("go1.21.0") {
+			t.Fatal("expected go1.21.0 content for every caller")
+		}
+	}
+	if lookups := atomic.LoadInt32(&source.lookups); lookups != 2 {
+		t.Fatalf("expected one lookup per version, got %d", lookups)
+	}
+}
+
+func TestStoreFlushDuringLookup(t *testing.T) {
+	blocking := &blockingSource{Source: EmbeddedSource(), version: "go1.21.0", started: make(chan struct{}), release: make(chan struct{})}
+	source := &countingSource{Source: blocking}
+	store := NewStore(WithSources(source))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = store.Version("go1.21.0")
+	}()
+	<-blocking.started
+	store.Flush()
+	close(blocking.release)
+	<-done
+
+	// the result of a lookup that overlapped a flush is not cached
+	blocking.version = ""
+	if _, err := store.Version("go1.21.0"); err != nil {
+		t.Fatal(err)
+	}
+	if lookups := atomic.LoadInt32(&source.lookups); lookups != 2 {
+		t.Fatalf("expected a second lookup after the flush, got %d", lookups)
+	}
+}
+
+func TestStoreVersionInfoContext(t *testing.T) {
+	blocking := &blockingSource{Source: EmbeddedSource(), version: "go1.21.0", started: make(chan struct{}), release: make(chan struct{})}
+	store := NewStore(WithSources(blocking))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = store.Version("go1.21.0")
+	}()
+	<-blocking.started
+
+	// a caller waiting on the lookup of another stops when its context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := store.VersionInfoContext(ctx, "go1.21.0"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	close(blocking.release)
+	<-done
+	if _, _, err := store.VersionInfoContext(ctx, "go1.21.0"); err != nil {
+		t.Fatalf("expected the cached version regardless of the context, got %v", err)
+	}
+}
+
+func BenchmarkStoreCachedParallel(b *testing.B) {
+	store := NewStore()
+	if _, err := store.Version("go1.21.0"); err != nil {
+		b.Fatal(err)
+	}
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := store.Version("go1.21.0"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkStoreColdParallel(b *testing.B) {
+	var versions []string
+	for version := range tagToShaMap {
+		versions = append(versions, version)
+	}
+	var next int64
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			// every iteration uses a fresh store so each lookup decodes
+			store := NewStore()
+			version := versions[int(atomic.AddInt64(&next, 1))%len(versions)]
+			if _, err := store.Version(version); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestStoreNormalizesVersions(t *testing.T) {
+	store := NewStore()
+	for _, version := range []string{"go1.21.0 X:boringcrypto", " go1.20 "} {
+		_, info, err := store.VersionInfo(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Sha != TagToSha(strings.Fields(version)[0]) {
+			t.Errorf("%q: unexpected info %+v", version, info)
+		}
+	}
+	if _, err := store.Version("go1.99.0 X:boringcrypto"); !errors.Is(err, ErrUnknownVersion) {
+		t.Fatalf("expected %v, got %v", ErrUnknownVersion, err)
+	}
+}
+
+func TestStoreFallback(t *testing.T) {
+	tests := []struct {
+		policy    FallbackPolicy
+		requested string
+		served    string
+	}{
+		{FallbackExact, "go1.21.99", ""},
+		{FallbackSameMinor, "go1.21.99", "go1.21.13"},
+		{FallbackSameMinor, "go1.21.0", "go1.21.0"},
+		{FallbackSameMinor, "go1.99.1", ""},
+		{FallbackLatestBelow, "go1.21.99", "go1.21.13"},
+		{FallbackLatestBelow, "go1.19.99", "go1.19.13"},
+		{FallbackLatestBelow, "go1.0", ""},
+	}
+	for _, test := range tests {
+		buf := &bytes.Buffer{}
+		store := NewStore(WithFallback(test.policy), WithLogger(log.New(buf, "", 0)))
+		_, info, err := store.VersionInfo(test.requested)
+		if test.served == "" {
+			if !errors.Is(err, ErrUnknownVersion) {
+				t.Errorf("%s %s: expected %v, got %v", test.policy, test.requested, ErrUnknownVersion, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s %s: %v", test.policy, test.requested, err)
+			continue
+		}
+		fallback := test.served != test.requested
+		if info.Version != test.served || info.Requested != test.requested || info.Fallback != fallback {
+			t.Errorf("%s %s: unexpected info %+v", test.policy, test.requested, info)
+		}
+		if fallback != strings.Contains(buf.String(), "serving "+test.served) {
+			t.Errorf("%s %s: unexpected log %q", test.policy, test.requested, buf.String())
+		}
+	}
+}
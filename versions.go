@@ -285,940 +285,1204 @@ func TagToSha(tag string) string {
 	return tagToShaMap[tag]
 }
 
-// compressed length 16803
+// compressed length 21557
 var compressed = []byte{
-	0x78, 0xda, 0xec, 0xbd, 0x7b, 0x90, 0x24, 0xd9, 0x5d, 0xdf, 0x2b, 0x10, 0x0a, 0xd1, 0xcd, 0x23, 0x2e, 0x71,
-	0x25, 0x74, 0xaf, 0x41, 0xf2, 0xd9, 0xb6, 0x76, 0x54, 0xa5, 0xa9, 0xa9, 0xea, 0xee, 0x99, 0x5d, 0xed, 0x76,
-	0xd3, 0x2b, 0x66, 0x67, 0x66, 0x47, 0xb3, 0xd2, 0xce, 0x34, 0xd3, 0x23, 0x0d, 0x30, 0x9a, 0x18, 0x9d, 0xaa,
-	0x3c, 0x55, 0x95, 0xdb, 0x99, 0x79, 0x4a, 0xe7, 0x64, 0x76, 0x4f, 0x69, 0xb7, 0x23, 0x0c, 0x41, 0x60, 0x3b,
-	0x02, 0x07, 0x0a, 0x5b, 0x96, 0x85, 0x78, 0xd8, 0x56, 0xd8, 0x96, 0x43, 0x80, 0x80, 0x00, 0x21, 0x5b, 0x08,
-	0xd0, 0xc3, 0xc8, 0x56, 0xf0, 0x92, 0x79, 0x85, 0x02, 0x07, 0x0a, 0x5b, 0x60, 0xb0, 0x25, 0x24, 0x4b, 0x01,
-	0x0e, 0x8c, 0x2d, 0x83, 0xe3, 0xbc, 0x32, 0x4f, 0x3e, 0x2b, 0xb3, 0xdf, 0xdd, 0xf3, 0xdb, 0x3f, 0x76, 0x3a,
-	0xeb, 0xbc, 0x1f, 0x79, 0x5e, 0xf9, 0xfb, 0x7e, 0xce, 0x0b, 0x5e, 0xf0, 0x82, 0x6f, 0xfa, 0xfa, 0x77, 0xfc,
-	0x93, 0x6b, 0xef, 0xfc, 0xcb, 0x7f, 0xf5, 0xce, 0x77, 0xfd, 0xd1, 0x07, 0x7e, 0xe5, 0xfb, 0x3f, 0xb3, 0xfc,
-	0xca, 0x1f, 0x59, 0x75, 0x3f, 0xf8, 0xa1, 0x9f, 0xfc, 0xf9, 0x67, 0x16, 0x5e, 0xc0, 0x7f, 0xed, 0x6b, 0x7e,
-	0xe2, 0x43, 0x2f, 0x79, 0x81, 0xfc, 0xef, 0xc9, 0xb7, 0xf4, 0x7a, 0xe8, 0x12, 0x9d, 0x4c, 0x99, 0x3b, 0x1a,
-	0x87, 0x68, 0x79, 0x71, 0xe9, 0x31, 0x74, 0x6b, 0x4c, 0xd0, 0x55, 0x8a, 0x2e, 0x46, 0xe1, 0x98, 0x32, 0xde,
-	0x45, 0x17, 0x3d, 0x0f, 0x49, 0x67, 0x8e, 0x18, 0xe1, 0x84, 0x6d, 0x11, 0xa7, 0x3b, 0xdf, 0xeb, 0xa1, 0x37,
-	0x72, 0x82, 0xe8, 0x10, 0x85, 0x63, 0x97, 0x23, 0x4e, 0x23, 0x36, 0x20, 0x68, 0x40, 0x1d, 0x82, 0x5c, 0x8e,
-	0x46, 0x74, 0x8b, 0xb0, 0x80, 0x38, 0xa8, 0x3f, 0x45, 0x18, 0x3d, 0xb9, 0x71, 0xf9, 0x1c, 0x0f, 0xa7, 0x1e,
-	0x11, 0xa1, 0x3c, 0x77, 0x40, 0x02, 0x4e, 0x50, 0x38, 0xc6, 0x21, 0x1a, 0xe0, 0x00, 0xf5, 0x09, 0x1a, 0xd2,
-	0x28, 0x70, 0x90, 0x1b, 0xa0, 0x70, 0x4c, 0xd0, 0x1b, 0xae, 0x5d, 0xba, 0x72, 0x7d, 0xe3, 0x0a, 0x1a, 0xba,
-	0x1e, 0xe9, 0xce, 0xcf, 0x2f, 0x44, 0x9c, 0x20, 0x1e, 0x32, 0x77, 0x10, 0x2e, 0xac, 0xce, 0xcf, 0xb7, 0x5a,
-	0x6d, 0xb4, 0xf6, 0x04, 0x7a, 0x6e, 0x7e, 0x6e, 0x40, 0x03, 0x1e, 0x22, 0x12, 0x50, 0x3e, 0xe5, 0x68, 0x0d,
-	0xc5, 0xbf, 0x1b, 0x07, 0xc6, 0xd0, 0x1a, 0x0a, 0xc8, 0x36, 0xba, 0xc2, 0x18, 0x65, 0xad, 0x85, 0x80, 0x86,
-	0xc8, 0xf5, 0x27, 0x1e, 0xf1, 0x49, 0x10, 0x12, 0x67, 0xa1, 0xbd, 0x3a, 0x3f, 0x37, 0x47, 0x18, 0xeb, 0xca,
-	0x4c, 0xaf, 0xa1, 0x85, 0x2b, 0xd7, 0x6f, 0x6c, 0x7c, 0xd7, 0xc6, 0x82, 0xf8, 0x99, 0x91, 0x30, 0x62, 0x81,
-	0x88, 0x63, 0x75, 0x7e, 0x6e, 0x67, 0x75, 0x7e, 0x7e, 0xce, 0x1d, 0xa2, 0xd6, 0x43, 0x23, 0x8f, 0xf6, 0xb1,
-	0x77, 0x6b, 0xec, 0xf2, 0xee, 0x90, 0xb7, 0x65, 0x62, 0x1e, 0x09, 0x11, 0x8d, 0xc2, 0x49, 0x14, 0x3e, 0x19,
-	0x0d, 0x45, 0x2c, 0x32, 0x7c, 0xca, 0x23, 0x5a, 0x93, 0x3e, 0x55, 0xbe, 0x70, 0x10, 0xf2, 0x15, 0xf4, 0x1c,
-	0xba, 0x71, 0xef, 0xf6, 0xcd, 0x1b, 0xd7, 0xdf, 0xf0, 0x5d, 0x2b, 0xe8, 0xdc, 0x52, 0x07, 0xdd, 0xb8, 0x77,
-	0xf3, 0xf2, 0xed, 0x9b, 0xe6, 0xef, 0x4b, 0x37, 0xaf, 0x5c, 0xbc, 0x65, 0x1e, 0x6e, 0xdd, 0x7c, 0xe3, 0xf5,
-	0x4b, 0xe6, 0xe1, 0xe2, 0xfa, 0xfa, 0x95, 0xeb, 0x97, 0xcd, 0xd3, 0x95, 0xef, 0xbc, 0xf4, 0x06, 0xf3, 0xf7,
-	0xe5, 0x6b, 0x37, 0xaf, 0x5c, 0xba, 0x75, 0xe3, 0xa6, 0x8c, 0x10, 0xed, 0x74, 0x50, 0xaf, 0x87, 0xa2, 0x20,
-	0xe2, 0xc4, 0x11, 0x49, 0x6f, 0x33, 0x37, 0x24, 0x1b, 0xd3, 0x60, 0xd0, 0x1a, 0x3a, 0x1d, 0xd4, 0x8f, 0x86,
-	0x2a, 0xf3, 0x73, 0x73, 0x49, 0xd6, 0xcf, 0xae, 0x21, 0x87, 0x88, 0xaa, 0x60, 0x5d, 0xf5, 0x6f, 0x4b, 0x78,
-	0x5b, 0x95, 0xbe, 0x54, 0x8d, 0x06, 0x1e, 0x5a, 0x4b, 0xca, 0xda, 0xf5, 0x30, 0x0f, 0xaf, 0x05, 0x0e, 0xb9,
-	0x7f, 0x63, 0xd8, 0x5a, 0x78, 0x73, 0xb0, 0xa0, 0xfd, 0x8a, 0x8a, 0x0a, 0x3c, 0xf4, 0xd0, 0x1a, 0x3a, 0xb7,
-	0x64, 0x52, 0x91, 0x11, 0x50, 0x8f, 0x74, 0x3d, 0x3a, 0x6a, 0x25, 0x31, 0xf0, 0xa8, 0x2f, 0x1a, 0x36, 0x18,
-	0xb5, 0x16, 0x3b, 0x28, 0xf0, 0xda, 0x3a, 0x86, 0x39, 0xbb, 0x3e, 0x8b, 0x7c, 0x07, 0x1e, 0x3a, 0x8b, 0x96,
-	0xb4, 0xef, 0x1d, 0xf9, 0x7f, 0xdd, 0x60, 0x7d, 0x91, 0x2f, 0x12, 0x8c, 0xc2, 0xb1, 0x74, 0xdc, 0xe9, 0xc4,
-	0x85, 0x37, 0x05, 0xef, 0x20, 0x3a, 0x1c, 0x72, 0x12, 0x76, 0x90, 0xf2, 0xd7, 0x41, 0x13, 0xca, 0xdd, 0xd0,
-	0xa5, 0x41, 0x07, 0x0d, 0xb0, 0xe7, 0xf5, 0xf1, 0x60, 0xd3, 0x64, 0x5b, 0x14, 0x45, 0xf9, 0x46, 0x0f, 0xad,
-	0xad, 0xa1, 0x45, 0xf4, 0xfc, 0xf3, 0x3a, 0x94, 0x7c, 0x4e, 0x12, 0x13, 0x0e, 0x26, 0x1a, 0xe9, 0x14, 0x44,
-	0x9e, 0x97, 0x14, 0x5e, 0x47, 0xdb, 0x52, 0x7d, 0xb5, 0x15, 0x97, 0x53, 0x65, 0xda, 0x2e, 0x86, 0xae, 0x68,
-	0xb4, 0x26, 0xdf, 0xaa, 0x6e, 0xbe, 0xd9, 0x74, 0x7b, 0x98, 0x18, 0x45, 0x3a, 0x1d, 0x14, 0xb4, 0xad, 0xd2,
-	0x0e, 0xc6, 0x3e, 0x75, 0x5a, 0x13, 0x2c, 0x8a, 0xe6, 0x53, 0x87, 0xa4, 0x8a, 0x85, 0x0a, 0xf2, 0x82, 0x4c,
-	0x38, 0xba, 0x1d, 0xe8, 0x70, 0x91, 0xeb, 0x74, 0xd0, 0x48, 0xfc, 0xaf, 0x66, 0x58, 0x8f, 0x72, 0x55, 0xc3,
-	0xf5, 0xfc, 0x0f, 0x55, 0x26, 0x45, 0x80, 0x26, 0x59, 0x1c, 0xaa, 0x3c, 0x8a, 0x60, 0x4d, 0x73, 0x38, 0xe4,
-	0x21, 0x0e, 0x9b, 0xe4, 0x90, 0x9b, 0x5a, 0x2f, 0xf4, 0x2f, 0xdb, 0x37, 0xf6, 0x1b, 0xb2, 0x28, 0x18, 0x60,
-	0xdd, 0xc7, 0x4c, 0xb7, 0xaa, 0x97, 0x8e, 0xb7, 0x97, 0x6a, 0xf7, 0xdc, 0x60, 0x53, 0x07, 0x15, 0x7f, 0xd6,
-	0x0e, 0x26, 0xeb, 0x42, 0x85, 0xab, 0x17, 0xc2, 0xdf, 0x74, 0x5c, 0xa6, 0x43, 0x4c, 0x08, 0xf3, 0xeb, 0x86,
-	0xa3, 0x13, 0x62, 0xca, 0x36, 0xf4, 0xf0, 0x88, 0x37, 0x6b, 0x6e, 0x46, 0xb0, 0x63, 0x3a, 0xfe, 0x90, 0xb0,
-	0x9a, 0x6f, 0xee, 0x8c, 0x08, 0x93, 0x82, 0xd4, 0x0f, 0x63, 0xd5, 0x73, 0xdd, 0x40, 0x01, 0xf6, 0x49, 0x6b,
-	0xc8, 0xa8, 0xdf, 0x41, 0x21, 0xad, 0x1d, 0xcc, 0x6f, 0x9a, 0xbb, 0xc6, 0x2d, 0xc9, 0xa7, 0xfe, 0x2e, 0x7b,
-	0x4d, 0xdc, 0xc9, 0x75, 0xd0, 0x46, 0xdd, 0x3c, 0x0a, 0x1a, 0xd7, 0x61, 0x14, 0xba, 0x3e, 0xe1, 0x3a, 0x08,
-	0x16, 0x0f, 0x1d, 0xe4, 0xab, 0x7f, 0x6a, 0x45, 0xb0, 0x23, 0x66, 0xee, 0x82, 0x89, 0x7b, 0xc2, 0xe8, 0x80,
-	0x70, 0x3d, 0x7b, 0xe7, 0x7f, 0x37, 0x93, 0xf5, 0x88, 0x84, 0x91, 0xeb, 0xb4, 0x44, 0x12, 0x7a, 0x6e, 0x39,
-	0xb7, 0x64, 0xb2, 0x36, 0x22, 0xe1, 0xa8, 0xc2, 0x8d, 0x54, 0x05, 0x24, 0x55, 0x21, 0x47, 0x8c, 0x46, 0x13,
-	0x2e, 0x9d, 0xc3, 0x31, 0xa3, 0xdb, 0xc8, 0x14, 0xca, 0xf8, 0x99, 0xb8, 0x8e, 0x9c, 0xf0, 0xe5, 0xdf, 0xf6,
-	0x43, 0xe4, 0x63, 0xbe, 0x59, 0x11, 0x70, 0xb0, 0xed, 0x54, 0xb9, 0x8e, 0x45, 0xc7, 0x2b, 0x75, 0xdf, 0x29,
-	0xa9, 0x49, 0x1c, 0x8e, 0xf3, 0xd5, 0x88, 0xc3, 0xb1, 0xa9, 0x43, 0x46, 0x38, 0xf5, 0xb6, 0x48, 0xab, 0xdb,
-	0x95, 0x3f, 0x6f, 0x90, 0x91, 0x58, 0x7a, 0x71, 0x33, 0x31, 0xea, 0x1a, 0xb0, 0x9d, 0xba, 0xcf, 0x52, 0x37,
-	0x68, 0x2d, 0xf4, 0xf4, 0x62, 0x62, 0xa7, 0x3c, 0xf1, 0x01, 0x9b, 0x4e, 0x42, 0xaa, 0xa2, 0x52, 0x99, 0xb6,
-	0x96, 0x7a, 0x39, 0x7f, 0x62, 0x4d, 0x2a, 0xd6, 0x7f, 0x78, 0x0b, 0xbb, 0x1e, 0xee, 0x7b, 0x44, 0x0c, 0x1e,
-	0xde, 0x74, 0xe8, 0x8a, 0xa5, 0x2d, 0x79, 0x6b, 0xe4, 0x32, 0xe2, 0xa0, 0x96, 0xf2, 0xdb, 0x1d, 0x91, 0xf0,
-	0x26, 0x0e, 0x1c, 0xea, 0xbf, 0x09, 0x7b, 0x11, 0xe1, 0x88, 0x06, 0xde, 0xb4, 0x2d, 0x73, 0x54, 0x58, 0x0b,
-	0x84, 0x0d, 0x29, 0xf3, 0x71, 0x30, 0x20, 0xb3, 0x73, 0x63, 0x79, 0xae, 0x97, 0x25, 0x2b, 0x40, 0x37, 0xa0,
-	0xdb, 0x33, 0xf2, 0x72, 0x8b, 0xdc, 0x0f, 0xaf, 0x04, 0x72, 0x11, 0x37, 0x3b, 0x2f, 0x96, 0xe7, 0x3a, 0x79,
-	0xa9, 0x4c, 0xf4, 0x32, 0x69, 0x90, 0xa8, 0xf6, 0xdc, 0x28, 0x51, 0xb3, 0xd8, 0x57, 0x21, 0xd5, 0xba, 0xde,
-	0x2a, 0x40, 0x6b, 0x21, 0x0a, 0x87, 0xe7, 0x1e, 0x93, 0xde, 0x95, 0x57, 0xbd, 0x96, 0xb5, 0xbc, 0xea, 0x64,
-	0x2d, 0xaf, 0xf3, 0x76, 0xc7, 0xbd, 0x4a, 0xd1, 0x1a, 0x1a, 0x78, 0x98, 0xf3, 0x64, 0x13, 0xc1, 0xa2, 0x41,
-	0x48, 0xe5, 0x8b, 0x21, 0x47, 0x40, 0xe1, 0x0d, 0xb3, 0xd1, 0x16, 0x5a, 0x43, 0x77, 0x16, 0x9e, 0xe5, 0x0b,
-	0x77, 0x57, 0xe3, 0x9f, 0x49, 0x20, 0x7e, 0x7d, 0x6e, 0xc7, 0xfa, 0xe5, 0xbe, 0x1b, 0x8a, 0x6d, 0x89, 0x48,
-	0x34, 0xde, 0x9a, 0xa8, 0x35, 0xa5, 0xdc, 0x70, 0xc8, 0x15, 0x65, 0x6e, 0x81, 0xbc, 0x8d, 0x59, 0xd0, 0x5a,
-	0x90, 0x61, 0x85, 0xaf, 0x95, 0x85, 0x8e, 0xfc, 0xd7, 0x5e, 0xeb, 0x5a, 0x69, 0xdc, 0x13, 0x1e, 0xd7, 0x19,
-	0xf5, 0x5d, 0x4e, 0x74, 0x49, 0xf5, 0x53, 0xab, 0xa5, 0x5f, 0x3e, 0x2b, 0x69, 0x15, 0x44, 0xff, 0x7e, 0x25,
-	0x15, 0x52, 0xff, 0xa8, 0x5e, 0xba, 0xb6, 0x95, 0xc0, 0x84, 0x04, 0x8e, 0x1b, 0x8c, 0xae, 0x6c, 0x91, 0x40,
-	0x94, 0x46, 0xac, 0x7b, 0x2c, 0x57, 0x3e, 0x18, 0x13, 0x27, 0xf2, 0x88, 0x73, 0xcb, 0xf5, 0x09, 0x8d, 0x42,
-	0xae, 0x33, 0xf1, 0x0c, 0x9e, 0xb4, 0xec, 0x58, 0x02, 0x72, 0x3f, 0xbc, 0xa4, 0x07, 0x6a, 0xed, 0xf5, 0xda,
-	0x65, 0xb4, 0x86, 0x96, 0x44, 0x1b, 0x98, 0x55, 0x2f, 0x27, 0xe1, 0xb5, 0x20, 0x7c, 0xf4, 0x82, 0xa8, 0x34,
-	0xec, 0x38, 0xac, 0x83, 0xb6, 0xb2, 0x99, 0xf7, 0x89, 0xdf, 0xe5, 0x24, 0x7c, 0xa3, 0x1b, 0x84, 0xe7, 0x97,
-	0xa5, 0x27, 0x74, 0x16, 0x2d, 0x76, 0xd0, 0x56, 0x07, 0x85, 0x2c, 0x32, 0xb5, 0x54, 0xee, 0xf5, 0x42, 0x07,
-	0x3d, 0x83, 0xc3, 0x71, 0x77, 0xe8, 0x51, 0xca, 0x5a, 0x5b, 0xa8, 0x87, 0x2e, 0x2c, 0x3f, 0x7e, 0xe1, 0xf1,
-	0x47, 0x5f, 0xb3, 0xfc, 0xf8, 0xa3, 0x6d, 0x3b, 0x8e, 0x9d, 0x5c, 0xbe, 0xce, 0x2f, 0xef, 0x35, 0x5f, 0x76,
-	0x9c, 0xa3, 0x4c, 0x59, 0xad, 0x08, 0x95, 0x07, 0x8f, 0x6e, 0x9b, 0x4d, 0x80, 0x88, 0x7c, 0x54, 0x10, 0xb9,
-	0x55, 0x62, 0x15, 0x66, 0xec, 0x8e, 0xc6, 0x99, 0x40, 0xd7, 0x32, 0xa5, 0xb7, 0xc2, 0xe8, 0xd1, 0x57, 0x24,
-	0x74, 0x56, 0x05, 0x7d, 0xb5, 0x55, 0x1b, 0xb9, 0x1c, 0x7b, 0x14, 0x3b, 0x72, 0x3c, 0x2c, 0xcd, 0xf2, 0x30,
-	0x93, 0xf6, 0x53, 0x1e, 0xc5, 0xe1, 0xa3, 0x17, 0x74, 0x9d, 0x59, 0x29, 0x8b, 0x57, 0x60, 0x88, 0xd6, 0x52,
-	0xfd, 0x5f, 0xe7, 0x26, 0x0a, 0x1c, 0x32, 0x74, 0x03, 0xe2, 0xd8, 0x9b, 0x22, 0x39, 0xe0, 0xb8, 0xfc, 0x3a,
-	0xbe, 0xde, 0x1a, 0xb6, 0xb3, 0x21, 0x86, 0xc6, 0xa7, 0x95, 0x13, 0xd7, 0x29, 0xaf, 0xbb, 0xa2, 0x3a, 0x50,
-	0x9d, 0x74, 0x4b, 0x8e, 0xf6, 0x77, 0x5c, 0xe7, 0x6e, 0xbe, 0x0b, 0x84, 0x94, 0x91, 0x54, 0xe9, 0xd3, 0x9d,
-	0x40, 0x6f, 0xdb, 0x70, 0xf0, 0x3a, 0x82, 0x45, 0xda, 0x8b, 0xf7, 0x5f, 0xf3, 0xd4, 0x53, 0x8f, 0x2d, 0x2e,
-	0x2e, 0x2e, 0xaa, 0x0e, 0x2e, 0x8b, 0x10, 0x4e, 0x27, 0x84, 0x0e, 0xd1, 0x96, 0x2c, 0xf9, 0x42, 0x10, 0xf9,
-	0x7d, 0xc2, 0x16, 0xd0, 0x99, 0x33, 0x68, 0x2b, 0x33, 0x16, 0x08, 0xcf, 0xaa, 0xb8, 0x5b, 0x49, 0x71, 0xab,
-	0x7b, 0xb5, 0x4e, 0x3a, 0x55, 0xb8, 0xd2, 0x20, 0x9d, 0x4c, 0xef, 0x49, 0x6f, 0x44, 0x75, 0xa5, 0xa7, 0x02,
-	0xa7, 0x9b, 0x32, 0xfd, 0xb6, 0x65, 0x76, 0xb1, 0x71, 0x71, 0x55, 0x39, 0xe3, 0x06, 0x8d, 0x0b, 0x52, 0x1e,
-	0xef, 0xe2, 0xcc, 0x78, 0x3d, 0x62, 0x37, 0xee, 0x3d, 0xd7, 0xe1, 0xa2, 0x75, 0x5b, 0x5b, 0x56, 0xc7, 0x12,
-	0xce, 0x85, 0xe9, 0xa6, 0xc2, 0xad, 0x53, 0xea, 0x75, 0x27, 0xd4, 0x8c, 0x52, 0x33, 0x82, 0xda, 0x61, 0x55,
-	0x2f, 0xb1, 0x0f, 0x19, 0xd2, 0x35, 0x66, 0x75, 0x23, 0xb4, 0x86, 0xb6, 0x56, 0x6d, 0xa7, 0x11, 0xbd, 0x49,
-	0x86, 0x97, 0x68, 0x14, 0x84, 0xc6, 0x7d, 0x31, 0xe5, 0x2e, 0xca, 0xc3, 0x45, 0x79, 0x3a, 0xc8, 0x75, 0x52,
-	0xc7, 0x1b, 0x85, 0xe1, 0xcf, 0x9e, 0x5d, 0x8d, 0x6b, 0x45, 0x74, 0xae, 0xa7, 0x3c, 0x3c, 0x4a, 0xe2, 0xe4,
-	0xdb, 0x6e, 0x38, 0x18, 0x27, 0xdd, 0xce, 0x3a, 0x8e, 0xe0, 0x04, 0x2d, 0xd0, 0xfe, 0xb3, 0x64, 0x10, 0x2e,
-	0xac, 0x98, 0x12, 0xca, 0x16, 0xcb, 0x1d, 0x5d, 0xcc, 0xcd, 0x59, 0x11, 0x2f, 0x99, 0xee, 0xa2, 0xcb, 0x3b,
-	0xd7, 0x67, 0x04, 0x6f, 0xae, 0xda, 0xb1, 0xaa, 0x03, 0x9a, 0x38, 0x56, 0x2b, 0xf0, 0xf2, 0x6a, 0x79, 0xa0,
-	0xa9, 0xdf, 0xa7, 0x5e, 0x51, 0xa0, 0xf3, 0xe5, 0x81, 0x86, 0x51, 0x30, 0x10, 0x7b, 0xbf, 0xa2, 0x60, 0x17,
-	0x0a, 0x82, 0xed, 0xcc, 0xd7, 0x7d, 0x89, 0xd0, 0xf3, 0x71, 0x6d, 0xd6, 0x99, 0x56, 0x44, 0x5b, 0x95, 0x0d,
-	0xf3, 0x62, 0xd0, 0xdc, 0xf0, 0xdc, 0x41, 0xf9, 0xa0, 0x89, 0x19, 0xc3, 0x53, 0xb4, 0x16, 0x4f, 0x08, 0xf1,
-	0xf8, 0x9e, 0x1a, 0xd9, 0x3d, 0x12, 0x14, 0xf8, 0x79, 0x2c, 0x3d, 0x8a, 0x89, 0x89, 0x57, 0xe4, 0xeb, 0xb1,
-	0x8b, 0x22, 0xce, 0x96, 0xee, 0x52, 0x01, 0x0f, 0xbb, 0xe4, 0xfe, 0x84, 0xb2, 0x50, 0x65, 0xde, 0xec, 0xa5,
-	0x65, 0xc2, 0x72, 0x1b, 0x57, 0x91, 0xed, 0x1b, 0x43, 0xbd, 0x04, 0x3e, 0xc8, 0xec, 0xeb, 0x98, 0xf4, 0xd2,
-	0x41, 0x65, 0x3e, 0xce, 0xd6, 0xdc, 0x90, 0x32, 0xd4, 0x92, 0xef, 0xbd, 0xec, 0xda, 0xc8, 0x45, 0xdf, 0x26,
-	0xe2, 0x5b, 0x45, 0xee, 0xd9, 0xb3, 0x71, 0x37, 0xc5, 0x77, 0x5c, 0xf1, 0x36, 0xc5, 0x73, 0x54, 0x4b, 0xe5,
-	0xeb, 0x2c, 0x72, 0xd1, 0xab, 0xe3, 0x74, 0x52, 0x07, 0x85, 0xb8, 0xb8, 0xd0, 0xb2, 0xfb, 0x96, 0x96, 0x96,
-	0xcb, 0x9c, 0xef, 0x4b, 0x63, 0x65, 0x8e, 0x5a, 0x45, 0xc9, 0x2f, 0xe3, 0x10, 0xbf, 0xc9, 0x25, 0xdb, 0xb3,
-	0x5a, 0x8e, 0xab, 0x5e, 0x27, 0xaa, 0x28, 0xdf, 0x74, 0x21, 0xe1, 0x72, 0xa5, 0x75, 0x45, 0x06, 0x94, 0x25,
-	0xe9, 0xa0, 0x7e, 0x6e, 0x11, 0x98, 0x8a, 0x5c, 0x84, 0x51, 0xfe, 0x17, 0x5b, 0x45, 0x13, 0x63, 0x89, 0x67,
-	0x15, 0x73, 0x3e, 0x07, 0xae, 0x4f, 0x6e, 0x30, 0x77, 0xe4, 0x8a, 0x5a, 0xb8, 0x8c, 0x43, 0xb9, 0x6f, 0x69,
-	0xb5, 0xd1, 0x39, 0x94, 0xd9, 0xcb, 0xd8, 0x2b, 0x44, 0xd7, 0x17, 0x31, 0xde, 0x90, 0x63, 0x92, 0xd9, 0x3d,
-	0xce, 0xdd, 0x1b, 0x51, 0x91, 0xda, 0x4a, 0xdc, 0xca, 0x8e, 0xb3, 0x62, 0x95, 0x07, 0xa3, 0xb3, 0xa8, 0xdf,
-	0x49, 0x8e, 0x58, 0x55, 0xae, 0x56, 0x32, 0x55, 0xa0, 0x3c, 0xa8, 0x6d, 0xee, 0xdc, 0x88, 0x3e, 0xcb, 0xe3,
-	0xe8, 0x7a, 0x3d, 0x74, 0x95, 0xbe, 0x8a, 0xa3, 0x8d, 0x75, 0xe4, 0x50, 0xa2, 0xf6, 0x22, 0x83, 0x31, 0x0e,
-	0x46, 0x04, 0x61, 0x8e, 0x3c, 0x1a, 0x8c, 0xc4, 0xbf, 0x01, 0x45, 0x57, 0x69, 0xfc, 0x5d, 0x83, 0x45, 0x41,
-	0xe0, 0x06, 0xa3, 0x2e, 0xda, 0xa0, 0x3e, 0x41, 0x74, 0x42, 0x18, 0x16, 0x83, 0x10, 0x47, 0x2d, 0xd2, 0x1d,
-	0x75, 0xe5, 0x61, 0x04, 0xef, 0x88, 0xb6, 0x0f, 0x09, 0xe3, 0x08, 0x07, 0x8e, 0x58, 0x3d, 0x8a, 0xbf, 0xdb,
-	0x71, 0x9a, 0x3e, 0x9e, 0x22, 0x3e, 0x0d, 0x06, 0x63, 0x46, 0x03, 0x1a, 0x71, 0x6f, 0x8a, 0x42, 0xe6, 0x8e,
-	0x46, 0x84, 0x21, 0x2c, 0x52, 0x22, 0x72, 0xa5, 0x3d, 0xc6, 0x81, 0xe3, 0x11, 0xd6, 0x45, 0x62, 0x6b, 0x82,
-	0x7c, 0xbc, 0x49, 0x78, 0x9c, 0x8d, 0x11, 0x09, 0x11, 0xb9, 0x4f, 0x06, 0x51, 0x48, 0xe2, 0x6f, 0x26, 0xbe,
-	0xeb, 0x38, 0x9e, 0xfe, 0x1a, 0x43, 0x90, 0xaa, 0x4d, 0x75, 0xf2, 0x2f, 0xd3, 0x34, 0x83, 0x65, 0x17, 0x5d,
-	0x44, 0x23, 0xca, 0x68, 0x14, 0xba, 0x01, 0x91, 0x1f, 0x5f, 0xf4, 0x2c, 0x11, 0x52, 0x84, 0xe5, 0xeb, 0xc7,
-	0x43, 0x3c, 0xd8, 0x44, 0xae, 0x8a, 0x67, 0x10, 0x31, 0x26, 0x72, 0xa3, 0x7f, 0xe4, 0x28, 0xa4, 0x14, 0x71,
-	0x1f, 0x7b, 0x1e, 0x6a, 0x71, 0x42, 0x90, 0x4f, 0x19, 0x51, 0x6e, 0x26, 0x81, 0x76, 0x37, 0x4e, 0x53, 0xe6,
-	0x5c, 0xd5, 0x27, 0x97, 0xb1, 0x6d, 0xac, 0x77, 0x50, 0x38, 0x8e, 0x38, 0xda, 0x26, 0x68, 0x8c, 0xb7, 0x88,
-	0x48, 0x34, 0x9a, 0x38, 0x38, 0x24, 0xda, 0x19, 0x45, 0x5c, 0x7d, 0x31, 0xb2, 0xcb, 0x90, 0xe4, 0x7d, 0x3e,
-	0x55, 0x1c, 0xb4, 0x8d, 0xb9, 0x2f, 0x36, 0x31, 0x6a, 0x37, 0x25, 0xc7, 0x63, 0x5d, 0xc9, 0x0b, 0x2c, 0x0a,
-	0x44, 0x2f, 0xec, 0x1a, 0x2f, 0x0b, 0x2b, 0xa8, 0xc5, 0x27, 0xd6, 0x4b, 0x30, 0x37, 0xc7, 0x27, 0xe8, 0x89,
-	0x27, 0x9e, 0x48, 0x26, 0x60, 0xdd, 0x79, 0xf5, 0xa7, 0xa0, 0xfc, 0x12, 0x9a, 0x4f, 0xc4, 0x3b, 0x5c, 0xb4,
-	0xbe, 0x12, 0x5b, 0x30, 0x22, 0xd7, 0x07, 0x2c, 0x22, 0xc6, 0xc5, 0x21, 0x1e, 0x91, 0xe5, 0x32, 0xef, 0x4f,
-	0xa1, 0x83, 0x5a, 0x2a, 0x14, 0x3a, 0x59, 0x53, 0x7d, 0x71, 0x9c, 0x4e, 0xd9, 0xef, 0x62, 0x69, 0x93, 0xcb,
-	0x60, 0xcb, 0xda, 0x44, 0x8a, 0x97, 0x21, 0x5f, 0x95, 0xb7, 0xf5, 0x47, 0x12, 0x14, 0xb9, 0x41, 0x38, 0x09,
-	0x59, 0x07, 0x4d, 0x50, 0x14, 0x70, 0x3c, 0x24, 0xdd, 0x75, 0xea, 0x06, 0xa1, 0x18, 0x79, 0x82, 0xf2, 0x6a,
-	0x96, 0xc1, 0xeb, 0xd7, 0xf3, 0xd0, 0xb1, 0x87, 0x48, 0x55, 0xb9, 0xed, 0xb4, 0x97, 0x49, 0xce, 0xc7, 0xd2,
-	0xa3, 0x19, 0x2f, 0x41, 0x79, 0x53, 0x2d, 0x5f, 0xc8, 0xb4, 0xd5, 0x30, 0xfb, 0x35, 0xa5, 0xd9, 0x74, 0x39,
-	0xe9, 0xa0, 0xa0, 0x5d, 0x5a, 0x81, 0x8c, 0x70, 0x12, 0x3e, 0x43, 0x7c, 0xca, 0xa6, 0xf1, 0x28, 0x9e, 0xad,
-	0xa5, 0x02, 0x3f, 0x75, 0xea, 0xcb, 0x94, 0x4f, 0x4f, 0x8f, 0xf5, 0x26, 0x89, 0xd2, 0x8c, 0x06, 0x38, 0xa0,
-	0x22, 0x37, 0x4b, 0xad, 0xb6, 0x68, 0xcb, 0x47, 0x2f, 0x64, 0x32, 0x19, 0xbb, 0xd7, 0xc9, 0x1a, 0x4f, 0xb7,
-	0x5f, 0x07, 0xb5, 0xac, 0xb1, 0xff, 0x6c, 0x7e, 0xb8, 0x6f, 0xa3, 0x57, 0xa3, 0xa5, 0x45, 0xf9, 0x5f, 0x45,
-	0x57, 0xf4, 0x3c, 0x11, 0x4b, 0xab, 0x2d, 0x86, 0x98, 0x81, 0xca, 0x64, 0x07, 0x05, 0xfa, 0xef, 0xa2, 0xce,
-	0xa7, 0x02, 0xd4, 0xef, 0x7b, 0xbe, 0x88, 0x6b, 0x0d, 0x99, 0x39, 0x97, 0xb4, 0x45, 0xd7, 0xb9, 0x25, 0xd3,
-	0x2c, 0x2d, 0x99, 0x0c, 0xd3, 0x93, 0xb9, 0x2f, 0xdc, 0x5d, 0x59, 0x3d, 0x6f, 0xe9, 0xd1, 0x0e, 0x6a, 0x49,
-	0xff, 0x0f, 0x2b, 0xff, 0x49, 0xa9, 0xd3, 0x5d, 0x32, 0x57, 0x76, 0x73, 0x9a, 0xa2, 0x4f, 0x48, 0xe4, 0x99,
-	0x4b, 0xcb, 0x21, 0x1e, 0x9e, 0xaa, 0x6a, 0x68, 0xab, 0x1a, 0xc8, 0x54, 0x40, 0x51, 0xa8, 0xfa, 0x95, 0x61,
-	0xed, 0x6c, 0x0a, 0x0f, 0x69, 0x52, 0x85, 0x2d, 0xf6, 0x62, 0xb6, 0x21, 0xa5, 0x87, 0x42, 0x72, 0x4f, 0x23,
-	0x16, 0xc9, 0x5c, 0xd5, 0x33, 0x8d, 0xc2, 0x96, 0xd9, 0x5e, 0xb4, 0x52, 0x99, 0xb4, 0xce, 0xa8, 0x22, 0xbb,
-	0x3d, 0xe6, 0xe6, 0xb6, 0xc7, 0xae, 0x47, 0x50, 0xab, 0x2c, 0x85, 0x31, 0xe6, 0x2d, 0xd7, 0xb1, 0x76, 0xcb,
-	0xba, 0x5a, 0x29, 0x43, 0x5c, 0xcc, 0xd4, 0x8c, 0x60, 0x4e, 0x03, 0x31, 0x85, 0x0e, 0xb1, 0xeb, 0x11, 0x47,
-	0xcc, 0x3d, 0x8c, 0x8c, 0x5c, 0x1e, 0x12, 0x26, 0xa7, 0x9b, 0x50, 0xc5, 0xa4, 0xa6, 0xdf, 0x0e, 0xf2, 0xe8,
-	0x48, 0xce, 0xde, 0x21, 0x9b, 0x22, 0x3c, 0xc2, 0x6e, 0x90, 0x8a, 0xb6, 0x15, 0x12, 0x31, 0x3b, 0x61, 0x36,
-	0x45, 0xdb, 0x94, 0x6d, 0x62, 0x26, 0xcd, 0x17, 0x44, 0x62, 0xe3, 0x30, 0x9c, 0xf0, 0x95, 0x5e, 0x6f, 0xe4,
-	0x86, 0xe3, 0xa8, 0xdf, 0x1d, 0x50, 0xbf, 0x37, 0xa2, 0x1e, 0x0e, 0x46, 0xbd, 0x11, 0xed, 0xb9, 0x9c, 0x47,
-	0x84, 0xf7, 0x96, 0x1f, 0x7b, 0xfc, 0x35, 0x8f, 0xb4, 0x93, 0x08, 0xd3, 0x47, 0x7f, 0x45, 0xcd, 0xb9, 0x82,
-	0x7c, 0x97, 0x8b, 0xb9, 0x31, 0x95, 0xcb, 0x05, 0xab, 0x7a, 0x4a, 0xeb, 0xcd, 0x6c, 0xd5, 0xcc, 0x0a, 0x48,
-	0x7d, 0x67, 0x48, 0x0d, 0xbb, 0xc6, 0xa1, 0x5d, 0xa7, 0x67, 0xbb, 0xce, 0x8c, 0x4e, 0x3c, 0xf0, 0x08, 0x66,
-	0xa9, 0x1e, 0xec, 0x3a, 0xc5, 0x6f, 0x6e, 0xce, 0xe7, 0x6e, 0x7a, 0xed, 0xec, 0x49, 0xda, 0x4e, 0xa6, 0xb4,
-	0xff, 0x8c, 0x64, 0x0f, 0xcd, 0xd4, 0x40, 0x81, 0x3f, 0x35, 0xd7, 0xb6, 0xe2, 0xdd, 0x79, 0xbe, 0xfc, 0xf1,
-	0xc7, 0x03, 0x31, 0x52, 0xb7, 0x18, 0xba, 0x73, 0xb7, 0x3f, 0x0d, 0x49, 0xb6, 0xec, 0x29, 0x5f, 0xb5, 0xca,
-	0x5d, 0xf8, 0x69, 0xa2, 0x15, 0xef, 0xd4, 0x4c, 0x63, 0x96, 0xe6, 0x6b, 0xe8, 0x06, 0xd8, 0x73, 0xdf, 0x46,
-	0x6e, 0x92, 0x61, 0x6b, 0x0b, 0x31, 0x32, 0x34, 0x59, 0xe2, 0x53, 0x2e, 0x96, 0xad, 0xbd, 0x67, 0x79, 0xd7,
-	0xf2, 0xb3, 0x87, 0xb6, 0xd0, 0x7b, 0xe3, 0xf2, 0x15, 0x53, 0xf6, 0x1c, 0xe3, 0xdc, 0xb9, 0x55, 0xeb, 0x14,
-	0xa2, 0xe4, 0xa8, 0x24, 0x75, 0x42, 0x66, 0xd2, 0xde, 0xca, 0x1c, 0xcb, 0xc4, 0x87, 0x77, 0x25, 0xc7, 0x31,
-	0xf1, 0xf1, 0x75, 0xfa, 0xcc, 0x45, 0xb7, 0xea, 0x56, 0x3b, 0xe7, 0xa6, 0xce, 0x89, 0x22, 0x3e, 0xb6, 0x9a,
-	0xdc, 0xbc, 0x53, 0xf9, 0xf1, 0x5b, 0xee, 0x1e, 0xdf, 0x84, 0xbd, 0x96, 0x4c, 0x54, 0x3f, 0xb7, 0x45, 0x65,
-	0xe7, 0xeb, 0x3a, 0xf6, 0x5c, 0x6b, 0xa6, 0x8d, 0xcf, 0x1f, 0x93, 0xd5, 0x4d, 0xb2, 0x5f, 0x9d, 0xd9, 0xf6,
-	0x32, 0x3b, 0x57, 0x49, 0xa8, 0x1a, 0x5e, 0xac, 0xec, 0x2a, 0xb3, 0x66, 0xbc, 0xd7, 0xef, 0x03, 0x62, 0xdc,
-	0xf1, 0xc4, 0xc6, 0xed, 0x26, 0x19, 0x7a, 0x64, 0x10, 0xca, 0xf7, 0x29, 0xd9, 0x8d, 0x9b, 0x81, 0x26, 0x97,
-	0xe7, 0xa5, 0x47, 0x93, 0x57, 0x8e, 0x4f, 0x92, 0xf3, 0x39, 0x7b, 0x55, 0x33, 0x22, 0x21, 0x9f, 0xb4, 0xda,
-	0x22, 0x5d, 0xb1, 0xff, 0xef, 0xf5, 0x90, 0xd8, 0x7a, 0x0c, 0xa8, 0xef, 0x8b, 0xad, 0x09, 0xee, 0xd3, 0x2d,
-	0x52, 0x52, 0x4b, 0xe7, 0x97, 0x3b, 0x3a, 0x67, 0xd5, 0x35, 0xb3, 0x91, 0xaf, 0x99, 0x0e, 0xba, 0x5f, 0xf2,
-	0x8e, 0x98, 0x10, 0x75, 0x2a, 0xc7, 0xd4, 0x06, 0xaf, 0x5d, 0x1b, 0x1d, 0x94, 0xf1, 0x77, 0x7e, 0x79, 0x46,
-	0xb3, 0x5e, 0xd6, 0x7d, 0x37, 0xd3, 0xb2, 0x25, 0x19, 0x57, 0xbe, 0x9b, 0xe4, 0x5d, 0xbd, 0x1b, 0xeb, 0x4c,
-	0xec, 0x75, 0xc3, 0x69, 0xc3, 0x46, 0x2d, 0xce, 0xb2, 0x34, 0x1a, 0x33, 0x39, 0x76, 0xc5, 0xe4, 0x50, 0xd5,
-	0x11, 0xa5, 0xef, 0xdd, 0xbe, 0x24, 0x33, 0xfa, 0x63, 0x6e, 0x7f, 0x51, 0x98, 0x73, 0xd3, 0xe4, 0xf9, 0x7c,
-	0xcf, 0xee, 0x26, 0xb5, 0x73, 0x3f, 0xa3, 0xaf, 0xe4, 0x72, 0x9a, 0xeb, 0x29, 0xcb, 0x17, 0x66, 0x54, 0xbb,
-	0x58, 0xb8, 0x99, 0xdc, 0xfb, 0x71, 0x3f, 0xc7, 0x6c, 0xc4, 0xd1, 0x9d, 0xbb, 0xa2, 0x14, 0xa8, 0x25, 0xdd,
-	0xfa, 0x94, 0x7a, 0x65, 0x25, 0x12, 0x71, 0xd4, 0xda, 0xb5, 0xb0, 0x69, 0xd1, 0x58, 0x9d, 0x2b, 0xd8, 0x6a,
-	0xda, 0x8f, 0x9f, 0x19, 0x42, 0xb6, 0xaa, 0x87, 0x8c, 0xf8, 0x10, 0x72, 0xc4, 0x75, 0xe4, 0xa9, 0x53, 0xcb,
-	0xf8, 0x0d, 0xca, 0xf8, 0xcf, 0x8d, 0x56, 0x78, 0x32, 0xf1, 0xa6, 0x2d, 0x5f, 0x7e, 0xd9, 0x10, 0x91, 0x25,
-	0x01, 0xf6, 0x3e, 0x28, 0xe5, 0xba, 0xe5, 0x23, 0x8f, 0x66, 0x47, 0xa5, 0xfc, 0xd1, 0xf2, 0x63, 0xca, 0xab,
-	0xd8, 0xf9, 0x2c, 0x25, 0xd3, 0x0e, 0x1a, 0x60, 0x79, 0xa2, 0x4f, 0x18, 0xb3, 0x66, 0xc2, 0x03, 0xca, 0xa2,
-	0x48, 0xa4, 0x4e, 0xfe, 0x16, 0x67, 0x4e, 0x8b, 0xfa, 0x2d, 0xde, 0xa2, 0x9b, 0xf1, 0x30, 0xd5, 0xac, 0xd3,
-	0xa9, 0xb0, 0x07, 0xd8, 0xed, 0xaa, 0x3b, 0x90, 0x75, 0xec, 0x50, 0xdd, 0x81, 0xb6, 0x3a, 0xc9, 0xb7, 0xa3,
-	0x43, 0xe8, 0x48, 0x17, 0x16, 0x6b, 0x77, 0xa4, 0x0b, 0x8f, 0x1d, 0x4d, 0x47, 0x12, 0x59, 0xac, 0xd3, 0x91,
-	0x44, 0xfe, 0x6a, 0x76, 0xa4, 0xeb, 0x64, 0x7b, 0x77, 0xbd, 0xe8, 0x7a, 0xcd, 0xf3, 0x96, 0x23, 0xea, 0x42,
-	0xb1, 0xbd, 0x49, 0x6b, 0x0b, 0xfa, 0xce, 0x01, 0xf5, 0x9d, 0x37, 0xc8, 0x8f, 0xb6, 0x7a, 0xfb, 0x23, 0xe6,
-	0xef, 0x92, 0xae, 0xa2, 0xfc, 0x35, 0x3f, 0x02, 0x13, 0x1b, 0xe4, 0x09, 0x66, 0x9c, 0x5c, 0x0b, 0x0a, 0x26,
-	0x71, 0xfd, 0xcd, 0xb8, 0x7c, 0x89, 0xb1, 0xce, 0xc8, 0x04, 0x33, 0xa2, 0x67, 0x3b, 0x9d, 0x4b, 0xd5, 0xb1,
-	0xc5, 0x1a, 0xa9, 0x24, 0xb3, 0xa9, 0x50, 0xf5, 0x97, 0xec, 0x3c, 0x64, 0x68, 0xcd, 0x18, 0x54, 0x75, 0xd5,
-	0xbf, 0x2d, 0x9d, 0x74, 0x2e, 0xeb, 0xd6, 0x2a, 0x3d, 0xd3, 0x4a, 0xa2, 0xc8, 0x3c, 0x64, 0x25, 0x27, 0x67,
-	0x62, 0x09, 0xc6, 0x43, 0x66, 0x4a, 0x5e, 0x56, 0xf0, 0x37, 0x24, 0x73, 0xbc, 0x7e, 0xb5, 0xfb, 0x99, 0x7d,
-	0x73, 0xae, 0x85, 0xe2, 0x10, 0x4d, 0x4b, 0x5c, 0xfa, 0x22, 0x67, 0xb6, 0xd2, 0x4b, 0x8f, 0xb6, 0xe5, 0x5a,
-	0xcc, 0x2a, 0x5c, 0xd9, 0xcc, 0xc6, 0x43, 0x1c, 0x88, 0x37, 0xde, 0x64, 0x3e, 0x54, 0x2d, 0x27, 0x06, 0xa3,
-	0xd2, 0x19, 0xcd, 0x84, 0x69, 0x72, 0x04, 0x9c, 0xe9, 0xf8, 0xa2, 0x72, 0xf3, 0x4d, 0x85, 0x5c, 0x1d, 0x37,
-	0x1d, 0x66, 0xcb, 0x2a, 0x96, 0x4e, 0xe8, 0xb5, 0x68, 0x09, 0xad, 0xa0, 0xf2, 0x03, 0xd8, 0x01, 0x9d, 0x4c,
-	0x9f, 0x9c, 0x86, 0x84, 0xdf, 0xa2, 0x57, 0x69, 0xcb, 0xe1, 0xa1, 0x6e, 0x89, 0x0e, 0xe2, 0x6c, 0xa0, 0xbb,
-	0xa4, 0x5c, 0xf9, 0x96, 0x8c, 0xb5, 0xa9, 0xf0, 0xf5, 0x1b, 0x47, 0x24, 0x64, 0x0d, 0x9f, 0xc5, 0x9f, 0x04,
-	0x44, 0x0e, 0xd6, 0x0a, 0x76, 0x48, 0xf6, 0xf1, 0xc1, 0x43, 0x2d, 0xe1, 0xcb, 0xaa, 0x84, 0xe4, 0x8c, 0x1f,
-	0x3d, 0xff, 0x3c, 0x2a, 0x72, 0xbc, 0xe4, 0x61, 0x7f, 0x42, 0x1c, 0xe9, 0xc7, 0x3e, 0x42, 0xac, 0x35, 0xe0,
-	0xa4, 0x6d, 0x67, 0xe2, 0xf3, 0x36, 0xfd, 0x2d, 0x94, 0x5e, 0xa2, 0x93, 0x29, 0x5a, 0x13, 0xc9, 0x76, 0x79,
-	0xd4, 0x97, 0x9f, 0xa5, 0x5b, 0x8b, 0x1d, 0x51, 0xdc, 0xcc, 0x4b, 0x31, 0x27, 0x7e, 0x12, 0x1d, 0x4e, 0x85,
-	0x29, 0x79, 0xa1, 0xc4, 0xa0, 0xa8, 0x3c, 0x64, 0x83, 0xd7, 0x19, 0xbd, 0xab, 0x1a, 0xfb, 0xe9, 0x0d, 0xd9,
-	0xd8, 0xb2, 0x03, 0x8b, 0x4a, 0xd2, 0xef, 0x5f, 0xed, 0xc6, 0x7e, 0x7a, 0x63, 0x37, 0x8d, 0x5d, 0xf8, 0x26,
-	0x66, 0x1b, 0x3b, 0xfd, 0x56, 0xa6, 0x1b, 0x5b, 0x44, 0x55, 0xda, 0xd8, 0x45, 0x8e, 0x0f, 0x5e, 0x63, 0x2f,
-	0x38, 0xa4, 0x1f, 0xc9, 0x71, 0x52, 0x8e, 0x3d, 0xa9, 0x06, 0xb2, 0x75, 0x59, 0xca, 0x35, 0x09, 0x9d, 0x36,
-	0x38, 0x95, 0x9f, 0xf8, 0x31, 0x9f, 0x06, 0x03, 0xc4, 0xa2, 0xa0, 0x65, 0xaa, 0x55, 0xd7, 0xa0, 0x6a, 0x0c,
-	0xf3, 0xa3, 0x5d, 0xe9, 0xb7, 0x49, 0xff, 0x22, 0xe7, 0xc4, 0xef, 0x7b, 0xd3, 0xae, 0x19, 0xf6, 0xe2, 0x7a,
-	0xcf, 0x59, 0x0a, 0x5f, 0xa5, 0x5d, 0x16, 0x05, 0x2b, 0x85, 0xa1, 0x10, 0xb9, 0x3f, 0x21, 0x83, 0x58, 0xb9,
-	0xa7, 0xf2, 0x96, 0xac, 0x39, 0xd0, 0x5a, 0x9c, 0x6c, 0x62, 0x57, 0xb0, 0xbb, 0x0f, 0x67, 0xf6, 0xc9, 0x21,
-	0x5a, 0x43, 0x77, 0xc4, 0xba, 0xe5, 0xe9, 0x0d, 0xa4, 0x7f, 0x90, 0xc2, 0xc5, 0xab, 0xd4, 0x7c, 0x1d, 0xf7,
-	0xa6, 0x68, 0x8c, 0xb9, 0x78, 0x71, 0x08, 0x23, 0xc1, 0x40, 0x78, 0xa0, 0x62, 0xc2, 0x76, 0xc8, 0x7d, 0xf5,
-	0x35, 0x3b, 0x76, 0x41, 0xae, 0xfa, 0x22, 0x7f, 0x1d, 0x5f, 0x57, 0xb5, 0xbb, 0xa8, 0xfe, 0x91, 0x22, 0x2e,
-	0x55, 0x1f, 0x2c, 0x22, 0xea, 0xaf, 0x21, 0xf6, 0xb8, 0xfe, 0x33, 0xb1, 0x51, 0xee, 0xc4, 0x16, 0x1c, 0xf2,
-	0x2f, 0xcb, 0xfc, 0xd8, 0x3e, 0x2f, 0x4d, 0x19, 0xd2, 0x14, 0x18, 0xad, 0xb5, 0xbb, 0x43, 0xd7, 0xf3, 0x5a,
-	0xd7, 0x82, 0xa1, 0x1b, 0xb8, 0xe1, 0xb4, 0x2d, 0x97, 0x65, 0xca, 0x1c, 0x11, 0xd1, 0x61, 0xaa, 0x20, 0xba,
-	0xa4, 0xa2, 0x7c, 0xd2, 0x42, 0xc0, 0x54, 0x42, 0x65, 0xf9, 0xe2, 0x73, 0x55, 0xcb, 0x18, 0x58, 0x56, 0xa1,
-	0x8f, 0x27, 0x13, 0x37, 0x18, 0xa1, 0x21, 0xa3, 0xbe, 0x5d, 0x9f, 0x34, 0x15, 0x05, 0x97, 0x85, 0xbc, 0xb3,
-	0xd8, 0x41, 0x4b, 0x77, 0x55, 0x81, 0xef, 0x28, 0x95, 0xdb, 0xb2, 0x79, 0x94, 0xb5, 0x84, 0xce, 0x9b, 0x47,
-	0x55, 0x55, 0xe8, 0x82, 0x79, 0xb6, 0xea, 0x0b, 0x3d, 0x12, 0x87, 0x91, 0x8f, 0x8f, 0xaa, 0xc7, 0xbb, 0x76,
-	0x33, 0xab, 0x43, 0x5e, 0xd1, 0xcc, 0x77, 0x57, 0x11, 0x4a, 0x64, 0x93, 0x22, 0x2b, 0xaa, 0x06, 0xa4, 0xb1,
-	0x42, 0x9f, 0x90, 0x00, 0x8d, 0x30, 0xeb, 0xe3, 0x91, 0x58, 0xc0, 0x7a, 0x9e, 0xec, 0x8a, 0xf3, 0x39, 0x2b,
-	0x00, 0x99, 0x1b, 0x59, 0xa5, 0xdb, 0x63, 0x12, 0x8e, 0xf5, 0xe7, 0xa5, 0xab, 0x14, 0x4d, 0x18, 0x1d, 0x31,
-	0xec, 0xcb, 0xca, 0x54, 0xbe, 0xe5, 0xfb, 0xd9, 0xeb, 0xa1, 0x75, 0xcc, 0xb9, 0x5c, 0x13, 0xe3, 0xc0, 0x41,
-	0x9e, 0x1b, 0x10, 0xb1, 0xfe, 0x8f, 0xa4, 0x1e, 0x42, 0x7e, 0x84, 0x22, 0xc1, 0x96, 0xcb, 0x68, 0x20, 0x97,
-	0xcc, 0x5b, 0x98, 0xb9, 0xb8, 0xef, 0xa9, 0x6a, 0xb3, 0xde, 0x12, 0xd1, 0x10, 0xdb, 0xcc, 0x0d, 0x45, 0xfd,
-	0x86, 0x63, 0xe2, 0x0b, 0x67, 0x91, 0xb0, 0x88, 0x0e, 0x33, 0xe4, 0xcb, 0xef, 0xce, 0xd2, 0x38, 0x43, 0x0a,
-	0x56, 0x95, 0xa8, 0x71, 0x0d, 0x5d, 0x58, 0x7c, 0xfc, 0xd1, 0x94, 0x0d, 0x76, 0xc8, 0xd6, 0xe5, 0x32, 0x49,
-	0xae, 0x7b, 0xb2, 0xf6, 0x4f, 0x13, 0xe9, 0xa4, 0x02, 0xdb, 0x16, 0x4f, 0x62, 0xa6, 0xe0, 0xf9, 0xd5, 0xa4,
-	0x58, 0x70, 0x9d, 0x45, 0x0b, 0x6f, 0x5e, 0x34, 0x5f, 0xaa, 0x8a, 0x3e, 0xb9, 0xdb, 0x1f, 0xd9, 0x8d, 0xbe,
-	0x4b, 0xc6, 0x17, 0xf7, 0x56, 0x31, 0x4e, 0xca, 0x5f, 0x74, 0x2c, 0x3a, 0xf3, 0x67, 0xd7, 0x52, 0xfe, 0x56,
-	0xb3, 0x8a, 0xcd, 0x87, 0xd1, 0x63, 0x19, 0xbb, 0xda, 0x24, 0xe0, 0x63, 0xe8, 0x9c, 0xed, 0xaf, 0xc8, 0x40,
-	0x6c, 0x12, 0xb2, 0x55, 0x3d, 0xf8, 0xcd, 0xdb, 0xbb, 0xbc, 0x81, 0xd9, 0xe8, 0x60, 0x36, 0xda, 0x8a, 0xd3,
-	0x4e, 0x79, 0xd9, 0x5a, 0x0f, 0x19, 0x57, 0x1d, 0x2a, 0x25, 0x1b, 0xe8, 0x0e, 0x29, 0xbb, 0x82, 0x07, 0xe3,
-	0x56, 0x0b, 0xb3, 0x91, 0x55, 0xb9, 0x26, 0x88, 0xfa, 0xd0, 0xa0, 0x9a, 0x40, 0x7a, 0x69, 0xdb, 0xf6, 0xf8,
-	0x69, 0x5f, 0x8b, 0x6d, 0x3b, 0xd1, 0x4d, 0x22, 0x25, 0xd0, 0xca, 0x7e, 0xaa, 0x2b, 0x9e, 0x5a, 0x46, 0x95,
-	0xd0, 0xee, 0x72, 0xca, 0x42, 0xfd, 0x49, 0x50, 0xb8, 0x24, 0xb9, 0xd8, 0x24, 0xd3, 0x59, 0xb9, 0x78, 0xcb,
-	0x2b, 0x9f, 0xdb, 0x24, 0xd3, 0x9d, 0xb5, 0x57, 0x3e, 0x67, 0xe2, 0xbb, 0xb3, 0x49, 0xa6, 0x77, 0x77, 0xde,
-	0x52, 0x3f, 0x6f, 0x5a, 0x30, 0x61, 0xf5, 0x9a, 0xd8, 0x77, 0x9c, 0x93, 0x49, 0xaa, 0xb3, 0x15, 0x18, 0x5a,
-	0x9a, 0x9e, 0x21, 0x0d, 0x53, 0xaa, 0x6d, 0x32, 0x4d, 0x2b, 0xa3, 0x0b, 0x59, 0x8b, 0x62, 0xab, 0xfd, 0xe3,
-	0xcc, 0xcf, 0x1b, 0x63, 0x25, 0xf9, 0xaa, 0x6c, 0x12, 0x86, 0x46, 0x11, 0x66, 0x38, 0x08, 0x09, 0xe1, 0x48,
-	0x8d, 0x24, 0xc8, 0xc1, 0x21, 0x46, 0x3c, 0xc4, 0x2c, 0xe4, 0x6a, 0xec, 0xc2, 0x21, 0xf2, 0x08, 0xe6, 0xa1,
-	0x34, 0x9c, 0x79, 0xc6, 0x0d, 0xc4, 0xdc, 0x72, 0xd1, 0x71, 0x58, 0x57, 0xc7, 0xf6, 0x7a, 0x42, 0x26, 0xc8,
-	0x0d, 0xa4, 0x99, 0x17, 0xda, 0x76, 0xc3, 0x31, 0x1a, 0xf8, 0x4e, 0x4f, 0xc4, 0xdf, 0x93, 0x96, 0x34, 0x01,
-	0xf6, 0x7a, 0x9e, 0xd3, 0x13, 0xf1, 0x76, 0x47, 0x74, 0xa5, 0x28, 0x16, 0x55, 0x77, 0x19, 0x17, 0xfd, 0xc2,
-	0x8a, 0xc5, 0xd2, 0xd2, 0xe3, 0xca, 0xa0, 0xd6, 0xea, 0xf0, 0x4f, 0xac, 0x65, 0xfd, 0x97, 0x4e, 0xb3, 0x21,
-	0x0d, 0xb1, 0x67, 0xa4, 0xcc, 0x74, 0x98, 0x19, 0x7c, 0x4a, 0x87, 0x1c, 0x72, 0x7f, 0x40, 0x88, 0xc3, 0x91,
-	0xe7, 0xfa, 0x6e, 0xb8, 0x60, 0x9b, 0xfe, 0x15, 0x4c, 0xaa, 0x62, 0x95, 0x20, 0xde, 0x17, 0x79, 0x9a, 0xa1,
-	0x3f, 0xad, 0xc5, 0xdf, 0xf7, 0xd4, 0x00, 0xd8, 0x9e, 0x21, 0x4c, 0x69, 0x59, 0x13, 0x3d, 0xde, 0xc6, 0x6e,
-	0x88, 0x72, 0xaa, 0x97, 0x78, 0x69, 0x12, 0x7f, 0xfb, 0x4e, 0x16, 0x24, 0x85, 0x29, 0xe5, 0x16, 0x1c, 0xa9,
-	0x81, 0x19, 0x7b, 0x8c, 0x60, 0x67, 0xaa, 0x07, 0xe8, 0xe2, 0x85, 0x46, 0x52, 0x42, 0xfb, 0x6b, 0xfb, 0xee,
-	0x8b, 0xa6, 0xb2, 0xef, 0xe3, 0x4d, 0xf2, 0x54, 0x14, 0x0c, 0x6e, 0x33, 0x3c, 0x99, 0x10, 0xd6, 0x72, 0x4d,
-	0x14, 0x5a, 0x30, 0x42, 0xf5, 0xb8, 0xb3, 0x3a, 0x6f, 0x69, 0x1f, 0xb4, 0x69, 0x1c, 0x32, 0xa5, 0x36, 0x32,
-	0x29, 0xad, 0xd6, 0x79, 0x0e, 0xb9, 0xce, 0x8a, 0xfa, 0x64, 0x3f, 0x76, 0xf9, 0x0a, 0x52, 0x93, 0x20, 0x66,
-	0x23, 0xbe, 0x62, 0x4d, 0x31, 0x4a, 0x4f, 0x34, 0x37, 0xa2, 0x39, 0xb5, 0x8f, 0x8c, 0x27, 0x71, 0x4d, 0x5b,
-	0x17, 0x18, 0x44, 0x82, 0xf0, 0xd3, 0x55, 0x67, 0x49, 0xab, 0xd6, 0x6a, 0x71, 0x6e, 0x67, 0x7e, 0xa7, 0x2d,
-	0xfc, 0xae, 0xbc, 0xe3, 0xa7, 0xdc, 0x8f, 0x3d, 0xfd, 0x2d, 0x2f, 0xfc, 0x8e, 0x2f, 0xfb, 0x5f, 0xf3, 0xec,
-	0xef, 0xfd, 0xdc, 0x37, 0x7e, 0xe4, 0xcb, 0xbf, 0xf4, 0x07, 0x8f, 0x7c, 0xed, 0x2f, 0xde, 0xfe, 0xff, 0x7f,
-	0xfd, 0xf1, 0xd7, 0xbc, 0xfd, 0x13, 0x5f, 0x35, 0x55, 0x1c, 0x8a, 0xd7, 0x7d, 0xe1, 0xb8, 0x73, 0x28, 0x12,
-	0xf0, 0x44, 0xaf, 0x27, 0xd6, 0x34, 0xc8, 0x8f, 0xbc, 0xd0, 0x9d, 0x78, 0x04, 0x3d, 0x8d, 0xb7, 0xf0, 0xc6,
-	0x80, 0xb9, 0x93, 0xd0, 0x7e, 0x6f, 0xf4, 0x92, 0x89, 0xbb, 0xc1, 0xc8, 0x53, 0x47, 0x5e, 0x34, 0x40, 0x17,
-	0xd7, 0xaf, 0x75, 0x64, 0x04, 0x13, 0xb9, 0xe4, 0x91, 0xd6, 0xc6, 0xdb, 0xa4, 0x2f, 0x46, 0x97, 0xc0, 0xc1,
-	0xcc, 0xe1, 0x48, 0x64, 0x18, 0x5d, 0xa7, 0x0e, 0xe9, 0x3e, 0xcb, 0x85, 0xf7, 0xae, 0xf0, 0x2e, 0x83, 0x5c,
-	0xb1, 0xe3, 0x16, 0x0d, 0xed, 0x3a, 0x84, 0x11, 0x67, 0x45, 0x3a, 0x9e, 0x43, 0x4f, 0x32, 0xba, 0xcd, 0x09,
-	0xe3, 0xfa, 0x51, 0x47, 0xa1, 0x9f, 0xae, 0x88, 0xe5, 0x0a, 0xa3, 0x81, 0x7e, 0x5c, 0xc7, 0x6c, 0x40, 0x3c,
-	0xfd, 0x70, 0x9b, 0xf4, 0x27, 0x78, 0xb0, 0xa9, 0x95, 0x7d, 0x5a, 0x2e, 0xa0, 0x87, 0x3e, 0x31, 0x81, 0x2e,
-	0xc4, 0x87, 0xda, 0x0b, 0xaa, 0x9b, 0xf5, 0x7a, 0xc6, 0xd9, 0x7a, 0x5d, 0x78, 0xc8, 0xe7, 0xe7, 0x76, 0x10,
-	0xf1, 0x38, 0x41, 0x56, 0x3c, 0xdb, 0x6e, 0xe0, 0xd0, 0xed, 0xe2, 0x78, 0x94, 0x5b, 0x57, 0xc7, 0xb5, 0xa6,
-	0xfd, 0xae, 0x16, 0x45, 0xc3, 0x89, 0x37, 0x2c, 0x8e, 0x44, 0xb8, 0x24, 0x51, 0x88, 0xa7, 0x24, 0x82, 0x42,
-	0x31, 0xe2, 0x00, 0x07, 0x01, 0x0d, 0x91, 0x7a, 0x89, 0x45, 0xff, 0x6a, 0x05, 0xc4, 0x95, 0x0b, 0x35, 0x15,
-	0x4b, 0xc7, 0xe4, 0x39, 0xa0, 0x4c, 0xa5, 0xeb, 0x72, 0x64, 0x14, 0x21, 0x45, 0x22, 0xc8, 0xae, 0x16, 0x2b,
-	0xa2, 0x33, 0x67, 0x90, 0xce, 0xae, 0xf9, 0xa5, 0x30, 0xc7, 0x99, 0x50, 0x6b, 0xc6, 0x77, 0x41, 0xcc, 0x43,
-	0x2e, 0x22, 0x4d, 0x07, 0x68, 0x5b, 0x8c, 0x13, 0xc9, 0x16, 0xd1, 0xbf, 0xb7, 0x16, 0x86, 0x5c, 0x0d, 0x58,
-	0x56, 0xbd, 0x09, 0x0f, 0x22, 0x0f, 0x5a, 0xed, 0x21, 0x62, 0x1b, 0xf2, 0x58, 0xe6, 0x21, 0x1e, 0xed, 0x35,
-	0xc3, 0x90, 0x9b, 0x43, 0xca, 0xd4, 0xca, 0x29, 0xc9, 0xcc, 0x1a, 0x1a, 0xf2, 0xd5, 0x44, 0x93, 0x7b, 0x64,
-	0x04, 0x96, 0x5a, 0xf4, 0x95, 0xc3, 0x20, 0xaf, 0x9c, 0x28, 0xd0, 0x4a, 0x03, 0xca, 0x0a, 0x20, 0x56, 0x00,
-	0xb1, 0x02, 0x88, 0x15, 0x40, 0xac, 0x00, 0x62, 0x05, 0x10, 0x2b, 0x8d, 0x10, 0x2b, 0x45, 0x78, 0x15, 0x40,
-	0xab, 0x34, 0x41, 0xab, 0x18, 0x62, 0x49, 0xe5, 0xc2, 0x2f, 0xa0, 0x0e, 0xb9, 0xa4, 0xfc, 0x59, 0x0b, 0x40,
-	0x15, 0x52, 0xad, 0x0f, 0xd2, 0x91, 0x25, 0x35, 0x9f, 0x32, 0x1f, 0xee, 0x9b, 0x09, 0x34, 0x89, 0xaf, 0xcb,
-	0xa4, 0x87, 0xa7, 0x5c, 0xcf, 0x93, 0xb3, 0x62, 0xdf, 0x9a, 0xf8, 0x54, 0x7b, 0x17, 0x64, 0xb6, 0x92, 0xfb,
-	0xd1, 0x08, 0xc1, 0x52, 0x9b, 0xbd, 0x92, 0xe7, 0xae, 0xe4, 0x7f, 0x37, 0xc5, 0x56, 0x02, 0x44, 0x7b, 0x87,
-	0x7c, 0x87, 0x93, 0x81, 0x52, 0x95, 0xdc, 0x45, 0x6b, 0x48, 0xf7, 0xcf, 0xee, 0x98, 0x85, 0x6e, 0x6e, 0x83,
-	0xcb, 0xc9, 0x40, 0x2b, 0x38, 0xd0, 0x59, 0xa5, 0x43, 0xe9, 0x19, 0x3d, 0x47, 0xb6, 0x66, 0xd2, 0x19, 0xb4,
-	0xf9, 0x2a, 0x25, 0x8d, 0x59, 0xe0, 0xd3, 0x6a, 0xce, 0x28, 0x74, 0xbd, 0x85, 0xb6, 0xed, 0x9a, 0xaf, 0xff,
-	0xba, 0xc4, 0x97, 0xfd, 0xa0, 0xbd, 0xa4, 0xe0, 0x2d, 0xb3, 0x4b, 0x74, 0x99, 0x54, 0x95, 0x48, 0xbb, 0x16,
-	0x97, 0xa8, 0x0e, 0x4e, 0x66, 0xd7, 0x28, 0x19, 0xb9, 0x95, 0x76, 0x10, 0x1d, 0xc6, 0x7e, 0xb8, 0xd4, 0x6e,
-	0x24, 0x5b, 0xf4, 0xee, 0x41, 0x03, 0x67, 0x00, 0x36, 0x03, 0xb0, 0x19, 0x00, 0xc3, 0x00, 0x18, 0x06, 0xc0,
-	0x30, 0x00, 0x86, 0x01, 0x30, 0x0c, 0x80, 0x61, 0x00, 0x0c, 0x03, 0x60, 0x98, 0x83, 0x06, 0xc3, 0xec, 0x1b,
-	0x96, 0x65, 0x44, 0x01, 0xa1, 0x02, 0x08, 0x15, 0x40, 0xa8, 0x00, 0x42, 0x05, 0x10, 0x2a, 0x80, 0x50, 0x01,
-	0x84, 0x0a, 0x20, 0x54, 0x1e, 0x78, 0x84, 0x8a, 0xe8, 0xb3, 0x1d, 0xa5, 0x6e, 0x33, 0xcd, 0x23, 0xcd, 0xc9,
-	0xa4, 0xd1, 0x30, 0x17, 0xff, 0x0b, 0x29, 0x1a, 0xba, 0x8c, 0xa0, 0x68, 0x22, 0xfe, 0x5c, 0x42, 0xbe, 0xeb,
-	0x79, 0x2e, 0x27, 0x03, 0x1a, 0x38, 0x88, 0x60, 0xe6, 0x4d, 0x01, 0xc2, 0x02, 0x10, 0x16, 0x80, 0xb0, 0x00,
-	0x84, 0x05, 0x20, 0x2c, 0x00, 0x61, 0x01, 0x08, 0x0b, 0x40, 0x58, 0x00, 0xc2, 0x02, 0x10, 0x16, 0x80, 0xb0,
-	0x00, 0x84, 0x05, 0x20, 0x2c, 0x00, 0x61, 0x01, 0x08, 0x0b, 0x40, 0x58, 0x00, 0xc2, 0x02, 0x10, 0x16, 0x80,
-	0xb0, 0x00, 0x84, 0x05, 0x20, 0x2c, 0x00, 0x61, 0x01, 0x08, 0xcb, 0x11, 0x41, 0x58, 0x00, 0xc0, 0x52, 0x1f,
-	0xc0, 0x02, 0xf0, 0x15, 0x80, 0xaf, 0x00, 0x7c, 0x05, 0xe0, 0x2b, 0x00, 0x5f, 0x01, 0xf8, 0x0a, 0xc0, 0x57,
-	0x0e, 0x1d, 0xbe, 0xa2, 0x44, 0x55, 0xf3, 0xca, 0xfc, 0x99, 0x0e, 0x91, 0x4f, 0x9d, 0xc8, 0xcb, 0x11, 0x29,
-	0xd0, 0x99, 0x33, 0x79, 0x22, 0x45, 0xc1, 0x6f, 0x5d, 0x1f, 0xbb, 0x81, 0xfc, 0xfe, 0xab, 0xe3, 0xb1, 0xfd,
-	0x18, 0x79, 0x67, 0xc1, 0x6f, 0xdd, 0x2d, 0xc2, 0xb8, 0xb4, 0xee, 0x94, 0x8e, 0x0f, 0x95, 0xb8, 0x76, 0x49,
-	0xcc, 0x27, 0x51, 0x95, 0x29, 0xf2, 0x6e, 0x3c, 0x59, 0x63, 0x3d, 0xfa, 0x36, 0x74, 0xde, 0x6a, 0x19, 0xb1,
-	0x68, 0x26, 0xaa, 0x2b, 0x45, 0x1c, 0x8f, 0xc8, 0x0a, 0x1a, 0xd1, 0x7b, 0xcf, 0xf2, 0x7b, 0xe2, 0x4d, 0xbc,
-	0x47, 0xee, 0x93, 0x01, 0xba, 0x23, 0xfe, 0x44, 0x7d, 0x37, 0xc0, 0x6c, 0x7a, 0x17, 0xdd, 0x89, 0xeb, 0xfd,
-	0xae, 0xee, 0x5d, 0x26, 0x0d, 0x69, 0xe0, 0xa7, 0x56, 0xed, 0xb2, 0x47, 0x58, 0xed, 0x2e, 0xfa, 0xeb, 0x55,
-	0xaa, 0x2a, 0x7e, 0x44, 0x8d, 0x24, 0x2b, 0x95, 0x39, 0x2e, 0xf7, 0x46, 0xcb, 0xc6, 0x8b, 0x92, 0x67, 0xe9,
-	0xf9, 0x00, 0x73, 0xee, 0x8e, 0x82, 0xd6, 0x73, 0xe8, 0xd6, 0x33, 0xeb, 0x97, 0xaf, 0xdd, 0x5c, 0x49, 0xc4,
-	0x70, 0x94, 0x2f, 0xb4, 0xbb, 0xa1, 0x3f, 0x51, 0xfa, 0xd0, 0x9d, 0x4e, 0x1c, 0xa7, 0x98, 0x38, 0x4c, 0x54,
-	0x4a, 0xd7, 0x65, 0x67, 0x53, 0xb8, 0xd8, 0x2b, 0x73, 0xb5, 0xde, 0x0e, 0x5d, 0x29, 0x8e, 0x17, 0xef, 0x05,
-	0x76, 0x9e, 0x72, 0x3d, 0x65, 0xa4, 0x67, 0xe7, 0xf2, 0xce, 0xf2, 0xdd, 0x76, 0x07, 0x8d, 0x68, 0xca, 0xa8,
-	0xb7, 0xdd, 0x0d, 0xc7, 0x24, 0x90, 0xfa, 0xad, 0xc8, 0x0b, 0x93, 0x69, 0xc0, 0x04, 0xa4, 0x5a, 0x1f, 0xb6,
-	0xd0, 0xb1, 0xb5, 0x65, 0x62, 0x81, 0x65, 0x10, 0x35, 0xc2, 0x95, 0x23, 0x77, 0x88, 0x82, 0x8c, 0xe1, 0x2d,
-	0x72, 0x39, 0xd2, 0x5d, 0x3a, 0xad, 0x45, 0x93, 0x56, 0x04, 0xe8, 0xcc, 0x19, 0xf4, 0x90, 0x2e, 0xa0, 0x25,
-	0xa8, 0xe8, 0xf5, 0x90, 0x43, 0xb0, 0xe3, 0xd1, 0xc1, 0x66, 0x47, 0xda, 0xec, 0xaa, 0xc5, 0x9b, 0x1b, 0x48,
-	0x9a, 0x08, 0x65, 0xca, 0x1e, 0x58, 0xda, 0xcb, 0x86, 0x0c, 0x0f, 0x88, 0x5a, 0xab, 0x16, 0xbd, 0x3e, 0xea,
-	0xf5, 0x5b, 0x34, 0x2f, 0x58, 0xc1, 0x3b, 0xb4, 0x63, 0xcd, 0xa1, 0xfa, 0x7d, 0x1a, 0xc9, 0x9d, 0x8f, 0xae,
-	0x8d, 0x6e, 0xbc, 0xbd, 0x92, 0xdd, 0xa2, 0xdd, 0x95, 0x87, 0xae, 0x2d, 0x75, 0xe8, 0x6a, 0x2a, 0x2a, 0xdd,
-	0x0d, 0xe3, 0xa3, 0xd2, 0xa2, 0x7e, 0xa5, 0xf4, 0x87, 0x0a, 0x8a, 0x74, 0xe5, 0x37, 0x7e, 0xf4, 0xaf, 0xd8,
-	0x37, 0xbd, 0x32, 0xba, 0xfc, 0xe3, 0xf4, 0xc9, 0xf1, 0xe7, 0x7f, 0xe0, 0x03, 0x3f, 0xff, 0x67, 0xbf, 0xfb,
-	0xfe, 0xb7, 0x9c, 0xfb, 0xf8, 0x07, 0x5e, 0x72, 0xf5, 0x83, 0x9f, 0xfb, 0xfd, 0x1f, 0xfa, 0x58, 0x5b, 0x41,
-	0x91, 0x2e, 0xf6, 0x8e, 0x3b, 0x14, 0x69, 0x21, 0xe2, 0xca, 0xc4, 0x62, 0x10, 0x2e, 0xac, 0xda, 0x8c, 0xa4,
-	0x23, 0x43, 0xc3, 0xc8, 0xbb, 0xfc, 0xeb, 0xe0, 0x61, 0xb4, 0x47, 0x40, 0xc4, 0xd8, 0xdc, 0x17, 0x37, 0x18,
-	0x35, 0xa0, 0xc4, 0x08, 0xdf, 0x00, 0x8a, 0x01, 0x50, 0x0c, 0x80, 0x62, 0x00, 0x14, 0x03, 0xa0, 0x18, 0x00,
-	0xc5, 0x34, 0x01, 0xc5, 0xc8, 0xe9, 0xb7, 0x00, 0x16, 0x63, 0xff, 0x0e, 0xc0, 0x98, 0x3a, 0xc0, 0x18, 0x59,
-	0x63, 0x35, 0x38, 0x2c, 0x96, 0xbf, 0x7a, 0x2c, 0x96, 0x62, 0x73, 0xe7, 0x72, 0x24, 0x8b, 0x6a, 0xba, 0x2c,
-	0x96, 0xa5, 0x2a, 0x37, 0x36, 0xab, 0xa5, 0x56, 0x96, 0x32, 0x82, 0x98, 0x19, 0x79, 0xa9, 0x0b, 0x47, 0xc9,
-	0x7a, 0xde, 0x2d, 0x20, 0x25, 0x8e, 0xa7, 0x0e, 0xbf, 0x24, 0xeb, 0xb9, 0x51, 0xa2, 0x07, 0x4a, 0x27, 0x91,
-	0x39, 0x03, 0x42, 0x09, 0x10, 0x4a, 0x0a, 0xa5, 0xeb, 0x46, 0x8e, 0xb2, 0xd7, 0x7c, 0x01, 0xf5, 0x04, 0xa8,
-	0x27, 0x40, 0x3d, 0x01, 0xea, 0x09, 0x50, 0x4f, 0x80, 0x7a, 0x02, 0xd4, 0x13, 0xa0, 0x9e, 0x1c, 0x73, 0xea,
-	0xc9, 0xbd, 0x11, 0x0d, 0x09, 0x0f, 0x63, 0xf4, 0x09, 0x76, 0x9c, 0x15, 0xd4, 0xc2, 0x1d, 0xd4, 0x97, 0xf5,
-	0x82, 0xd1, 0x59, 0xd4, 0xd7, 0xe6, 0x5a, 0xda, 0x62, 0x88, 0x3e, 0xcb, 0x01, 0x94, 0x02, 0xa0, 0x14, 0x00,
-	0xa5, 0x00, 0x28, 0x05, 0x40, 0x29, 0x00, 0x4a, 0x01, 0x50, 0x0a, 0x80, 0x52, 0x1e, 0x78, 0x50, 0x4a, 0x07,
-	0x30, 0x27, 0x80, 0x39, 0x01, 0xcc, 0x09, 0x60, 0x4e, 0x00, 0x73, 0x02, 0x98, 0x13, 0xc0, 0x9c, 0x00, 0xe6,
-	0x04, 0x30, 0x27, 0x80, 0x39, 0x01, 0xcc, 0x09, 0x60, 0x4e, 0x00, 0x73, 0x02, 0x98, 0x13, 0xc0, 0x9c, 0x00,
-	0xe6, 0x04, 0x30, 0x27, 0x80, 0x39, 0x01, 0xcc, 0x09, 0x60, 0x4e, 0x00, 0x73, 0x02, 0x98, 0x13, 0xc0, 0x9c,
-	0x1c, 0x11, 0xe6, 0xe4, 0x96, 0x01, 0x9c, 0x00, 0xea, 0xa4, 0x16, 0xea, 0x44, 0xd6, 0x17, 0xe0, 0x4e, 0x00,
-	0x77, 0x02, 0xb8, 0x13, 0xc0, 0x9d, 0x00, 0xee, 0x04, 0x70, 0x27, 0x80, 0x3b, 0x39, 0x6c, 0xdc, 0x89, 0xc2,
-	0x2a, 0x3c, 0xf5, 0xb9, 0x17, 0xff, 0xe7, 0x0f, 0xbc, 0xe3, 0xa3, 0xbf, 0xfb, 0x33, 0x83, 0x4f, 0x47, 0xbf,
-	0x72, 0xe3, 0x27, 0x2e, 0x7d, 0xe0, 0xd3, 0xf7, 0xbf, 0x3c, 0xf7, 0x6f, 0xff, 0xdb, 0x17, 0xbe, 0xf3, 0xab,
-	0x3e, 0xfc, 0xd9, 0x17, 0xbe, 0xf1, 0x67, 0x3f, 0xa6, 0xb0, 0x0a, 0xdd, 0xef, 0x39, 0xee, 0x58, 0x85, 0x84,
-	0xa3, 0xd0, 0xeb, 0x89, 0x35, 0x0d, 0xda, 0x26, 0x7d, 0xd4, 0x67, 0x74, 0x9b, 0x13, 0x86, 0x2e, 0xae, 0x5f,
-	0x93, 0xef, 0x8d, 0x21, 0x70, 0x88, 0x67, 0xb9, 0x62, 0xe2, 0x6e, 0x30, 0xf2, 0x88, 0x75, 0x8f, 0x35, 0x6a,
-	0x4d, 0xe4, 0x6a, 0x47, 0x5a, 0xf1, 0x8a, 0x28, 0xc4, 0x92, 0xd5, 0xc1, 0xcc, 0xe1, 0x48, 0xe4, 0xd5, 0x8e,
-	0xa1, 0xdd, 0x35, 0x4a, 0x31, 0x97, 0x8b, 0x9f, 0x9f, 0xde, 0x10, 0x7d, 0x40, 0xd9, 0xca, 0x1b, 0x35, 0x66,
-	0x86, 0x1d, 0xb3, 0x3a, 0x6f, 0xa4, 0x19, 0xd2, 0x7f, 0xea, 0xfe, 0x6f, 0x83, 0x92, 0x89, 0xef, 0xfe, 0xb6,
-	0xae, 0xa4, 0x97, 0xcc, 0x85, 0x98, 0x82, 0x32, 0xe4, 0x4a, 0x7a, 0x76, 0xd4, 0x17, 0xdb, 0x1f, 0xfd, 0x05,
-	0xf2, 0x71, 0x1d, 0x44, 0xa1, 0xeb, 0xe5, 0x6f, 0x4d, 0x5f, 0x2d, 0xbb, 0x2f, 0x5e, 0x38, 0x67, 0x2e, 0x89,
-	0x2f, 0xbe, 0x86, 0x3d, 0xf6, 0x68, 0xdd, 0xbd, 0x8e, 0x88, 0xc7, 0x49, 0x8c, 0xdc, 0xd1, 0x0d, 0xbe, 0xed,
-	0x06, 0x0e, 0xdd, 0xce, 0xb6, 0xb7, 0xae, 0x00, 0xe5, 0xd8, 0xd5, 0xf3, 0xd5, 0x9a, 0xf6, 0x2c, 0x5f, 0x44,
-	0x15, 0x99, 0x15, 0x11, 0x27, 0xde, 0xb0, 0x24, 0x1a, 0xe1, 0x94, 0x44, 0x22, 0x9e, 0xac, 0x28, 0xb4, 0xbe,
-	0x30, 0x33, 0x7e, 0x0e, 0x70, 0x10, 0xd0, 0x10, 0xa9, 0x21, 0x51, 0xbc, 0xad, 0xad, 0x80, 0xb8, 0x72, 0xd9,
-	0xab, 0x33, 0x1c, 0x50, 0xa6, 0x92, 0x74, 0x39, 0x32, 0xa2, 0x95, 0x85, 0x04, 0xea, 0x53, 0x45, 0x03, 0x01,
-	0x12, 0x48, 0x86, 0xed, 0xd1, 0x00, 0x03, 0xd2, 0x94, 0x01, 0x42, 0x27, 0x24, 0x50, 0x78, 0xa2, 0x2c, 0x8d,
-	0x20, 0x33, 0xb5, 0xd4, 0x82, 0xc4, 0x94, 0x62, 0x62, 0x4c, 0x0f, 0x52, 0x9c, 0x98, 0xe4, 0x3d, 0x3b, 0x78,
-	0x01, 0xed, 0xc9, 0x14, 0xcf, 0x0e, 0xd2, 0xc6, 0x96, 0x7b, 0x12, 0xaf, 0xaa, 0xb3, 0x10, 0xdb, 0xe0, 0x52,
-	0x2f, 0x7c, 0xd5, 0xf6, 0x4b, 0x0a, 0x13, 0xb4, 0xfe, 0x61, 0x7b, 0x4c, 0x02, 0x39, 0xd6, 0x11, 0x2e, 0x77,
-	0x94, 0x3e, 0x65, 0xc4, 0xde, 0x43, 0xda, 0x7a, 0x9e, 0x26, 0x07, 0x2b, 0x3b, 0xb5, 0xb5, 0xb4, 0x3e, 0xf1,
-	0x5b, 0xed, 0x1a, 0x42, 0xda, 0x12, 0x7f, 0x07, 0x79, 0xcf, 0xbf, 0x4a, 0xb2, 0x89, 0xdc, 0x35, 0x0e, 0x71,
-	0xd8, 0x5a, 0xd7, 0x38, 0xe1, 0x4a, 0xa1, 0x6b, 0x73, 0xe1, 0x6a, 0x51, 0x15, 0x1c, 0x33, 0xd5, 0xea, 0x0c,
-	0xa9, 0x6a, 0x79, 0xbf, 0x29, 0xd6, 0xa9, 0x16, 0xf9, 0xaf, 0x27, 0x52, 0x8d, 0x43, 0x36, 0x55, 0xa8, 0x1a,
-	0x75, 0x64, 0x46, 0x16, 0x19, 0xcf, 0xda, 0x2b, 0xfb, 0x5a, 0x94, 0xa5, 0xaa, 0xa2, 0xc8, 0x84, 0x83, 0xc8,
-	0xf3, 0xf6, 0x37, 0xcd, 0xe5, 0x99, 0x69, 0x0a, 0xe7, 0xfd, 0x4d, 0xf3, 0xfc, 0xcc, 0x34, 0xe5, 0x09, 0xde,
-	0xfe, 0x26, 0x7a, 0xa1, 0x22, 0x51, 0x4b, 0x37, 0xcc, 0x48, 0x2c, 0x50, 0xbf, 0xc7, 0xc8, 0x30, 0xaf, 0x1c,
-	0x96, 0x1e, 0x0a, 0xf5, 0xbf, 0x76, 0xd0, 0x22, 0xfd, 0x6f, 0xca, 0x45, 0x9e, 0xd2, 0xc4, 0xd6, 0xae, 0x56,
-	0x7a, 0x5a, 0xd9, 0xcb, 0xc8, 0x30, 0xb5, 0x70, 0xd8, 0x95, 0x7a, 0xb7, 0x5c, 0x67, 0xbb, 0xb4, 0x1b, 0x9d,
-	0xed, 0xf2, 0xee, 0x74, 0xb6, 0xe7, 0x4b, 0x75, 0xb6, 0x33, 0x5b, 0xb6, 0x4c, 0x64, 0x5b, 0xdc, 0xc4, 0xea,
-	0xcb, 0x2f, 0x48, 0x6c, 0x41, 0x62, 0x7b, 0xaa, 0x24, 0xb6, 0x23, 0x6a, 0xeb, 0x65, 0xf7, 0x41, 0x78, 0x99,
-	0xd2, 0x59, 0x66, 0x16, 0x46, 0x87, 0x2d, 0xb2, 0x14, 0x63, 0xde, 0xf1, 0x91, 0x4a, 0xee, 0xb7, 0x32, 0xb2,
-	0xa8, 0x72, 0x0f, 0x57, 0x16, 0x69, 0xd4, 0x84, 0x33, 0xc4, 0x86, 0x45, 0x86, 0x03, 0xc7, 0x5c, 0x5a, 0xb8,
-	0xbf, 0x4a, 0xc2, 0x78, 0x46, 0xd9, 0x57, 0x19, 0xa1, 0xd9, 0x10, 0xd6, 0x97, 0x10, 0x9a, 0x10, 0xa5, 0x05,
-	0x3e, 0x00, 0xb5, 0x60, 0x76, 0x87, 0x5b, 0x43, 0x2c, 0x88, 0x52, 0x87, 0xea, 0x26, 0x85, 0xe4, 0x60, 0x1d,
-	0x1d, 0xee, 0xa5, 0xe0, 0x65, 0xad, 0x57, 0x4f, 0x2a, 0xb7, 0x61, 0x34, 0x66, 0x71, 0x73, 0x55, 0xea, 0xe5,
-	0x72, 0xde, 0xab, 0xdb, 0xaa, 0xce, 0x00, 0x5b, 0x20, 0x90, 0xcb, 0x35, 0x4a, 0xb1, 0x3e, 0x2e, 0xe7, 0xed,
-	0xb0, 0xe4, 0x71, 0x7b, 0x56, 0xc3, 0xed, 0x9f, 0x54, 0xeb, 0xd8, 0x28, 0xb3, 0x0a, 0xc4, 0x4f, 0xbb, 0x50,
-	0x63, 0x1d, 0x8e, 0x52, 0xea, 0x30, 0x84, 0x51, 0xfb, 0xa4, 0x32, 0x3a, 0x49, 0xa2, 0xa2, 0x13, 0xad, 0x21,
-	0x3a, 0xa6, 0x92, 0xa1, 0x22, 0x6d, 0x4c, 0xa9, 0x6e, 0x28, 0x09, 0x96, 0xda, 0x24, 0x36, 0x92, 0xf3, 0xcc,
-	0xd2, 0xe2, 0x94, 0xc5, 0x7c, 0x94, 0x42, 0x9c, 0x03, 0x17, 0x4d, 0x14, 0x89, 0x03, 0x66, 0x8a, 0x6f, 0xaa,
-	0xeb, 0xac, 0x9e, 0xae, 0x61, 0x96, 0x28, 0xa1, 0x2c, 0xe6, 0xa3, 0x51, 0xb3, 0x1c, 0x69, 0x3b, 0xe4, 0x15,
-	0x2c, 0x27, 0xa0, 0x01, 0xf6, 0x2e, 0x09, 0x39, 0xfe, 0x0a, 0x90, 0x53, 0x2f, 0xf8, 0x38, 0x59, 0xfa, 0x8e,
-	0xa2, 0x1e, 0x6b, 0x96, 0x8d, 0x4d, 0xa4, 0x1c, 0x47, 0x61, 0xe7, 0x3d, 0xc3, 0xdc, 0x3a, 0x63, 0x33, 0x7d,
-	0xeb, 0xc6, 0xe5, 0x1b, 0x2b, 0x59, 0xa3, 0x59, 0x97, 0x06, 0x69, 0x2b, 0xe8, 0x64, 0xd8, 0xde, 0xcd, 0xa5,
-	0x8f, 0xf9, 0xc3, 0x89, 0x0a, 0x2b, 0x69, 0x46, 0x86, 0x65, 0x9f, 0x50, 0xcd, 0x5e, 0x66, 0x63, 0x1c, 0x85,
-	0x0e, 0xdd, 0x0e, 0x62, 0x53, 0xde, 0x32, 0x13, 0xdf, 0xdc, 0x97, 0xd5, 0x06, 0x1f, 0x43, 0x4f, 0x90, 0xe5,
-	0xaf, 0x08, 0x94, 0xb7, 0xfb, 0xcd, 0x9c, 0x12, 0x15, 0x19, 0xf1, 0x26, 0xc3, 0x80, 0x34, 0x44, 0x90, 0x2f,
-	0x5b, 0xb9, 0x81, 0x70, 0xce, 0x44, 0x33, 0x15, 0xbc, 0x25, 0x0d, 0x76, 0xad, 0x9f, 0x1e, 0x4e, 0x36, 0x55,
-	0xc7, 0xd2, 0x5e, 0xb7, 0xb9, 0x69, 0x6e, 0x3a, 0x52, 0x69, 0xa8, 0x6b, 0x0f, 0xa2, 0x07, 0x62, 0xb9, 0xbb,
-	0x17, 0x23, 0xdd, 0x7a, 0xf6, 0xb9, 0x7b, 0x35, 0xcd, 0x35, 0xd8, 0x27, 0xe1, 0x33, 0x75, 0x08, 0x3f, 0x48,
-	0x9f, 0xfa, 0xcc, 0x26, 0x8b, 0xcf, 0x55, 0x1d, 0x1a, 0x65, 0xec, 0x22, 0x4a, 0xad, 0x39, 0x8b, 0x2c, 0xa0,
-	0xfa, 0xd8, 0x89, 0x73, 0xb3, 0x82, 0xea, 0xd8, 0x93, 0x5a, 0xca, 0x21, 0xeb, 0x90, 0x4b, 0xe7, 0x4c, 0xae,
-	0x5c, 0x94, 0x5a, 0x62, 0x93, 0x20, 0x1e, 0x31, 0x82, 0xdc, 0x10, 0xb9, 0x1c, 0x61, 0x0b, 0x1a, 0xa9, 0xc7,
-	0x6e, 0xfd, 0x45, 0xc3, 0xb6, 0x85, 0x9e, 0x6d, 0x83, 0x5b, 0x5a, 0xb6, 0xdc, 0x17, 0x32, 0x65, 0x72, 0x9b,
-	0xa9, 0xe9, 0xb4, 0xd9, 0x2a, 0x0f, 0x71, 0xe8, 0x0e, 0x90, 0xb4, 0x5e, 0x35, 0x75, 0xfa, 0x3a, 0xe2, 0x29,
-	0x03, 0xd6, 0x8e, 0xb9, 0x6b, 0xcc, 0xb8, 0xf0, 0x0e, 0x1a, 0xe9, 0xfb, 0x10, 0xb2, 0x26, 0xac, 0xb1, 0x29,
-	0x5f, 0x36, 0x88, 0xea, 0xd4, 0x89, 0x25, 0x6b, 0xce, 0x7a, 0xb5, 0x9d, 0x32, 0x50, 0x2d, 0x3e, 0x11, 0x4c,
-	0xcf, 0x6e, 0x76, 0xa6, 0xa5, 0xa9, 0x6b, 0x26, 0xe7, 0x13, 0x26, 0x8d, 0x5a, 0x2f, 0x93, 0x21, 0x8e, 0x3c,
-	0x39, 0x92, 0xd1, 0xc9, 0x3a, 0xa3, 0x13, 0x3c, 0xc2, 0xea, 0x36, 0x15, 0xf1, 0xc3, 0x35, 0xdf, 0x27, 0x8e,
-	0x8b, 0x15, 0x1a, 0x25, 0x76, 0x19, 0x06, 0x25, 0xc5, 0x93, 0x31, 0xda, 0xf7, 0x26, 0xa5, 0x13, 0x89, 0x1b,
-	0x41, 0x99, 0xd3, 0xa6, 0x1d, 0x53, 0x97, 0xa3, 0xc9, 0xc0, 0x99, 0x1c, 0x65, 0x42, 0x67, 0x5c, 0x8b, 0x83,
-	0x17, 0xe5, 0xbf, 0x20, 0x9e, 0x22, 0x6f, 0xe9, 0x08, 0x87, 0xa6, 0x70, 0x85, 0x97, 0x1d, 0xa6, 0x2d, 0x51,
-	0x67, 0x5f, 0x21, 0x68, 0x5c, 0x79, 0xe8, 0x10, 0xc6, 0xd4, 0xb7, 0x89, 0xe6, 0x37, 0x09, 0xc6, 0x36, 0x76,
-	0x07, 0x78, 0x97, 0xa0, 0x75, 0x33, 0xe0, 0x89, 0xbc, 0x18, 0xd0, 0xbc, 0xd7, 0x87, 0x7d, 0x27, 0x60, 0xc1,
-	0x42, 0xcb, 0xfa, 0xa8, 0x27, 0x7c, 0xe4, 0x86, 0xb0, 0xfd, 0xbc, 0x1c, 0x30, 0x65, 0x7f, 0x98, 0xba, 0xff,
-	0xef, 0x3b, 0xff, 0xf5, 0xf7, 0x5f, 0x7e, 0xe1, 0x4b, 0x5f, 0xf4, 0x9b, 0xef, 0xfe, 0xd4, 0xc5, 0x27, 0xff,
-	0xcf, 0x0f, 0x8f, 0xae, 0xbd, 0x6b, 0x6d, 0xfe, 0xc9, 0xff, 0xf8, 0x0f, 0xde, 0xf8, 0x9a, 0xff, 0xf4, 0xde,
-	0x6f, 0xfd, 0xfc, 0xf0, 0x8d, 0x9f, 0xfa, 0xef, 0xca, 0x50, 0xfd, 0xea, 0x5f, 0x9c, 0x38, 0x43, 0x75, 0x3f,
-	0xf2, 0x42, 0x77, 0xe2, 0x11, 0xf4, 0x34, 0xde, 0xc2, 0x1b, 0x03, 0xe6, 0x4e, 0x42, 0x7b, 0x65, 0xc9, 0x4b,
-	0x2c, 0xd5, 0x3b, 0x32, 0x82, 0xda, 0xd6, 0xea, 0x5d, 0xe1, 0x5d, 0x06, 0xb9, 0x62, 0xc7, 0x2d, 0xde, 0x34,
-	0xd7, 0x21, 0x4c, 0xda, 0x2b, 0xf5, 0x7a, 0xe8, 0x1c, 0x7a, 0x52, 0xd9, 0xcc, 0x73, 0xfd, 0xa8, 0xa3, 0xd0,
-	0x4f, 0x57, 0xe2, 0x4b, 0x47, 0xe5, 0xe3, 0x3a, 0x66, 0x03, 0xe2, 0xe9, 0x87, 0xdb, 0xa4, 0x3f, 0xc1, 0x83,
-	0x4d, 0x3d, 0xb2, 0x68, 0xb3, 0x13, 0x6d, 0xae, 0x5c, 0x68, 0xd0, 0xdc, 0xeb, 0x19, 0x67, 0x6b, 0x1e, 0xe6,
-	0x21, 0x9f, 0x2f, 0x30, 0x8b, 0xae, 0xb2, 0xaf, 0x2e, 0x35, 0xaf, 0x6e, 0x62, 0x5d, 0x5d, 0x68, 0x5c, 0x6d,
-	0xd9, 0x56, 0x37, 0x30, 0xad, 0x36, 0x97, 0xb9, 0xcf, 0x32, 0xb1, 0xce, 0x5c, 0x85, 0x63, 0x5d, 0x26, 0x6b,
-	0x64, 0x04, 0xe6, 0x97, 0xc2, 0x1c, 0x57, 0xe8, 0x06, 0xb2, 0x31, 0x0f, 0xb9, 0x88, 0x34, 0x1d, 0xa0, 0x6d,
-	0xdd, 0x2d, 0x59, 0xa4, 0x2f, 0x48, 0x19, 0xef, 0x09, 0x0f, 0x22, 0x0f, 0x9a, 0xf9, 0x2f, 0x62, 0x1b, 0xf2,
-	0x18, 0xf6, 0x2f, 0x1e, 0xed, 0x15, 0xf4, 0x90, 0x9b, 0xb3, 0x94, 0x94, 0x99, 0xad, 0x6d, 0x36, 0xae, 0xbe,
-	0xec, 0xef, 0xa4, 0x2d, 0x8b, 0x0f, 0xfd, 0xe6, 0xcb, 0x5a, 0xb7, 0x5e, 0x82, 0x9d, 0xfb, 0xe1, 0xd9, 0xb9,
-	0xc3, 0x5d, 0x97, 0x70, 0xd7, 0x25, 0xdc, 0x75, 0x09, 0x77, 0x5d, 0xc2, 0x5d, 0x97, 0x0f, 0xf8, 0x5d, 0x97,
-	0x45, 0xf7, 0x5c, 0xc2, 0x1d, 0x97, 0x4d, 0xee, 0xb8, 0x34, 0x72, 0xcf, 0xca, 0x85, 0xdf, 0x91, 0x69, 0x47,
-	0x45, 0x86, 0xf3, 0x99, 0xad, 0xbc, 0xfd, 0xb1, 0xd1, 0x45, 0x9c, 0xb5, 0x6f, 0xe0, 0xcc, 0xdf, 0xbe, 0x79,
-	0xc4, 0x9a, 0xd6, 0x5c, 0x06, 0x6d, 0xe9, 0x6a, 0x49, 0x63, 0x16, 0x8a, 0x5c, 0x33, 0x62, 0xd8, 0x8c, 0xde,
-	0x75, 0xa7, 0x34, 0x91, 0xea, 0x46, 0xd8, 0x87, 0x3b, 0x3f, 0x53, 0x1a, 0xdb, 0xd9, 0x25, 0x4a, 0xd4, 0xb8,
-	0x45, 0x25, 0xb2, 0x84, 0xb9, 0xa5, 0x89, 0xcc, 0x2e, 0xd1, 0x6e, 0x2e, 0x14, 0x95, 0x5b, 0x69, 0x07, 0xd1,
-	0x61, 0xec, 0x87, 0xcb, 0x4b, 0x06, 0x92, 0x2d, 0x7a, 0x17, 0x54, 0x93, 0x70, 0xe5, 0xe8, 0xd1, 0x5c, 0x39,
-	0x0a, 0xd7, 0x83, 0xc2, 0xf5, 0xa0, 0x70, 0x3d, 0x28, 0x5c, 0x0f, 0x0a, 0xd7, 0x83, 0xc2, 0xf5, 0xa0, 0xa0,
-	0x5d, 0x04, 0xed, 0xe2, 0xb1, 0xd7, 0x2e, 0xc2, 0x5d, 0x9f, 0x70, 0xd7, 0x27, 0xdc, 0xf5, 0x09, 0x77, 0x7d,
-	0xc2, 0x5d, 0x9f, 0xc7, 0xed, 0xae, 0xcf, 0xa5, 0xc6, 0x8a, 0xdc, 0x25, 0xb8, 0xed, 0x13, 0x6e, 0xfb, 0x84,
-	0xdb, 0x3e, 0x77, 0x73, 0xdb, 0xe7, 0x41, 0x28, 0xa0, 0xe1, 0xbe, 0x50, 0xb8, 0x2f, 0x14, 0xee, 0x0b, 0x85,
-	0xfb, 0x42, 0xe1, 0xbe, 0x50, 0xb8, 0x2f, 0x14, 0xee, 0x0b, 0x85, 0xfb, 0x42, 0xe1, 0xbe, 0xd0, 0xe3, 0x7c,
-	0x5f, 0x28, 0x5c, 0xf6, 0x09, 0x97, 0x7d, 0xc2, 0x4d, 0x9d, 0x0f, 0xec, 0x4d, 0x9d, 0x70, 0xcd, 0x26, 0x5c,
-	0xb3, 0x09, 0xd7, 0x6c, 0xc2, 0x35, 0x9b, 0x70, 0xcd, 0x26, 0x5c, 0xb3, 0x09, 0xd7, 0x6c, 0xc2, 0x35, 0x9b,
-	0x70, 0xcd, 0x66, 0x31, 0x58, 0x06, 0xae, 0xd8, 0x9c, 0x75, 0xc5, 0x26, 0x5c, 0xaf, 0x09, 0xd7, 0x6b, 0xc2,
-	0xf5, 0x9a, 0x70, 0xbd, 0xe6, 0xe9, 0xbb, 0x5e, 0x53, 0xbd, 0xb7, 0xbb, 0xbe, 0x5e, 0x33, 0x35, 0xd8, 0x84,
-	0x94, 0x4a, 0x03, 0x33, 0xb8, 0x2f, 0x13, 0xee, 0xcb, 0xac, 0xbe, 0x2f, 0x53, 0xc9, 0x8b, 0xe6, 0x95, 0x21,
-	0x30, 0x1d, 0x22, 0x9f, 0x3a, 0x91, 0x97, 0x63, 0x33, 0xa0, 0x33, 0x67, 0xf2, 0x6c, 0x86, 0x82, 0xdf, 0xba,
-	0x3e, 0x76, 0x03, 0xf9, 0x1d, 0x54, 0xc7, 0x63, 0xfb, 0x31, 0x42, 0xc7, 0x82, 0xdf, 0xba, 0x5b, 0x84, 0x71,
-	0x69, 0xe7, 0x28, 0x1d, 0x1f, 0x2a, 0x71, 0xed, 0x92, 0x98, 0xd4, 0x51, 0x97, 0xed, 0x63, 0x56, 0xc1, 0x44,
-	0x75, 0xa5, 0xa6, 0x50, 0x9f, 0x83, 0x47, 0xfa, 0xe8, 0x01, 0x1e, 0x73, 0xee, 0x8e, 0x82, 0xd6, 0x73, 0xe8,
-	0xd6, 0x33, 0xeb, 0x97, 0xaf, 0xdd, 0x5c, 0x49, 0x64, 0x61, 0x94, 0x2f, 0xb4, 0xbb, 0xa1, 0x3f, 0x51, 0x4a,
-	0xc9, 0x9d, 0x8e, 0x0d, 0x01, 0x6a, 0x9f, 0x48, 0x0a, 0x50, 0xca, 0x04, 0xf5, 0xb0, 0x51, 0x40, 0x99, 0xd7,
-	0x47, 0xbd, 0x7e, 0x8b, 0xe6, 0x05, 0x2b, 0x78, 0x87, 0xf6, 0x85, 0x00, 0x94, 0xee, 0x86, 0xf1, 0xd9, 0x67,
-	0x51, 0xbf, 0xb2, 0xf1, 0x40, 0xfe, 0x9b, 0xbf, 0xee, 0xcf, 0xdb, 0xec, 0xf3, 0xef, 0x0a, 0x5f, 0xf1, 0xb3,
-	0xdf, 0xfc, 0xf0, 0xc7, 0xfe, 0x1f, 0xef, 0x2b, 0x5f, 0xba, 0xfd, 0x3d, 0x1f, 0xfe, 0xab, 0x7f, 0xff, 0xb5,
-	0xe7, 0x5f, 0x76, 0xe6, 0xbd, 0x2b, 0xe7, 0x7f, 0xec, 0x71, 0x8d, 0x07, 0x7a, 0x1f, 0xe0, 0x81, 0x00, 0x0f,
-	0x04, 0x78, 0x20, 0xc0, 0x03, 0x01, 0x1e, 0x08, 0xf0, 0x40, 0x80, 0x07, 0x02, 0x3c, 0x10, 0xe0, 0x81, 0x00,
-	0x0f, 0x04, 0x78, 0x20, 0xc0, 0x03, 0x01, 0x1e, 0x08, 0xf0, 0x40, 0x80, 0x07, 0x02, 0x3c, 0x10, 0xe0, 0x81,
-	0x00, 0x0f, 0x04, 0x78, 0x20, 0xc0, 0x03, 0x01, 0x1e, 0x08, 0xf0, 0x40, 0x80, 0x07, 0x02, 0x3c, 0x10, 0xe0,
-	0x81, 0x00, 0x0f, 0x04, 0x78, 0x20, 0xc0, 0x03, 0x01, 0x1e, 0x08, 0xf0, 0x40, 0x80, 0x07, 0x02, 0x3c, 0x10,
-	0xe0, 0x81, 0x32, 0x78, 0xa0, 0xc6, 0x74, 0x20, 0x80, 0x03, 0x01, 0x1c, 0x08, 0xe0, 0x40, 0x00, 0x07, 0x02,
-	0x38, 0x10, 0xc0, 0x81, 0x00, 0x0e, 0x04, 0x70, 0x20, 0x80, 0x03, 0x01, 0x1c, 0x08, 0xe0, 0x40, 0x00, 0x07,
-	0x02, 0x38, 0xd0, 0x6c, 0x3a, 0xc8, 0xc1, 0x64, 0x11, 0xf8, 0x42, 0x0f, 0x1c, 0x5f, 0xe8, 0x60, 0xb2, 0x08,
-	0x88, 0xa2, 0x07, 0x01, 0x51, 0x74, 0x22, 0xfa, 0x0e, 0x50, 0x8e, 0x80, 0x72, 0x04, 0x94, 0x23, 0xa0, 0x1c,
-	0x01, 0xe5, 0x08, 0x28, 0x47, 0x40, 0x39, 0x02, 0xca, 0x11, 0x50, 0x8e, 0x80, 0x72, 0x04, 0x94, 0x23, 0xa0,
-	0x1c, 0x01, 0xe5, 0xe8, 0x81, 0xa4, 0x1c, 0x01, 0xd1, 0x07, 0x88, 0x3e, 0x40, 0xf4, 0x01, 0xa2, 0x0f, 0x10,
-	0x7d, 0x4e, 0x0c, 0xd1, 0xe7, 0xad, 0xbf, 0xfa, 0xec, 0x3f, 0x7f, 0xd7, 0xb7, 0xfe, 0xc2, 0xc3, 0x77, 0x6f,
-	0xf9, 0xad, 0xb3, 0xbf, 0xf9, 0x03, 0xef, 0xbf, 0xff, 0x2f, 0x2f, 0x7c, 0xec, 0xab, 0xc6, 0xdf, 0xf4, 0x49,
-	0xf2, 0x73, 0x2f, 0xfc, 0xc3, 0x7f, 0xd6, 0xdf, 0x61, 0x8a, 0xe8, 0xf3, 0xf8, 0x0f, 0x03, 0xd1, 0xe7, 0xd0,
-	0x89, 0x3e, 0xc0, 0xf0, 0x39, 0x41, 0x0c, 0x1f, 0x1b, 0x53, 0x93, 0x85, 0xf8, 0xec, 0x00, 0xf0, 0x06, 0x80,
-	0x37, 0x15, 0xc0, 0x9b, 0xdc, 0x2b, 0x51, 0xc8, 0x56, 0xda, 0x47, 0xe6, 0x4d, 0x0d, 0xce, 0x88, 0xbd, 0x8e,
-	0xac, 0x85, 0x7e, 0x2a, 0x85, 0x3f, 0xd9, 0x40, 0x1f, 0x33, 0x2b, 0xed, 0x05, 0x58, 0x72, 0x18, 0x39, 0x2b,
-	0xc4, 0xd9, 0xe4, 0xab, 0xb5, 0x5d, 0x0d, 0x0d, 0xb0, 0x79, 0x0a, 0x47, 0x8f, 0x7a, 0x38, 0xb9, 0xc4, 0x85,
-	0x3d, 0xf0, 0x15, 0x66, 0xe0, 0x08, 0x76, 0x45, 0x38, 0x00, 0xea, 0x00, 0x50, 0x07, 0x78, 0x88, 0xd4, 0x49,
-	0xb8, 0x6d, 0xb0, 0xaf, 0x21, 0xcf, 0x6a, 0x34, 0x93, 0xc2, 0x36, 0xad, 0x9f, 0xdb, 0x1e, 0x93, 0x40, 0x76,
-	0x2b, 0xc2, 0xe5, 0xb9, 0xa2, 0x4f, 0x19, 0xb1, 0x4f, 0x12, 0x6d, 0x71, 0x67, 0x93, 0x63, 0xf5, 0x9d, 0xda,
-	0x10, 0x04, 0x9f, 0xf8, 0xad, 0x76, 0x0d, 0x02, 0x42, 0x89, 0xbf, 0x83, 0xc4, 0x1f, 0xa8, 0x24, 0x9b, 0xb0,
-	0x0f, 0xe2, 0x10, 0x87, 0x0d, 0x3e, 0x88, 0x13, 0x3e, 0x62, 0xea, 0x41, 0x51, 0x95, 0x1d, 0x33, 0xe4, 0xc1,
-	0x0c, 0xce, 0x41, 0x79, 0x3f, 0x2b, 0x86, 0x1c, 0x14, 0xf9, 0xaf, 0x47, 0x38, 0x48, 0x28, 0x05, 0x8b, 0xfb,
-	0x9d, 0xfc, 0x52, 0x8d, 0xe4, 0xe3, 0x90, 0x4d, 0xe9, 0x0a, 0x46, 0xd9, 0x9f, 0x91, 0xf4, 0xc7, 0xbd, 0x68,
-	0x25, 0x9b, 0xb5, 0x4a, 0xcc, 0x42, 0x3a, 0x6f, 0x32, 0x26, 0x31, 0x36, 0xae, 0xec, 0x6b, 0x7d, 0x2c, 0xcf,
-	0x4c, 0x53, 0x38, 0xef, 0x6f, 0x9a, 0xe7, 0x67, 0xa6, 0x29, 0xbf, 0x23, 0xed, 0x6f, 0xa2, 0x17, 0x2a, 0x12,
-	0xb5, 0x20, 0x16, 0x8c, 0xc4, 0xb4, 0x94, 0x7b, 0x8c, 0x0c, 0xf3, 0x18, 0x0b, 0xe9, 0xa1, 0x10, 0x46, 0x61,
-	0x07, 0x2d, 0x82, 0x51, 0xa4, 0x5c, 0xe4, 0xb7, 0x82, 0x58, 0x6b, 0x61, 0xa5, 0xa7, 0x31, 0x13, 0x8c, 0x0c,
-	0x53, 0x3b, 0x88, 0x5d, 0xa1, 0x24, 0xca, 0xa1, 0x0f, 0x4b, 0xbb, 0x81, 0x3e, 0x2c, 0xef, 0x0e, 0xfa, 0x70,
-	0xbe, 0x14, 0xfa, 0x30, 0xb3, 0x65, 0xcb, 0x88, 0x0f, 0xc5, 0x4d, 0xac, 0xec, 0x8e, 0x80, 0xf7, 0x00, 0xbc,
-	0x07, 0xe0, 0x3d, 0x00, 0xef, 0xe1, 0xd4, 0xf1, 0x1e, 0x52, 0x78, 0x87, 0xcc, 0x7a, 0xfa, 0xb0, 0xd9, 0x0e,
-	0x62, 0xaa, 0x3a, 0x3e, 0x84, 0x86, 0xfd, 0x06, 0x32, 0x14, 0x55, 0xee, 0xe1, 0xd2, 0x18, 0x0c, 0xc4, 0x60,
-	0x06, 0xe3, 0xa0, 0xc8, 0xda, 0xf0, 0x98, 0x13, 0x0d, 0xf6, 0x17, 0x60, 0x10, 0x2f, 0x04, 0x8e, 0x3f, 0xbd,
-	0x00, 0x60, 0x05, 0x00, 0x2b, 0x38, 0x30, 0x58, 0x41, 0xd9, 0x7b, 0x70, 0xc8, 0xa4, 0x82, 0xa2, 0xf3, 0x8e,
-	0xd3, 0x46, 0x25, 0xd8, 0x33, 0x84, 0x60, 0xff, 0x14, 0xf2, 0xc7, 0x45, 0x10, 0x7f, 0x68, 0xfa, 0xf7, 0x63,
-	0xad, 0x7c, 0x3f, 0x0c, 0xa1, 0xfb, 0x3e, 0xa9, 0xc6, 0x4f, 0x92, 0x48, 0xfc, 0x44, 0x6b, 0xc2, 0x1f, 0x10,
-	0x09, 0xf8, 0xde, 0xc5, 0xdf, 0xa9, 0x13, 0x9d, 0x46, 0xca, 0xef, 0x59, 0xb2, 0xed, 0xb2, 0x98, 0x8f, 0x52,
-	0xb3, 0x7d, 0x62, 0x25, 0xda, 0x7b, 0x17, 0xd8, 0x16, 0x35, 0x47, 0x3d, 0x75, 0xed, 0x2c, 0x69, 0x6c, 0x59,
-	0xcc, 0x47, 0xa3, 0xa9, 0x3e, 0x79, 0x12, 0xea, 0x53, 0xdc, 0xb6, 0x7b, 0xd7, 0x3c, 0x1f, 0x7f, 0x89, 0xf3,
-	0xa9, 0x57, 0x34, 0x9f, 0x2c, 0x01, 0x73, 0x51, 0x8f, 0x35, 0x0b, 0xf4, 0x26, 0x5a, 0xe5, 0xa3, 0x92, 0x28,
-	0x1f, 0x9e, 0x22, 0xd9, 0x3e, 0x45, 0x98, 0xfd, 0x9e, 0x1f, 0x17, 0xf5, 0xe9, 0xcc, 0xb1, 0xee, 0xd0, 0x75,
-	0xc6, 0x87, 0x27, 0x2b, 0x3e, 0xed, 0x2d, 0x76, 0x18, 0x62, 0xe1, 0x19, 0x9a, 0xdd, 0x8c, 0xf0, 0xf6, 0xd6,
-	0x8d, 0xcb, 0x37, 0x56, 0xb2, 0x0a, 0x4c, 0x97, 0x06, 0x07, 0x22, 0xa5, 0x65, 0x64, 0x58, 0x62, 0x65, 0x95,
-	0x11, 0x7a, 0xe6, 0x2c, 0xab, 0x1a, 0x18, 0x43, 0x3d, 0xe8, 0xfa, 0x4f, 0x90, 0x7e, 0xd6, 0x91, 0x7e, 0x36,
-	0x57, 0x79, 0xa6, 0x23, 0x95, 0x9a, 0x4f, 0x7b, 0x1c, 0x38, 0x10, 0x11, 0xe8, 0x5e, 0xf4, 0x9e, 0xf5, 0xa4,
-	0x9e, 0xa0, 0xf2, 0x04, 0x95, 0xe7, 0xc1, 0xa9, 0x3c, 0x41, 0xc2, 0x09, 0x12, 0x4e, 0x90, 0x70, 0x1e, 0xa6,
-	0x84, 0x93, 0xfd, 0xf4, 0x6f, 0xe2, 0xaf, 0xff, 0xc4, 0xbf, 0xf9, 0xdf, 0x2f, 0x9e, 0xbc, 0x2c, 0xfa, 0x91,
-	0x3f, 0xfc, 0xe2, 0xd7, 0xff, 0xf9, 0x77, 0xdf, 0xfd, 0xfb, 0xee, 0x7b, 0xbd, 0x97, 0x05, 0x9f, 0xee, 0x3e,
-	0x75, 0xf9, 0x83, 0xaf, 0x7d, 0xb7, 0x92, 0x70, 0x9e, 0x7f, 0xe9, 0xc9, 0x91, 0x70, 0x82, 0xee, 0xb1, 0xb6,
-	0xee, 0x51, 0xab, 0x5d, 0xb7, 0x49, 0x1f, 0xf5, 0x95, 0xc4, 0x14, 0x5d, 0x5c, 0xbf, 0x26, 0xbb, 0xb6, 0x25,
-	0x53, 0x2d, 0x11, 0xbb, 0x8a, 0x71, 0xb1, 0xa6, 0xd0, 0xb5, 0xdd, 0x35, 0x2a, 0x15, 0x97, 0x8b, 0x9f, 0x9f,
-	0xde, 0x40, 0x6b, 0x28, 0x37, 0x66, 0x67, 0x7e, 0xe9, 0x86, 0x6e, 0xe8, 0x11, 0x6d, 0x17, 0x4e, 0x1d, 0xb2,
-	0xb0, 0x3a, 0x6f, 0xec, 0xc1, 0x65, 0x14, 0x33, 0xf5, 0x97, 0x55, 0xb2, 0xca, 0xa3, 0x17, 0x55, 0x1d, 0xbd,
-	0x6a, 0x2a, 0xae, 0x83, 0x28, 0x74, 0xbd, 0xbc, 0x74, 0x69, 0xb5, 0x4c, 0x36, 0x25, 0x9c, 0x33, 0x5a, 0xa9,
-	0x62, 0x2d, 0x54, 0xec, 0xd1, 0x12, 0x40, 0x25, 0x7d, 0x1a, 0x04, 0xac, 0x20, 0x60, 0x05, 0x01, 0xeb, 0xe9,
-	0x12, 0xb0, 0x82, 0x6a, 0x11, 0x54, 0x8b, 0xa0, 0x5a, 0x04, 0xd5, 0x22, 0xa8, 0x16, 0x41, 0xb5, 0x08, 0xaa,
-	0x45, 0x50, 0x2d, 0x82, 0x6a, 0x11, 0x54, 0x8b, 0xa0, 0x5a, 0x04, 0xd5, 0x22, 0xa8, 0x16, 0x41, 0xb5, 0x08,
-	0xaa, 0x45, 0x50, 0x2d, 0x82, 0x6a, 0x11, 0x54, 0x8b, 0xa7, 0x41, 0xb5, 0x88, 0x32, 0xaa, 0x3b, 0x04, 0x22,
-	0x3b, 0x10, 0xd9, 0x81, 0xc8, 0x0e, 0x44, 0x76, 0x20, 0xb2, 0x03, 0x91, 0x1d, 0x88, 0xec, 0x40, 0x64, 0x07,
-	0x22, 0x3b, 0x10, 0xd9, 0x81, 0xc8, 0x0e, 0x44, 0x76, 0x20, 0xb2, 0x03, 0x91, 0xdd, 0x5e, 0x44, 0x76, 0x0f,
-	0x96, 0x00, 0x28, 0x7f, 0xde, 0x03, 0xe2, 0xa0, 0xda, 0xe2, 0x20, 0x11, 0x28, 0x2f, 0x0d, 0xaa, 0x21, 0xf6,
-	0x49, 0xde, 0x6d, 0x69, 0x37, 0x26, 0xdf, 0xa0, 0x72, 0x0d, 0x51, 0x4e, 0xdf, 0x91, 0x0a, 0xde, 0x92, 0xc2,
-	0x1e, 0xeb, 0xa7, 0x87, 0x93, 0x3d, 0x29, 0xe8, 0x7a, 0x40, 0xd7, 0x03, 0xba, 0x9e, 0x07, 0x4f, 0xd7, 0x93,
-	0x36, 0xd8, 0x9e, 0x2d, 0xa8, 0x31, 0xae, 0x3c, 0x74, 0x08, 0x63, 0xea, 0x2b, 0x41, 0x73, 0x5d, 0x4d, 0x6c,
-	0x6c, 0x0b, 0xca, 0x1a, 0x50, 0xd6, 0xec, 0x49, 0x59, 0xa3, 0x86, 0x0c, 0xc2, 0x58, 0x4e, 0x3c, 0xf3, 0xee,
-	0xd7, 0x3d, 0xf2, 0xb1, 0x4f, 0x7e, 0xea, 0x23, 0x9f, 0x7d, 0xf7, 0x37, 0xbc, 0xe7, 0x1b, 0xef, 0xbc, 0xea,
-	0x75, 0x9f, 0xf8, 0x7b, 0xaf, 0xf8, 0x86, 0xdf, 0x78, 0xdf, 0x67, 0xbe, 0x70, 0xfe, 0xcd, 0x7f, 0xf4, 0xff,
-	0xae, 0x7c, 0xf3, 0x95, 0x95, 0x97, 0x29, 0xf1, 0xcc, 0x93, 0xbf, 0x05, 0xf7, 0x9f, 0xc1, 0xfd, 0x67, 0x70,
-	0xff, 0x59, 0xf9, 0xfd, 0x67, 0xfa, 0x03, 0x74, 0xd1, 0xf5, 0x67, 0x32, 0x06, 0x7b, 0x4d, 0x36, 0xe4, 0xed,
-	0x94, 0x61, 0xb6, 0x2d, 0x18, 0x51, 0x1f, 0xd5, 0x77, 0xd2, 0xb6, 0xe8, 0x94, 0xcb, 0x75, 0x5d, 0x62, 0xb5,
-	0xdc, 0xc4, 0x7a, 0xbe, 0xc4, 0x76, 0xde, 0x4c, 0x7d, 0x72, 0x4c, 0x90, 0x0b, 0x59, 0xb8, 0xa2, 0x0d, 0x14,
-	0x2e, 0x65, 0x0a, 0x97, 0x44, 0x67, 0x21, 0x3b, 0x63, 0xab, 0x5d, 0x6c, 0x03, 0xbb, 0x4f, 0xf2, 0x96, 0xc1,
-	0xd8, 0xa7, 0x8e, 0xd6, 0xb7, 0xe4, 0x84, 0x2d, 0xa8, 0x20, 0x2f, 0xc8, 0x84, 0xa3, 0xdb, 0x46, 0x17, 0x13,
-	0x89, 0x25, 0xe3, 0xc8, 0x75, 0x6a, 0x87, 0xf5, 0x28, 0x27, 0x59, 0xc5, 0x48, 0x85, 0xff, 0xa1, 0xca, 0xa4,
-	0x08, 0xd0, 0x24, 0x8b, 0x43, 0x95, 0x47, 0x11, 0xac, 0x69, 0x0e, 0x87, 0x3c, 0xc4, 0x61, 0x93, 0x1c, 0x16,
-	0x69, 0x60, 0x50, 0x46, 0xff, 0x62, 0xfc, 0x86, 0x2c, 0x0a, 0x06, 0x58, 0xf7, 0x31, 0xd3, 0xad, 0xea, 0xa5,
-	0xe3, 0xed, 0xa5, 0xda, 0x3d, 0x37, 0xd8, 0xd4, 0x41, 0xc5, 0x9f, 0xb5, 0x83, 0xc9, 0xba, 0x50, 0xe1, 0xea,
-	0x85, 0xf0, 0x37, 0xc5, 0x0a, 0x56, 0x85, 0x98, 0x10, 0xe6, 0xd7, 0x0d, 0x57, 0x43, 0x6a, 0x55, 0x11, 0x7a,
-	0x77, 0x0a, 0xa9, 0x19, 0x11, 0x26, 0x05, 0xa9, 0x1f, 0xc6, 0xaa, 0xe7, 0xba, 0x81, 0x02, 0xec, 0x93, 0xd6,
-	0x90, 0x51, 0xbf, 0x83, 0x42, 0x5a, 0x3b, 0x98, 0xdf, 0x34, 0x77, 0x8d, 0x5b, 0x92, 0x4f, 0xfd, 0x5d, 0xf6,
-	0x9a, 0xb8, 0x93, 0xeb, 0xa0, 0x8d, 0xba, 0x79, 0x14, 0x34, 0xae, 0xc3, 0x28, 0x74, 0x7d, 0xc2, 0x75, 0x10,
-	0x2c, 0x1e, 0x3a, 0xc8, 0x57, 0xff, 0xd4, 0x8a, 0xa0, 0xf0, 0xe2, 0x42, 0xb5, 0xe7, 0x49, 0x5f, 0x5a, 0xa8,
-	0x05, 0xc8, 0x89, 0xb2, 0x37, 0x72, 0x1d, 0xb1, 0x9f, 0x46, 0x7a, 0x4e, 0x39, 0xb7, 0x64, 0xb2, 0x34, 0x22,
-	0xe1, 0xa8, 0xc2, 0x8d, 0x54, 0x05, 0x24, 0x55, 0x21, 0x47, 0x8c, 0x46, 0x13, 0x2e, 0x9d, 0xf5, 0x6e, 0x43,
-	0x17, 0xc6, 0xf8, 0x99, 0x88, 0x9d, 0xce, 0xb9, 0x25, 0xf5, 0xb7, 0xfd, 0x10, 0xf9, 0x98, 0x6f, 0x56, 0x04,
-	0x1c, 0x6c, 0x3b, 0x55, 0xae, 0x63, 0xb5, 0x33, 0x2d, 0x71, 0xdf, 0x81, 0x6b, 0x29, 0xe1, 0x5a, 0x4a, 0xb8,
-	0x96, 0xf2, 0xc1, 0x11, 0x78, 0x56, 0x2b, 0x2a, 0x65, 0x44, 0xe9, 0x83, 0xe2, 0x32, 0x51, 0x65, 0xb9, 0xd7,
-	0x83, 0xd4, 0x55, 0xc6, 0xa9, 0x36, 0x91, 0x56, 0xda, 0x81, 0x0e, 0x5b, 0x5d, 0x69, 0xa7, 0x7d, 0xc4, 0x02,
-	0xcb, 0x92, 0xba, 0x3b, 0x66, 0x1a, 0x4b, 0x74, 0xe6, 0x0c, 0xda, 0xca, 0xbc, 0xb7, 0xc5, 0x72, 0xcb, 0xca,
-	0x1e, 0x58, 0xac, 0x7c, 0x2b, 0x09, 0x52, 0x4f, 0x74, 0x69, 0x07, 0x6e, 0x2a, 0x7c, 0x4c, 0x04, 0x9b, 0x79,
-	0x59, 0x5c, 0x79, 0xbc, 0x8b, 0x33, 0xe3, 0x95, 0x72, 0xa3, 0xc4, 0x62, 0xda, 0x75, 0xf2, 0x7a, 0x3c, 0xe1,
-	0x5c, 0x98, 0x6e, 0x2a, 0xdc, 0x3a, 0xa5, 0x5e, 0x77, 0x42, 0x27, 0xb1, 0x79, 0x79, 0x65, 0x50, 0x3b, 0x6c,
-	0x91, 0x92, 0x6f, 0x27, 0x2f, 0xe8, 0x13, 0xdd, 0x08, 0xad, 0xa1, 0xad, 0x94, 0xa0, 0x6f, 0x44, 0x6f, 0x92,
-	0xe1, 0x25, 0x1a, 0x05, 0xa1, 0x71, 0x5f, 0x4c, 0xb9, 0x8b, 0xf2, 0x68, 0xbd, 0x5f, 0x6c, 0x07, 0xbc, 0x33,
-	0x5f, 0x1a, 0xde, 0x18, 0x7e, 0xef, 0x4a, 0x0b, 0x48, 0xe5, 0x11, 0x54, 0xac, 0xcf, 0x53, 0x2d, 0x96, 0xdb,
-	0xe9, 0x17, 0x8b, 0x04, 0x77, 0xca, 0xc5, 0x82, 0xa5, 0x0a, 0xc3, 0xe5, 0xdd, 0x28, 0x0c, 0xcf, 0xef, 0x4e,
-	0x61, 0x78, 0xa1, 0x54, 0x61, 0x58, 0xe7, 0x25, 0x2a, 0x13, 0x19, 0x96, 0xbe, 0x4d, 0x69, 0x2b, 0x75, 0x90,
-	0x19, 0x82, 0xcc, 0x10, 0x64, 0x86, 0x20, 0x33, 0x3c, 0x95, 0x32, 0xc3, 0xfc, 0xda, 0xf2, 0xb0, 0x95, 0x86,
-	0xd6, 0x1c, 0x58, 0x1c, 0xa7, 0x53, 0xf6, 0xbb, 0x98, 0xf3, 0x4f, 0xaf, 0x46, 0xb1, 0xa4, 0x65, 0x0e, 0x57,
-	0xa6, 0xc8, 0x08, 0x27, 0xe1, 0x33, 0xd2, 0xee, 0x2b, 0x1e, 0xcd, 0xb2, 0x95, 0x52, 0xe0, 0xa7, 0xc8, 0xfe,
-	0x58, 0x17, 0xa7, 0x99, 0x75, 0xdb, 0x2c, 0xf9, 0xe4, 0xd2, 0x0c, 0xfd, 0xe4, 0xd2, 0x21, 0x0b, 0x28, 0x97,
-	0x1a, 0x2b, 0x28, 0x97, 0x0e, 0x5c, 0x42, 0x69, 0x2f, 0x73, 0x40, 0x45, 0x09, 0x77, 0x3f, 0x3e, 0xc0, 0x77,
-	0x3f, 0x56, 0xbc, 0x0a, 0x47, 0xa7, 0x4c, 0xad, 0x39, 0x05, 0x3f, 0x98, 0xe2, 0xd4, 0xa1, 0x1b, 0x60, 0xcf,
-	0x7d, 0x1b, 0xb9, 0x49, 0xb4, 0x21, 0x7c, 0x81, 0xa9, 0xbe, 0xe5, 0xa7, 0x7e, 0x4d, 0xeb, 0x0d, 0x5f, 0xf9,
-	0x6a, 0x27, 0xbb, 0x39, 0x3f, 0x77, 0xce, 0xbe, 0xf2, 0xa3, 0x64, 0xff, 0x9f, 0x26, 0x5a, 0x59, 0x3a, 0x9b,
-	0xc2, 0x13, 0xa9, 0x92, 0x33, 0x86, 0xf8, 0xfc, 0x34, 0x7d, 0x90, 0xa0, 0xdb, 0x6c, 0xab, 0x9d, 0x73, 0x53,
-	0x87, 0x1f, 0x11, 0x1f, 0x5b, 0x0d, 0x5a, 0x2a, 0x40, 0x01, 0xb9, 0x2f, 0xc8, 0x7d, 0x77, 0x2b, 0xf7, 0xbd,
-	0xac, 0xbb, 0x60, 0xa6, 0xc5, 0x4a, 0xf2, 0xa9, 0x7c, 0x57, 0x64, 0x55, 0xf5, 0xe8, 0x75, 0x26, 0x36, 0x93,
-	0xe1, 0xb4, 0x61, 0x63, 0x81, 0x20, 0x19, 0x04, 0xc9, 0x0f, 0x90, 0x20, 0x39, 0x7b, 0x58, 0xb9, 0xaf, 0x9a,
-	0xe4, 0x8a, 0xc8, 0x41, 0x96, 0x7c, 0x24, 0xb2, 0xe4, 0x92, 0x16, 0xd9, 0x1f, 0xf5, 0x6a, 0x45, 0xe4, 0x20,
-	0x4e, 0x86, 0x16, 0x06, 0x89, 0x32, 0x48, 0x94, 0x8f, 0x42, 0xa2, 0x5c, 0xd2, 0x69, 0x45, 0xd5, 0xb5, 0x9a,
-	0xca, 0x94, 0xd1, 0x6b, 0xd1, 0x12, 0x5a, 0x41, 0x8b, 0x0f, 0xc0, 0xa5, 0xa0, 0xe8, 0xf9, 0xe7, 0x51, 0x91,
-	0xe3, 0x25, 0x0f, 0xfb, 0x13, 0xe2, 0xe4, 0xae, 0xa1, 0xac, 0x35, 0x36, 0x1c, 0x97, 0x9b, 0x28, 0xeb, 0x8c,
-	0x92, 0xa7, 0xf8, 0xfa, 0x50, 0xd1, 0xb6, 0x45, 0x8e, 0x0f, 0x5e, 0xdb, 0x1e, 0x03, 0xce, 0xc0, 0xee, 0xbe,
-	0x5e, 0x14, 0x21, 0x0a, 0x9e, 0xde, 0x40, 0xfa, 0x07, 0x29, 0x80, 0xbb, 0x4a, 0xcd, 0xf7, 0x46, 0x6f, 0x2a,
-	0xcf, 0x38, 0xa5, 0x3e, 0x8d, 0x04, 0x03, 0xa9, 0xd0, 0x17, 0x53, 0x9c, 0x43, 0xee, 0xab, 0xef, 0x83, 0xb1,
-	0x0b, 0x72, 0x9d, 0x03, 0xb9, 0xd1, 0xd4, 0x3a, 0xd2, 0x4a, 0x7d, 0xc0, 0x2f, 0x30, 0x96, 0x69, 0x77, 0x87,
-	0xae, 0xe7, 0xb5, 0xae, 0x05, 0x43, 0x37, 0x70, 0xc3, 0xa9, 0x5a, 0x13, 0x29, 0x33, 0x28, 0x24, 0x55, 0x59,
-	0x49, 0x21, 0x74, 0x29, 0x45, 0xd9, 0xe4, 0xf7, 0x56, 0x53, 0x01, 0x95, 0x65, 0x8b, 0x8f, 0xbe, 0x2c, 0x83,
-	0x41, 0x59, 0x7d, 0x3e, 0x9e, 0x4c, 0xdc, 0x60, 0x84, 0x86, 0x8c, 0xfa, 0x76, 0x5d, 0xd2, 0x54, 0x14, 0x4a,
-	0x96, 0x79, 0x67, 0xb1, 0x83, 0x96, 0xee, 0xaa, 0x02, 0xdf, 0x51, 0x62, 0x94, 0x65, 0xf3, 0x28, 0x6b, 0x08,
-	0x9d, 0x37, 0x8f, 0xaa, 0x9a, 0xd0, 0x05, 0xf3, 0x6c, 0x04, 0x6a, 0x8f, 0xc4, 0xfe, 0xa5, 0x38, 0xf9, 0x51,
-	0xf5, 0x78, 0xd7, 0x6e, 0x5e, 0x75, 0x06, 0xa7, 0x84, 0xff, 0x08, 0x25, 0x9a, 0x26, 0x91, 0x0d, 0x55, 0x7a,
-	0xf9, 0xd9, 0x57, 0x9e, 0x5d, 0x67, 0xc8, 0x14, 0xc4, 0x29, 0xc3, 0x42, 0x88, 0x68, 0xb6, 0xc7, 0x44, 0x8a,
-	0xe5, 0xc2, 0xb1, 0x56, 0xa1, 0x26, 0x42, 0x70, 0xe5, 0xfb, 0x41, 0xbf, 0x1c, 0x36, 0x1e, 0x44, 0xe0, 0x86,
-	0xd8, 0x59, 0x24, 0x89, 0x9c, 0xaf, 0xc5, 0x5d, 0xe1, 0x25, 0x0e, 0x84, 0x1f, 0x31, 0x23, 0x6f, 0xcd, 0xa1,
-	0x12, 0x05, 0xc6, 0x5d, 0x65, 0x64, 0x89, 0x52, 0xaf, 0x80, 0x97, 0x00, 0xbc, 0x04, 0x5c, 0x1b, 0x0b, 0xd7,
-	0xc6, 0x02, 0xdc, 0xe2, 0xe4, 0x5d, 0x1b, 0xfb, 0x81, 0x5f, 0x7c, 0xf1, 0x67, 0xdf, 0xff, 0xd6, 0xb3, 0xbf,
-	0xf0, 0xb6, 0xbf, 0x7a, 0xf9, 0x3f, 0xfe, 0xeb, 0x17, 0xfd, 0xed, 0x6f, 0x7e, 0xc3, 0x0f, 0x7c, 0xf2, 0x7f,
-	0x5d, 0xf9, 0x8e, 0xc5, 0x27, 0x5e, 0xf4, 0xa7, 0xb7, 0x7f, 0xea, 0xca, 0x27, 0xbe, 0xe8, 0x2b, 0xf2, 0xc5,
-	0xeb, 0xce, 0x00, 0xf9, 0xe2, 0xd0, 0xc9, 0x17, 0xea, 0xe1, 0x36, 0xe9, 0x4f, 0xf0, 0x60, 0x13, 0x38, 0x18,
-	0xa7, 0x89, 0x83, 0xa1, 0x33, 0x22, 0x3c, 0x88, 0x3c, 0x68, 0x4d, 0x82, 0x88, 0x6d, 0xc8, 0x63, 0x31, 0x82,
-	0x78, 0xcc, 0x00, 0x33, 0xba, 0x16, 0xb4, 0x00, 0xb8, 0x19, 0xc0, 0xcd, 0x00, 0x6e, 0x06, 0x70, 0x33, 0x80,
-	0x9b, 0x01, 0xdc, 0x0c, 0xe0, 0x66, 0x00, 0x37, 0x03, 0xb8, 0x19, 0x87, 0xc8, 0xcd, 0xa8, 0x5e, 0xf8, 0x1d,
-	0x25, 0x49, 0xa3, 0x14, 0xf2, 0x91, 0x5b, 0x6a, 0xa7, 0xf3, 0xe0, 0x2a, 0x79, 0x1b, 0xde, 0xc2, 0xae, 0x87,
-	0xfb, 0x1e, 0xe9, 0xc4, 0xf8, 0x08, 0x53, 0x02, 0x07, 0xb5, 0x32, 0x39, 0x44, 0x34, 0xf0, 0xa6, 0xc5, 0xab,
-	0xee, 0x93, 0x84, 0xf0, 0x28, 0x6b, 0xcc, 0x5d, 0x41, 0x3d, 0xaa, 0x39, 0x21, 0x65, 0x8d, 0x60, 0xa7, 0x51,
-	0xa3, 0x25, 0x0a, 0xab, 0xdc, 0x06, 0x85, 0xcc, 0x2e, 0x51, 0x7d, 0xa4, 0x48, 0x35, 0xa5, 0xa4, 0xaa, 0x44,
-	0x26, 0x8d, 0x26, 0x25, 0x02, 0x7c, 0x09, 0xe0, 0x4b, 0x00, 0x5f, 0x02, 0xf8, 0x12, 0xc0, 0x97, 0x00, 0xbe,
-	0x04, 0xf0, 0x25, 0x80, 0x2f, 0x01, 0x7c, 0x09, 0xe0, 0x4b, 0x00, 0x5f, 0x02, 0xf8, 0x12, 0xc0, 0x97, 0x34,
-	0xc3, 0x97, 0xf0, 0x09, 0x7a, 0xe2, 0x89, 0x27, 0x92, 0xa9, 0x0a, 0x78, 0x26, 0x07, 0xc3, 0x33, 0x29, 0xa9,
-	0x67, 0x00, 0x9c, 0x94, 0x00, 0x4e, 0x72, 0xf5, 0x75, 0x8c, 0x88, 0x27, 0xd9, 0xac, 0x1d, 0x7f, 0x04, 0x4a,
-	0x49, 0xef, 0x7b, 0x30, 0x99, 0x28, 0x25, 0x95, 0x01, 0x90, 0x14, 0x80, 0xa4, 0x9c, 0x6a, 0x48, 0xca, 0xcc,
-	0x7e, 0x7f, 0x4a, 0xa9, 0x29, 0xf9, 0x72, 0x1f, 0x3d, 0x46, 0xa5, 0x6e, 0x5b, 0x00, 0x57, 0x65, 0xcf, 0x5c,
-	0x95, 0xdc, 0x6c, 0x7d, 0x5c, 0x40, 0x2b, 0x25, 0x7d, 0xe0, 0x10, 0xc8, 0x2b, 0x22, 0x5d, 0xb1, 0xdb, 0x3e,
-	0xc6, 0xfc, 0x95, 0x5c, 0xe5, 0x9c, 0x20, 0x20, 0x4b, 0x69, 0xde, 0x8f, 0x2f, 0xa1, 0xa5, 0xce, 0x4b, 0x72,
-	0x6c, 0x91, 0x2d, 0x4d, 0xfb, 0xca, 0xf1, 0x66, 0xb8, 0xe4, 0x77, 0x3e, 0x0f, 0x06, 0xd4, 0xa5, 0xc6, 0xa0,
-	0x04, 0x68, 0x97, 0x12, 0xb4, 0xcb, 0x7e, 0xf5, 0x99, 0xa3, 0x67, 0xbd, 0xec, 0xa6, 0x17, 0x00, 0xf1, 0xe5,
-	0xd8, 0xb4, 0xff, 0xee, 0x11, 0x30, 0x0f, 0x48, 0xc3, 0xef, 0x1d, 0x04, 0x53, 0x7d, 0xfa, 0x74, 0x2c, 0xc9,
-	0x30, 0x25, 0x2b, 0xdd, 0x53, 0x8c, 0x8a, 0xa9, 0x2c, 0xf1, 0xf1, 0x67, 0xc7, 0x94, 0x9e, 0xbe, 0x9e, 0x0a,
-	0x98, 0x4c, 0x49, 0xe3, 0x00, 0x5d, 0xe6, 0x34, 0xd2, 0x65, 0x66, 0x36, 0x36, 0xe0, 0x66, 0x4e, 0x07, 0x6e,
-	0x46, 0x35, 0x86, 0xf9, 0xd1, 0xae, 0x74, 0x5b, 0x7d, 0x6c, 0x86, 0xbd, 0x76, 0x85, 0x82, 0xbf, 0xcb, 0xa2,
-	0x60, 0xa5, 0x30, 0x14, 0x22, 0xf7, 0x27, 0x12, 0x32, 0x52, 0xac, 0xe4, 0x07, 0xce, 0x0d, 0x70, 0x6e, 0x80,
-	0x73, 0x03, 0x9c, 0x1b, 0xe0, 0xdc, 0x00, 0xe7, 0xa6, 0x1e, 0xe7, 0x46, 0xda, 0x07, 0xc9, 0x57, 0x65, 0x93,
-	0x30, 0x34, 0x8a, 0x30, 0xc3, 0x41, 0x48, 0x08, 0x37, 0xda, 0x7c, 0x07, 0x87, 0x18, 0xf1, 0x10, 0xb3, 0x90,
-	0xab, 0x71, 0x0b, 0x87, 0xc8, 0x23, 0x98, 0x87, 0xd2, 0x56, 0xe5, 0x19, 0x37, 0x10, 0x73, 0xca, 0x45, 0xc7,
-	0x61, 0x5d, 0x1d, 0xdb, 0xeb, 0x09, 0x99, 0x20, 0x37, 0x90, 0x96, 0x55, 0x68, 0xdb, 0x0d, 0xc7, 0x68, 0xe0,
-	0x3b, 0x3d, 0x11, 0x7f, 0x4f, 0x1a, 0xaf, 0x04, 0xd8, 0xeb, 0x79, 0x4e, 0x4f, 0xc4, 0xdb, 0x1d, 0xd1, 0x95,
-	0xa2, 0x58, 0x54, 0xdd, 0x65, 0x5c, 0xf4, 0x0b, 0x2b, 0x16, 0x49, 0x4b, 0x8f, 0x2f, 0xc7, 0x84, 0x1a, 0x5d,
-	0xae, 0x27, 0xd6, 0xb2, 0xfe, 0x4b, 0xa7, 0xd7, 0x90, 0x86, 0xd8, 0x33, 0xea, 0x60, 0x3a, 0xcc, 0x0c, 0x3e,
-	0xa5, 0x43, 0x0e, 0xb9, 0x3f, 0x20, 0xc4, 0xe1, 0xc8, 0x73, 0x7d, 0x37, 0x5c, 0xb0, 0x2d, 0xe3, 0x00, 0x15,
-	0x04, 0xa8, 0xa0, 0x72, 0x54, 0x90, 0xb6, 0x8f, 0xd6, 0x14, 0xa0, 0x0c, 0xca, 0xa1, 0x88, 0x1c, 0x04, 0x34,
-	0x21, 0xa0, 0x09, 0x01, 0x4d, 0xe8, 0x60, 0x69, 0x42, 0x1f, 0x7d, 0x78, 0xf0, 0xf1, 0xc5, 0x9f, 0x7e, 0xfd,
-	0xf6, 0x5b, 0xbf, 0xf4, 0x43, 0x9f, 0xfd, 0x1b, 0xbf, 0xf3, 0xf8, 0xaf, 0xbd, 0xa4, 0xff, 0xce, 0x97, 0x9e,
-	0xff, 0xba, 0xb7, 0xf7, 0x6e, 0xff, 0xf9, 0x74, 0xf9, 0xab, 0xff, 0x6e, 0xf4, 0x25, 0x45, 0x13, 0xea, 0x9c,
-	0x3b, 0x71, 0x34, 0xa1, 0x6d, 0xd2, 0x47, 0x7d, 0x85, 0xf0, 0x41, 0x17, 0xd7, 0xaf, 0xc9, 0xf6, 0xb2, 0x30,
-	0x40, 0x25, 0x30, 0x21, 0xf1, 0xb2, 0xd7, 0x04, 0x09, 0xb5, 0xbb, 0x46, 0xa9, 0xe8, 0x72, 0xf1, 0xf3, 0xd3,
-	0x1b, 0x62, 0x6c, 0x56, 0x63, 0x9c, 0x19, 0x88, 0x32, 0x83, 0xdc, 0xea, 0xbc, 0x91, 0x1c, 0x49, 0xff, 0x33,
-	0xf1, 0x35, 0x29, 0xf0, 0x4a, 0x06, 0x4b, 0x73, 0xf4, 0xd2, 0xe5, 0xa3, 0x97, 0x08, 0xc7, 0x75, 0x10, 0x85,
-	0xae, 0x97, 0xd7, 0xc5, 0xae, 0x96, 0x29, 0x82, 0x85, 0x73, 0x46, 0x06, 0x5c, 0x2c, 0xb4, 0x8d, 0x3d, 0x5a,
-	0xea, 0xda, 0x04, 0x85, 0x54, 0xc6, 0x5b, 0x3a, 0x06, 0x1c, 0x1d, 0xf1, 0xf7, 0xf5, 0x1b, 0xd7, 0x9f, 0x7c,
-	0xc3, 0x8d, 0x4b, 0xaf, 0x37, 0xcf, 0x1b, 0xdf, 0xa5, 0x42, 0x01, 0x63, 0x27, 0x21, 0x7d, 0xa8, 0xc9, 0x29,
-	0x4b, 0xfb, 0xc8, 0xac, 0xa3, 0x6a, 0x61, 0x97, 0x4a, 0xc1, 0x4b, 0x66, 0xb9, 0xa9, 0xc8, 0x4b, 0x19, 0x7d,
-	0x3b, 0xa8, 0xa2, 0x73, 0xaa, 0xe8, 0x41, 0xda, 0x94, 0x77, 0x4f, 0xaa, 0x64, 0x75, 0xe0, 0x67, 0x9b, 0xf3,
-	0xea, 0x5d, 0x9e, 0x3a, 0x6b, 0x90, 0xc2, 0x17, 0xad, 0xaf, 0xd9, 0x1e, 0x93, 0x40, 0x0e, 0x20, 0x84, 0xcb,
-	0xe3, 0x13, 0x9f, 0x32, 0x62, 0x1f, 0x98, 0xd8, 0xe2, 0xaf, 0x26, 0xa7, 0x87, 0x3b, 0xb5, 0x45, 0xd2, 0x3e,
-	0xf1, 0x5b, 0xed, 0x1a, 0x0a, 0xe9, 0x12, 0x7f, 0x07, 0x29, 0x8f, 0x56, 0x49, 0x36, 0xd1, 0x46, 0xc7, 0x21,
-	0x0e, 0x5b, 0x18, 0x1d, 0x27, 0x5c, 0xa9, 0x8a, 0x6e, 0xae, 0x72, 0x2e, 0xaa, 0x82, 0x03, 0x91, 0x38, 0x97,
-	0x2a, 0x98, 0x67, 0xc8, 0x96, 0xcb, 0xbb, 0x45, 0x22, 0x92, 0x36, 0x19, 0x96, 0xeb, 0x66, 0x7c, 0xbd, 0x22,
-	0x64, 0x3d, 0xe9, 0x72, 0x1c, 0xb2, 0xa9, 0x6e, 0x79, 0x37, 0x99, 0x4d, 0x89, 0x6d, 0x33, 0x2a, 0xdb, 0x78,
-	0x8d, 0xb5, 0x52, 0x59, 0xa6, 0xa5, 0xaa, 0x32, 0xc9, 0x88, 0x82, 0xc8, 0xf3, 0xaa, 0xe3, 0x58, 0x9e, 0x19,
-	0x87, 0x70, 0xae, 0x8e, 0xe3, 0xfc, 0xcc, 0x38, 0xe4, 0x01, 0x72, 0x75, 0x24, 0x17, 0x2a, 0x22, 0xd9, 0x29,
-	0x53, 0xc3, 0x6b, 0xdd, 0x70, 0x5c, 0x7b, 0x62, 0xa1, 0xc2, 0x48, 0x4c, 0x14, 0xb8, 0xa7, 0xdc, 0x6f, 0x92,
-	0x61, 0x5a, 0xf0, 0x2d, 0x63, 0x92, 0xfe, 0x8a, 0x75, 0xdb, 0x76, 0x14, 0x45, 0xc2, 0xed, 0xd4, 0x47, 0x14,
-	0x75, 0x3e, 0xb8, 0x95, 0xb5, 0x4a, 0x4f, 0x52, 0xd6, 0xd2, 0x6c, 0x46, 0x86, 0xed, 0x92, 0xfe, 0x96, 0xaa,
-	0x08, 0xf5, 0x99, 0x7d, 0xb6, 0x50, 0x3e, 0x53, 0x13, 0x4a, 0x0c, 0x5d, 0x5e, 0x13, 0xd2, 0xfd, 0x48, 0x6a,
-	0x22, 0x49, 0xf9, 0x00, 0x6a, 0x22, 0x29, 0xe7, 0xd6, 0x1d, 0x73, 0x2e, 0x35, 0x5c, 0x67, 0x74, 0x72, 0x37,
-	0x19, 0x1e, 0xcb, 0xcb, 0x37, 0xab, 0x78, 0x15, 0xa5, 0xcb, 0x26, 0x27, 0x17, 0xec, 0x43, 0x7b, 0xb5, 0x56,
-	0xab, 0x50, 0x20, 0xfb, 0x06, 0xd9, 0xf7, 0xe9, 0x93, 0x7d, 0xef, 0x83, 0x7e, 0xb8, 0x5c, 0xfa, 0x9b, 0x68,
-	0x6e, 0x33, 0xcb, 0xb2, 0x94, 0x98, 0xe5, 0x28, 0xb4, 0xb8, 0xfb, 0x2d, 0xbd, 0x2d, 0x2a, 0xdf, 0xe1, 0xea,
-	0x6e, 0x8d, 0x5c, 0x75, 0x86, 0x9a, 0xf5, 0x90, 0xaf, 0xef, 0x6f, 0x2c, 0x5d, 0x3d, 0xf0, 0xcb, 0xfb, 0xe3,
-	0xb1, 0x7e, 0x5f, 0x55, 0xaa, 0x66, 0x47, 0x58, 0x5f, 0xa1, 0x6a, 0x42, 0x1c, 0xe6, 0x8d, 0xfd, 0xd9, 0x2d,
-	0x6e, 0x0d, 0x2d, 0x2a, 0x4a, 0x7d, 0x42, 0x32, 0x29, 0x24, 0x9f, 0x91, 0xd0, 0xe1, 0x4a, 0x29, 0xcb, 0x5a,
-	0xaf, 0x9e, 0x8e, 0x72, 0xc3, 0x08, 0x10, 0xe3, 0xe6, 0xaa, 0x14, 0x53, 0xe6, 0xbc, 0x57, 0xb7, 0x55, 0xd5,
-	0x18, 0x57, 0xa1, 0x9e, 0xcc, 0x35, 0x4a, 0xb1, 0x78, 0x32, 0xe7, 0xed, 0xc4, 0xdc, 0x38, 0x7f, 0xfa, 0xee,
-	0x47, 0x2f, 0x52, 0xc6, 0xed, 0x42, 0xaa, 0x77, 0x7a, 0xae, 0x31, 0x87, 0x4b, 0xc2, 0xe1, 0x92, 0xf0, 0x7d,
-	0xd0, 0x93, 0x15, 0x69, 0xaf, 0x4a, 0x45, 0x65, 0x49, 0xb0, 0xd4, 0xf6, 0x6d, 0x5f, 0xb5, 0x5e, 0x65, 0x31,
-	0x9f, 0xea, 0x3b, 0xbc, 0x8b, 0x24, 0x30, 0x33, 0xc5, 0x5d, 0xd5, 0x75, 0xb6, 0x3f, 0xd2, 0x9b, 0xb2, 0x98,
-	0x4f, 0xe9, 0x15, 0xdb, 0x55, 0xed, 0x90, 0x17, 0x59, 0x9d, 0x80, 0x06, 0x80, 0x1b, 0xb0, 0xe1, 0x06, 0xec,
-	0x43, 0x56, 0x31, 0x15, 0xf5, 0x58, 0xb3, 0x6c, 0x6c, 0x22, 0x58, 0x3a, 0x86, 0x97, 0xe7, 0x66, 0x94, 0x01,
-	0xb7, 0x6e, 0x5c, 0xbe, 0xb1, 0x92, 0x35, 0x11, 0x77, 0x69, 0x90, 0xb6, 0xf5, 0x4f, 0x86, 0xed, 0xdd, 0xd8,
-	0xfc, 0xe7, 0x0f, 0x27, 0x94, 0x5b, 0x2b, 0xb1, 0x8a, 0x2a, 0xde, 0x28, 0xce, 0xa7, 0x71, 0x24, 0x19, 0x9b,
-	0xa7, 0xbc, 0x5d, 0x64, 0x1f, 0x3b, 0xf1, 0x6d, 0x05, 0x2b, 0xa8, 0x8e, 0x95, 0x64, 0x3c, 0xf4, 0x58, 0x5b,
-	0xd9, 0xaa, 0xad, 0xab, 0x1c, 0xb4, 0x94, 0x24, 0x60, 0x93, 0x20, 0x1e, 0x31, 0x82, 0xdc, 0x10, 0xb9, 0x1c,
-	0x61, 0x0b, 0x48, 0x38, 0x9f, 0xb4, 0x9a, 0xad, 0x74, 0x48, 0xbe, 0x18, 0x94, 0x7c, 0x23, 0x4e, 0x0e, 0xd2,
-	0x4b, 0x3c, 0xe8, 0xa3, 0x68, 0xb4, 0x86, 0x36, 0xa4, 0x57, 0xdb, 0x31, 0x63, 0xcb, 0x9f, 0xfb, 0xaa, 0xdc,
-	0xe0, 0x43, 0xf0, 0x09, 0x32, 0xf1, 0x17, 0x81, 0xf2, 0x06, 0xfe, 0x99, 0x03, 0xb2, 0x22, 0x6b, 0xfd, 0x64,
-	0x04, 0x94, 0x46, 0x18, 0x72, 0x9c, 0x29, 0x57, 0x02, 0xe4, 0x6c, 0xb1, 0x53, 0xc1, 0x5b, 0xd2, 0x32, 0xdf,
-	0xfa, 0xe9, 0xe1, 0x64, 0x3f, 0x79, 0x2c, 0x0d, 0xf3, 0x9b, 0xdb, 0xe0, 0xa7, 0x23, 0x95, 0x16, 0xf9, 0xf6,
-	0xfc, 0x71, 0x20, 0x26, 0xfa, 0x7b, 0xb1, 0xc6, 0xaf, 0x67, 0x88, 0xbf, 0x57, 0x1b, 0x7c, 0x03, 0x54, 0x13,
-	0x3e, 0x53, 0x5f, 0x06, 0x06, 0xe9, 0x51, 0x63, 0x36, 0x2e, 0x7f, 0xae, 0x6a, 0xd0, 0xc9, 0x30, 0xf3, 0x8d,
-	0xed, 0x65, 0x5d, 0xfb, 0xf5, 0xd2, 0x01, 0x34, 0x47, 0x41, 0x56, 0xe6, 0xea, 0x99, 0xcc, 0x5b, 0x26, 0xdf,
-	0xb1, 0x79, 0x74, 0xda, 0x24, 0x70, 0xb6, 0xd1, 0xb1, 0x71, 0xe5, 0xa1, 0x43, 0x18, 0x53, 0xc7, 0xd9, 0xcd,
-	0x6d, 0x8f, 0x63, 0xbb, 0xac, 0x03, 0xb4, 0x3e, 0xb6, 0x6c, 0x89, 0x8f, 0xa1, 0x29, 0x71, 0xb5, 0x19, 0xb1,
-	0x69, 0xb8, 0x22, 0x0b, 0xe2, 0x02, 0x9b, 0xe1, 0x8c, 0x25, 0xb8, 0xfc, 0x67, 0x05, 0x61, 0xcf, 0x4b, 0x00,
-	0xb7, 0x62, 0x5e, 0xf3, 0x08, 0x99, 0xc8, 0xe1, 0x3e, 0xa0, 0xf6, 0x55, 0x97, 0x71, 0x62, 0x3a, 0x25, 0x74,
-	0x2e, 0xb6, 0x40, 0x7e, 0x28, 0x9e, 0x5c, 0x0b, 0x1a, 0xea, 0x60, 0xed, 0x87, 0xe3, 0x52, 0xda, 0x9f, 0x94,
-	0x66, 0x19, 0x15, 0x7f, 0xfc, 0x55, 0x7f, 0x33, 0xfa, 0xcc, 0xa5, 0xf7, 0xff, 0xe4, 0x5b, 0xff, 0xc7, 0xaf,
-	0x7f, 0xa0, 0xff, 0x43, 0x6f, 0xef, 0x3f, 0xff, 0xca, 0x97, 0x7e, 0xe6, 0xe3, 0x9f, 0xba, 0x3b, 0xfa, 0xbd,
-	0xaf, 0x7c, 0xf1, 0x23, 0xe3, 0x0f, 0xbf, 0xef, 0xbf, 0x68, 0xa3, 0xe2, 0xef, 0x05, 0xa3, 0x62, 0x30, 0x2a,
-	0x06, 0xa3, 0xe2, 0xb4, 0x51, 0x71, 0xcd, 0xbb, 0x5e, 0xcb, 0x2f, 0x7b, 0x6d, 0x74, 0xdb, 0x6b, 0xf1, 0x75,
-	0xaf, 0x76, 0x7e, 0x9a, 0x5c, 0xf8, 0x3a, 0xeb, 0xa2, 0x57, 0x35, 0xa9, 0x80, 0x6d, 0x34, 0xd8, 0x46, 0x83,
-	0x6d, 0x34, 0xd8, 0x46, 0x83, 0x6d, 0x34, 0xd8, 0x46, 0x1f, 0xda, 0xf5, 0x4f, 0xbb, 0x36, 0x9e, 0x2e, 0xbe,
-	0xf0, 0xe9, 0x08, 0x4c, 0xa6, 0xf7, 0x62, 0xf9, 0xdc, 0xbc, 0x28, 0x7b, 0xb7, 0x94, 0x6e, 0x9e, 0xe6, 0xde,
-	0x2d, 0xab, 0x9b, 0xa7, 0xb9, 0x0f, 0x96, 0xd8, 0xcd, 0x13, 0xad, 0x61, 0xb9, 0x9d, 0xb5, 0x46, 0x66, 0x59,
-	0x3b, 0xe4, 0x03, 0x33, 0xd3, 0xb5, 0xd2, 0xcb, 0x59, 0x1f, 0xef, 0xec, 0xfe, 0x1a, 0xac, 0xf2, 0x0b, 0xab,
-	0x96, 0x76, 0x73, 0x61, 0xd5, 0xf2, 0xee, 0x2e, 0xac, 0x3a, 0x5f, 0x7a, 0x61, 0xd5, 0xcc, 0x96, 0x2d, 0xbb,
-	0xad, 0x0a, 0x8c, 0x96, 0xc1, 0x68, 0x19, 0x8c, 0x96, 0x77, 0x6b, 0xb4, 0x9c, 0xba, 0xe3, 0xa8, 0x8e, 0xe5,
-	0xde, 0x01, 0x5e, 0x70, 0x24, 0xc6, 0xbc, 0xe3, 0x73, 0x4d, 0x11, 0x98, 0x46, 0x83, 0x69, 0x34, 0x98, 0x46,
-	0x83, 0x69, 0x34, 0x98, 0x46, 0x83, 0x69, 0x34, 0x98, 0x46, 0x83, 0x69, 0x34, 0x98, 0x46, 0x83, 0x69, 0x34,
-	0x98, 0x46, 0x83, 0x69, 0x34, 0x98, 0x46, 0x83, 0x69, 0x34, 0x98, 0x46, 0x83, 0x69, 0x34, 0x98, 0x46, 0x37,
-	0x31, 0x8d, 0x2e, 0x44, 0xa5, 0xb3, 0x72, 0xcb, 0x60, 0xb3, 0x97, 0xd9, 0x18, 0x47, 0xa1, 0x43, 0xb7, 0x83,
-	0xc4, 0x0e, 0x18, 0xec, 0x83, 0xc1, 0x3e, 0x18, 0xec, 0x83, 0x4f, 0xbb, 0x7d, 0x70, 0xfa, 0x0a, 0xe8, 0x52,
-	0xc5, 0xc4, 0xfe, 0x48, 0x26, 0xe2, 0xab, 0x43, 0xac, 0x43, 0x2e, 0x9d, 0xb3, 0xfa, 0xfa, 0x08, 0x6d, 0x28,
-	0x02, 0xc6, 0xcc, 0x0f, 0xac, 0x31, 0xf3, 0x6c, 0x2e, 0x72, 0x95, 0x41, 0xf3, 0x41, 0x22, 0x91, 0x0b, 0x66,
-	0x53, 0xeb, 0xcb, 0x8d, 0xf0, 0x91, 0xeb, 0xa7, 0xfb, 0x69, 0xdb, 0x9c, 0x32, 0x32, 0x4b, 0x59, 0x2a, 0x7f,
-	0x72, 0x7e, 0xfa, 0xf2, 0x0f, 0x8f, 0xff, 0xe4, 0xa3, 0xbf, 0xf2, 0xc5, 0x57, 0xbc, 0xfa, 0xc7, 0xbf, 0x6e,
-	0xf5, 0x5b, 0x3e, 0xf1, 0xb5, 0x7f, 0xe7, 0x25, 0x1f, 0xfb, 0xde, 0x37, 0xff, 0x57, 0xfc, 0xdb, 0xbf, 0xf4,
-	0xbb, 0xce, 0xdb, 0xdf, 0xf9, 0x26, 0x65, 0xa9, 0x7c, 0x69, 0x74, 0xe2, 0x2c, 0x95, 0xfd, 0xc8, 0x0b, 0xdd,
-	0x89, 0x47, 0x6c, 0x0b, 0x73, 0x6b, 0xf9, 0xc0, 0x4b, 0x4c, 0x95, 0x3b, 0x32, 0x82, 0xda, 0xe6, 0xca, 0x5d,
-	0xe1, 0x5d, 0x06, 0xb9, 0x62, 0xc7, 0x2d, 0xde, 0x34, 0xd7, 0x21, 0x4c, 0x1a, 0xa5, 0xf4, 0x7a, 0xe8, 0x1c,
-	0x7a, 0x52, 0x19, 0x4d, 0x73, 0xfd, 0xa8, 0xa3, 0xd0, 0x4f, 0x57, 0x62, 0xe6, 0xba, 0x7c, 0x5c, 0xc7, 0x6c,
-	0x40, 0x3c, 0x3d, 0x96, 0x68, 0x6b, 0x02, 0x6d, 0xa1, 0x5a, 0x68, 0xc3, 0xda, 0xeb, 0x19, 0x67, 0x6b, 0x78,
-	0xe5, 0x21, 0x9f, 0x2f, 0xb0, 0x84, 0xad, 0x32, 0xa9, 0x2d, 0xb5, 0xa8, 0x6d, 0x62, 0x50, 0x5b, 0x68, 0x4f,
-	0x6b, 0x99, 0xd3, 0x36, 0xb0, 0xa6, 0x35, 0xb7, 0xd7, 0xcc, 0xb2, 0xaa, 0x35, 0xc3, 0xee, 0x43, 0x39, 0x7a,
-	0xbe, 0xb1, 0x1c, 0x37, 0xbf, 0x14, 0xe6, 0xb8, 0xc2, 0x54, 0x3c, 0x1b, 0xf3, 0x90, 0x8b, 0x48, 0xd3, 0x01,
-	0x52, 0x91, 0x14, 0xd9, 0x94, 0xa7, 0x6c, 0x39, 0x29, 0x97, 0xcb, 0x9f, 0x64, 0x76, 0x6d, 0x62, 0x46, 0x5a,
-	0x62, 0x44, 0x6a, 0xae, 0x20, 0x90, 0xaf, 0xb7, 0x5c, 0xef, 0xa5, 0xf3, 0xac, 0x72, 0x78, 0xd4, 0x56, 0xc7,
-	0x60, 0x59, 0xac, 0xf6, 0x96, 0xdb, 0xfa, 0x6b, 0xb9, 0x2c, 0x72, 0xb2, 0x3d, 0x50, 0xfe, 0x3a, 0x68, 0x42,
-	0xb9, 0x2b, 0xf6, 0x84, 0x9d, 0x78, 0xae, 0x6a, 0x5b, 0x96, 0xb4, 0x7a, 0x5d, 0x28, 0x6d, 0x69, 0xd1, 0xf3,
-	0xcf, 0x9b, 0xbb, 0x4b, 0xc4, 0x73, 0x92, 0x98, 0x70, 0x30, 0xd1, 0x48, 0x27, 0xb1, 0x85, 0xb4, 0xac, 0x91,
-	0xf4, 0x77, 0x35, 0xd5, 0x19, 0x5b, 0xed, 0x62, 0x93, 0xbb, 0xf4, 0xb7, 0x73, 0xb9, 0x3e, 0xca, 0x37, 0x98,
-	0x6e, 0x09, 0x13, 0xa3, 0xba, 0x9c, 0x2a, 0xb0, 0x04, 0x0d, 0x73, 0x83, 0xb1, 0x4f, 0x1d, 0x6d, 0x44, 0xed,
-	0x53, 0x87, 0xa4, 0x8a, 0x85, 0x0a, 0xf2, 0x82, 0x4c, 0x38, 0xba, 0x1d, 0xe8, 0x70, 0x91, 0xeb, 0x74, 0xd0,
-	0x48, 0xfc, 0xaf, 0x66, 0x58, 0x8f, 0x72, 0x55, 0xc3, 0xf5, 0xfc, 0x0f, 0x55, 0x26, 0x45, 0x80, 0x26, 0x59,
-	0x1c, 0xaa, 0x3c, 0x8a, 0x60, 0x4d, 0x73, 0x38, 0xe4, 0x21, 0x0e, 0x9b, 0xe4, 0x90, 0x9b, 0x5a, 0x2f, 0xf4,
-	0x2f, 0xdb, 0x37, 0xf6, 0x1b, 0xb2, 0x28, 0x18, 0x60, 0xdd, 0xc7, 0x4c, 0xb7, 0xaa, 0x97, 0x8e, 0xb7, 0x97,
-	0x6a, 0xf7, 0xdc, 0x60, 0x53, 0x07, 0x15, 0x7f, 0xd6, 0x0e, 0x26, 0xeb, 0x42, 0x85, 0xab, 0x17, 0xc2, 0xdf,
-	0x74, 0x5c, 0xa6, 0x43, 0x4c, 0x08, 0xf3, 0xeb, 0x86, 0xa3, 0x13, 0x12, 0xe4, 0xed, 0xf9, 0xeb, 0x86, 0x16,
-	0x33, 0xab, 0xe9, 0xf8, 0xa9, 0x8d, 0x7d, 0xe5, 0x9b, 0x3b, 0x23, 0xc2, 0xa4, 0x20, 0xf5, 0xc3, 0x58, 0xf5,
-	0x5c, 0x37, 0x50, 0x80, 0x7d, 0xd2, 0x1a, 0x32, 0xea, 0x77, 0xe4, 0x6d, 0x7c, 0x35, 0x83, 0xf9, 0x4d, 0x73,
-	0xd7, 0xb8, 0x25, 0xf9, 0xd4, 0xdf, 0x65, 0xaf, 0x89, 0x3b, 0xb9, 0x0e, 0xda, 0xa8, 0x9b, 0x47, 0x41, 0xe3,
-	0x3a, 0x8c, 0x42, 0xd7, 0x27, 0x5c, 0x07, 0xc1, 0xe2, 0xa1, 0x83, 0x7c, 0xf5, 0x4f, 0xad, 0x08, 0x76, 0x0a,
-	0x56, 0x13, 0x7a, 0x0b, 0x93, 0x5a, 0x40, 0x18, 0x8d, 0x5b, 0xa2, 0x27, 0x8b, 0x5c, 0xa7, 0x25, 0xa2, 0xd6,
-	0x73, 0xca, 0xb9, 0x25, 0x93, 0xa5, 0x11, 0x09, 0x47, 0x15, 0x6e, 0xa4, 0x2a, 0x20, 0xa9, 0x0a, 0x39, 0x62,
-	0x34, 0x9a, 0x70, 0xe9, 0xac, 0x37, 0x0e, 0xba, 0x30, 0xc6, 0xcf, 0xc4, 0x75, 0xe4, 0xec, 0x2e, 0xff, 0xb6,
-	0x1f, 0x22, 0x1f, 0xf3, 0xcd, 0x8a, 0x80, 0x83, 0x6d, 0xa7, 0xca, 0x75, 0xac, 0x2e, 0xe0, 0x29, 0x71, 0xdf,
-	0xc9, 0xd7, 0xa0, 0xb2, 0x7b, 0x68, 0x5b, 0x0b, 0xa9, 0x23, 0xd3, 0xed, 0xe5, 0x5b, 0x37, 0xb1, 0xc9, 0x4a,
-	0xb7, 0xf0, 0x11, 0xc9, 0xfa, 0x72, 0x19, 0xb4, 0xa4, 0x44, 0xa9, 0x0c, 0xa6, 0x55, 0x7d, 0x19, 0xf5, 0x5f,
-	0x46, 0xe0, 0x57, 0x14, 0xa7, 0xd6, 0x1c, 0xe5, 0xe2, 0x4c, 0x04, 0x80, 0x45, 0x71, 0x5a, 0x5a, 0xc0, 0x79,
-	0xbd, 0xa1, 0x72, 0xc4, 0xbe, 0x71, 0x42, 0xbd, 0xe9, 0xd0, 0xf5, 0x3c, 0x8e, 0x86, 0x94, 0x59, 0x1b, 0xb5,
-	0x2e, 0x08, 0xa4, 0x8a, 0x04, 0x52, 0xd6, 0x9d, 0x70, 0xb3, 0xcf, 0x00, 0xcb, 0x6e, 0x65, 0xcb, 0xe0, 0x01,
-	0x76, 0xec, 0xef, 0x02, 0x99, 0xcb, 0x9d, 0xc4, 0xd2, 0xc3, 0x06, 0x8e, 0x18, 0x73, 0xac, 0xfd, 0x10, 0x68,
-	0x55, 0x2b, 0xa2, 0x0a, 0xee, 0x3c, 0x2c, 0x13, 0x45, 0x95, 0x7b, 0x3d, 0x48, 0x5d, 0x54, 0x9c, 0x6a, 0x13,
-	0x69, 0x94, 0x1d, 0xe8, 0xb0, 0xd5, 0x51, 0x76, 0xda, 0x95, 0x02, 0x29, 0x25, 0xba, 0x58, 0xcc, 0xca, 0xa3,
-	0xe2, 0x8d, 0xb5, 0xbd, 0x87, 0xd8, 0x9d, 0xa0, 0xaa, 0xa4, 0xee, 0x4e, 0x96, 0xa6, 0xaa, 0xb2, 0xdb, 0x15,
-	0xcb, 0x65, 0x4a, 0x82, 0xd4, 0x53, 0x56, 0x89, 0x5c, 0x6c, 0x65, 0x9a, 0x66, 0x5f, 0x33, 0xb1, 0x54, 0x23,
-	0x13, 0x76, 0xe0, 0x83, 0x52, 0x78, 0x95, 0xa7, 0xb1, 0xd8, 0x48, 0xb5, 0xb5, 0x9f, 0x75, 0xd3, 0x4c, 0xbb,
-	0xb5, 0x9f, 0x29, 0x37, 0x54, 0x70, 0xed, 0x67, 0xd2, 0x35, 0x75, 0x5c, 0x96, 0x99, 0xb4, 0xeb, 0xe4, 0x55,
-	0x5c, 0xc2, 0xb9, 0x50, 0xc4, 0x95, 0x0a, 0xb7, 0x4e, 0xa9, 0xd7, 0x9d, 0xd0, 0x49, 0xcb, 0xbe, 0x87, 0xa2,
-	0x34, 0xa8, 0x1d, 0xb6, 0x48, 0xff, 0xb5, 0x93, 0x97, 0x81, 0x89, 0xc1, 0x03, 0xad, 0xa1, 0xad, 0x94, 0x0c,
-	0xcc, 0xba, 0x4c, 0x5c, 0xbb, 0x2f, 0xa6, 0xdc, 0x45, 0x79, 0xb4, 0x4a, 0x2c, 0xb6, 0xf2, 0xdd, 0x99, 0x2f,
-	0x0d, 0x6f, 0xac, 0xbd, 0x33, 0x0a, 0xb2, 0xa5, 0x3d, 0x2a, 0xc8, 0x96, 0x77, 0xa3, 0x20, 0x3b, 0xbf, 0x3b,
-	0x05, 0xd9, 0x85, 0x52, 0x05, 0x59, 0x9d, 0x9e, 0x55, 0x26, 0x22, 0x2b, 0xed, 0x62, 0x69, 0xab, 0x71, 0x90,
-	0x91, 0x81, 0x8c, 0xec, 0xf4, 0xc9, 0xc8, 0xae, 0xd2, 0x57, 0x71, 0xb4, 0xb1, 0x8e, 0x1c, 0x4a, 0x38, 0x0a,
-	0x68, 0x68, 0xb0, 0x03, 0x98, 0x23, 0x8f, 0x06, 0x23, 0xf1, 0x6f, 0x40, 0xd1, 0x55, 0x1a, 0x7f, 0x38, 0x63,
-	0x51, 0x10, 0xb8, 0xc1, 0xa8, 0x8b, 0x36, 0xa8, 0x4f, 0x10, 0x9d, 0x10, 0x86, 0x43, 0x79, 0xb7, 0x6f, 0x8b,
-	0x74, 0x47, 0x5d, 0x79, 0x0a, 0xc1, 0xa5, 0x7d, 0x73, 0x48, 0x98, 0xb2, 0x9c, 0xe1, 0xea, 0xef, 0x76, 0x9c,
-	0xa6, 0x8f, 0xa7, 0xc8, 0xfa, 0x82, 0xee, 0x4d, 0x51, 0xc8, 0xdc, 0xd1, 0x88, 0x30, 0x84, 0x45, 0x4a, 0xa9,
-	0x0b, 0x64, 0xbb, 0xe8, 0xd6, 0xd8, 0xe5, 0xf2, 0x93, 0x27, 0x8f, 0xb3, 0x31, 0x22, 0x21, 0x22, 0xf7, 0xc9,
-	0x20, 0x0a, 0x49, 0xfc, 0x51, 0xce, 0x77, 0x1d, 0xc7, 0xd3, 0x9f, 0xfb, 0x08, 0x52, 0x25, 0x57, 0x87, 0xfd,
-	0xb1, 0x11, 0x99, 0xc8, 0x67, 0x17, 0x5d, 0x4c, 0x88, 0x50, 0xf2, 0xeb, 0x9e, 0x1e, 0xf9, 0xe4, 0x37, 0x39,
-	0xd1, 0x5c, 0xea, 0x2b, 0xaa, 0xab, 0xe2, 0x19, 0x44, 0x8c, 0x89, 0xdc, 0xe8, 0x1f, 0x39, 0x0a, 0x29, 0x45,
-	0xdc, 0xc7, 0x9e, 0x87, 0x5a, 0x9c, 0x10, 0x49, 0x63, 0x50, 0x6e, 0x26, 0x81, 0x76, 0x37, 0x4e, 0x53, 0xe6,
-	0x5c, 0xd5, 0x27, 0x97, 0xb1, 0x6d, 0xac, 0x77, 0x50, 0x38, 0x8e, 0x38, 0xda, 0x26, 0x68, 0x8c, 0xb7, 0x88,
-	0x48, 0x34, 0x9a, 0x38, 0x58, 0xaa, 0xe0, 0x84, 0x33, 0x8a, 0xb8, 0xfa, 0x24, 0x69, 0x97, 0x21, 0xc9, 0xfb,
-	0xfc, 0x81, 0xa9, 0xff, 0xf2, 0x6b, 0xff, 0xc3, 0x16, 0x00, 0x5a, 0xb3, 0x55, 0x71, 0x9c, 0x4e, 0xd9, 0xef,
-	0x62, 0x76, 0x3e, 0xbd, 0xd2, 0xc1, 0x92, 0x96, 0x39, 0x5c, 0xf5, 0x20, 0x23, 0x9c, 0x84, 0xcf, 0x48, 0xf3,
-	0xb5, 0x78, 0x34, 0xcb, 0x56, 0x4a, 0x81, 0x9f, 0xb2, 0x8b, 0x72, 0x1a, 0x1b, 0xe9, 0xcd, 0x52, 0x35, 0x2e,
-	0xcd, 0x90, 0x35, 0x2e, 0x1d, 0xb2, 0xae, 0x71, 0xa9, 0xb1, 0xb0, 0x71, 0xe9, 0xc0, 0x95, 0x8d, 0xf6, 0x32,
-	0x67, 0x5f, 0xc5, 0x8d, 0xfa, 0x10, 0x45, 0x1e, 0xcb, 0xd4, 0x17, 0x38, 0xda, 0xa1, 0x0e, 0x53, 0xe4, 0x98,
-	0x3b, 0x26, 0xaa, 0xa1, 0x72, 0x34, 0x8f, 0xc9, 0xa9, 0x55, 0x64, 0x57, 0x7f, 0x62, 0x1b, 0x57, 0x92, 0xc2,
-	0x18, 0x73, 0x29, 0xd9, 0xb3, 0x62, 0x92, 0xb5, 0x48, 0x19, 0xe2, 0x62, 0x16, 0x65, 0x04, 0x73, 0x1a, 0x88,
-	0xe9, 0x6d, 0x88, 0x5d, 0x8f, 0x38, 0x62, 0x5e, 0x60, 0x64, 0xe4, 0xf2, 0x90, 0x30, 0x39, 0x15, 0x84, 0x5a,
-	0x1f, 0x29, 0xa7, 0xc6, 0x0e, 0xf2, 0xe8, 0x48, 0xce, 0xac, 0x21, 0x9b, 0x22, 0x3c, 0xc2, 0x6e, 0x90, 0x8a,
-	0xb6, 0x15, 0x12, 0x31, 0x73, 0x60, 0x36, 0x45, 0xdb, 0x94, 0x6d, 0x62, 0x26, 0x6d, 0x57, 0x44, 0x62, 0xe3,
-	0x30, 0x9c, 0xf0, 0x95, 0x5e, 0x6f, 0xe4, 0x86, 0xe3, 0xa8, 0xdf, 0x1d, 0x50, 0xbf, 0x37, 0xa2, 0x1e, 0x0e,
-	0x46, 0xbd, 0x11, 0xed, 0xb9, 0x9c, 0x47, 0x84, 0xf7, 0x96, 0x1f, 0x7b, 0xfc, 0x35, 0x8f, 0xb4, 0x93, 0x08,
-	0xd3, 0x87, 0x81, 0x45, 0xad, 0xb7, 0x82, 0x7c, 0x97, 0x8b, 0x79, 0x2b, 0x95, 0xcb, 0x05, 0xab, 0x7a, 0x4a,
-	0xeb, 0xcd, 0xd8, 0xde, 0xcd, 0x1d, 0xae, 0x4c, 0xb4, 0xe2, 0x55, 0xa8, 0xa7, 0x14, 0x4d, 0x75, 0xf9, 0x4a,
-	0x91, 0x68, 0xc3, 0x6e, 0x3e, 0x7b, 0x0a, 0x2e, 0x90, 0x88, 0xe6, 0x3b, 0x5c, 0xb1, 0x46, 0x34, 0xef, 0xef,
-	0xc4, 0x88, 0x44, 0x87, 0x6e, 0x80, 0x3d, 0xf7, 0x6d, 0xe4, 0x26, 0xd1, 0xa6, 0xff, 0x05, 0xe2, 0x04, 0xcb,
-	0x4f, 0xfd, 0x9a, 0xd6, 0x1b, 0xbe, 0xf2, 0xd5, 0x4e, 0x76, 0x1b, 0x7d, 0xee, 0xdc, 0x6a, 0xd6, 0x30, 0x35,
-	0xbf, 0x53, 0x4f, 0x9f, 0x83, 0x59, 0xf2, 0x9f, 0xc2, 0x13, 0xc3, 0x92, 0xd3, 0x80, 0xf8, 0x7c, 0x3b, 0xbd,
-	0xe5, 0xd7, 0x6d, 0x96, 0x5c, 0x5c, 0x39, 0x97, 0x3e, 0xa6, 0x88, 0xf8, 0xd8, 0x6a, 0xd0, 0x52, 0xc9, 0xcd,
-	0xe9, 0x93, 0xdd, 0xaa, 0x5a, 0x56, 0xa6, 0x89, 0x19, 0x25, 0x62, 0x4d, 0xc5, 0x6d, 0x3c, 0x9f, 0x4e, 0x92,
-	0xc3, 0x1f, 0x7b, 0x45, 0x32, 0x22, 0x21, 0x9f, 0xb4, 0x94, 0x4d, 0xb0, 0x58, 0xfd, 0x0f, 0xa8, 0x2f, 0xf5,
-	0x00, 0xb8, 0x4f, 0xb7, 0x48, 0x85, 0xf8, 0x57, 0x1b, 0x90, 0x9e, 0x1a, 0x3d, 0xef, 0x65, 0xdd, 0x05, 0x33,
-	0x2d, 0x56, 0x92, 0x4f, 0xe5, 0xbb, 0x22, 0xab, 0xaa, 0x47, 0xaf, 0x33, 0xb1, 0x99, 0x0c, 0xa7, 0x0d, 0x1b,
-	0x0b, 0x14, 0xc7, 0xa0, 0x38, 0x3e, 0x30, 0xc5, 0x71, 0xc9, 0x90, 0x92, 0x13, 0x1b, 0x27, 0xa2, 0xcc, 0x3d,
-	0x8c, 0x1c, 0x85, 0x7a, 0xe3, 0xf4, 0xd0, 0x91, 0x3f, 0xac, 0xdc, 0x57, 0x3d, 0x73, 0x45, 0xe4, 0xa7, 0x5a,
-	0xd2, 0x5c, 0xd9, 0xcc, 0x05, 0x6a, 0xe6, 0x83, 0x69, 0xee, 0x0b, 0x8b, 0xb5, 0x9b, 0x7b, 0x7f, 0xf4, 0xba,
-	0x15, 0x91, 0x9f, 0x52, 0xcd, 0x74, 0x49, 0x43, 0xe7, 0xe5, 0xd2, 0xd0, 0xc2, 0x20, 0xca, 0x06, 0x51, 0xf6,
-	0xd1, 0x88, 0xb2, 0x4b, 0x3a, 0xed, 0x7e, 0xe8, 0xb2, 0xe3, 0x4d, 0x3c, 0x9d, 0x4c, 0x9f, 0x9c, 0x86, 0x84,
-	0xdf, 0xa2, 0x57, 0x69, 0xcb, 0xe1, 0xa1, 0xae, 0xdd, 0x0e, 0xe2, 0x6c, 0xa0, 0x7b, 0x95, 0x5c, 0x8c, 0x95,
-	0x8c, 0x61, 0xa9, 0xf0, 0xa5, 0x15, 0xee, 0x48, 0x69, 0x76, 0x6e, 0x93, 0x9b, 0x3e, 0xe3, 0x15, 0x09, 0xae,
-	0x15, 0xac, 0xc0, 0xed, 0x5d, 0xe6, 0x43, 0x2d, 0xe1, 0xcb, 0x2a, 0x6a, 0x72, 0xae, 0xdb, 0x4e, 0xe9, 0x21,
-	0x6b, 0xbc, 0xed, 0xe9, 0x6f, 0xf4, 0xf1, 0x09, 0x8c, 0xfe, 0xca, 0x44, 0x2f, 0xd1, 0xc9, 0x14, 0xad, 0x89,
-	0x5c, 0x75, 0x79, 0xd4, 0x97, 0x9f, 0xdb, 0x5a, 0x8b, 0x1d, 0x51, 0x94, 0x4c, 0x9f, 0x9d, 0x13, 0x3f, 0x89,
-	0x0e, 0xa2, 0xc2, 0x94, 0xf4, 0x77, 0x31, 0x22, 0x29, 0x0f, 0xd9, 0xe0, 0x75, 0xc6, 0xbd, 0xaa, 0x76, 0x7b,
-	0x7a, 0x43, 0xb6, 0x9b, 0xec, 0x70, 0xa2, 0x76, 0xf4, 0xeb, 0x51, 0xbb, 0xdd, 0x9e, 0xde, 0xa8, 0xd1, 0x6e,
-	0x85, 0x2f, 0x4a, 0xb6, 0xdd, 0xd2, 0x2f, 0x4d, 0xba, 0xdd, 0x44, 0x54, 0x0f, 0x70, 0xbb, 0x1d, 0x03, 0x0e,
-	0xc2, 0xee, 0xbe, 0x35, 0x14, 0x21, 0x14, 0x9e, 0xde, 0x40, 0xfa, 0x07, 0xa9, 0x0c, 0xbc, 0x4a, 0xcd, 0xd7,
-	0x41, 0x6f, 0x2a, 0x4f, 0x24, 0xa5, 0x70, 0x8f, 0x04, 0x03, 0x89, 0x05, 0x10, 0x13, 0x92, 0x43, 0xee, 0xab,
-	0xaf, 0x79, 0xb1, 0x0b, 0x72, 0x9d, 0x34, 0x70, 0x61, 0x71, 0x57, 0xa0, 0x85, 0x1c, 0x4c, 0xc1, 0x3a, 0x80,
-	0x52, 0xe2, 0x79, 0x91, 0x5f, 0x65, 0x29, 0x86, 0xa4, 0x06, 0x2d, 0xc9, 0x99, 0xce, 0xba, 0xc8, 0xb0, 0xfc,
-	0xe4, 0x69, 0x4a, 0x55, 0x99, 0xe1, 0xf8, 0xf4, 0x29, 0x65, 0x53, 0x89, 0x94, 0x36, 0x7a, 0x32, 0x71, 0x83,
-	0x11, 0x1a, 0x32, 0xea, 0xdb, 0x55, 0x44, 0x53, 0x91, 0xf0, 0xf9, 0xcc, 0x39, 0x95, 0xce, 0xa6, 0xfc, 0x2f,
-	0x96, 0x66, 0x09, 0x8f, 0x2a, 0x87, 0xf2, 0xeb, 0xa8, 0x3c, 0xe2, 0xcd, 0x20, 0x2b, 0x88, 0x53, 0x06, 0x81,
-	0xd0, 0x31, 0x6d, 0x8f, 0x89, 0x54, 0xf0, 0x85, 0x63, 0x92, 0x55, 0x80, 0xab, 0x00, 0x27, 0x08, 0xf8, 0xa0,
-	0x3f, 0x14, 0xe6, 0x90, 0x0f, 0xea, 0x77, 0x31, 0xde, 0xf1, 0xfc, 0x92, 0xc5, 0x22, 0x39, 0x14, 0x02, 0x22,
-	0xe2, 0x17, 0x38, 0x2b, 0x26, 0x91, 0xf1, 0x99, 0xd7, 0x5d, 0x0e, 0x11, 0xf2, 0x97, 0x1c, 0x16, 0xc0, 0xf6,
-	0xb7, 0x9a, 0x95, 0x87, 0x3d, 0x8c, 0x1e, 0xcb, 0x58, 0x13, 0x5b, 0x3c, 0x01, 0x74, 0xce, 0xf6, 0x57, 0x64,
-	0xbe, 0x71, 0xbc, 0xd0, 0x11, 0x39, 0x5f, 0x8b, 0xbb, 0xe2, 0x49, 0x1c, 0x08, 0x30, 0x62, 0x46, 0xde, 0x9a,
-	0x53, 0x24, 0x0a, 0xcc, 0xa0, 0xca, 0x50, 0x12, 0xa5, 0x5e, 0x9b, 0xf0, 0x24, 0x6a, 0x32, 0x12, 0x4a, 0x10,
-	0x09, 0x65, 0xf6, 0xe3, 0xad, 0x76, 0x4c, 0x45, 0xd0, 0xd0, 0x84, 0x9c, 0x71, 0x7a, 0x3c, 0xa5, 0xc4, 0x1f,
-	0xa4, 0x54, 0x9c, 0xa5, 0x29, 0x65, 0x54, 0xc3, 0x75, 0xb8, 0x12, 0x3b, 0x65, 0x25, 0xb4, 0x3f, 0x81, 0xed,
-	0xbe, 0x68, 0x2a, 0xfb, 0x3e, 0xde, 0x24, 0x4f, 0x45, 0xc1, 0xe0, 0x36, 0xc3, 0x93, 0x09, 0x61, 0x2d, 0xd7,
-	0x44, 0x61, 0xd1, 0x16, 0x44, 0x64, 0xb6, 0x80, 0xdf, 0xd8, 0x92, 0xa0, 0x8c, 0xea, 0x83, 0x68, 0xa3, 0xfa,
-	0xe7, 0x90, 0xeb, 0xac, 0xa8, 0xaf, 0x60, 0x63, 0x97, 0xaf, 0xc8, 0xff, 0xab, 0x3d, 0xf1, 0x8a, 0x35, 0x3e,
-	0x6a, 0xdc, 0xc5, 0x88, 0xe6, 0x8c, 0xf2, 0x65, 0x3c, 0x89, 0x6b, 0xfa, 0x93, 0x9f, 0x51, 0x0b, 0x0b, 0x3f,
-	0x5d, 0xb5, 0x11, 0x5e, 0xb5, 0x66, 0xf9, 0x58, 0xd2, 0x91, 0x57, 0x46, 0x9f, 0x39, 0x93, 0xfb, 0xad, 0xeb,
-	0x63, 0x37, 0x90, 0x9f, 0x5a, 0x7c, 0xea, 0x44, 0x5e, 0xda, 0x8f, 0xd1, 0x32, 0x15, 0xfc, 0xd6, 0xdd, 0x22,
-	0x8c, 0x4b, 0x8b, 0x25, 0xe9, 0xf8, 0x50, 0x89, 0x6b, 0x97, 0xc4, 0xca, 0xf8, 0xba, 0x2c, 0x8d, 0xcc, 0x1d,
-	0x74, 0x4d, 0x21, 0x1a, 0x07, 0x8f, 0xd0, 0xd0, 0x23, 0x15, 0xe6, 0xdc, 0x1d, 0x05, 0xad, 0xe7, 0xd0, 0xad,
-	0x67, 0xd6, 0x2f, 0x5f, 0xbb, 0xb9, 0x92, 0x88, 0x62, 0x28, 0x5f, 0x68, 0x77, 0x43, 0x7f, 0xa2, 0xc4, 0x50,
-	0x3b, 0x1d, 0x1b, 0xba, 0xd1, 0x3e, 0x91, 0xd4, 0x8d, 0x94, 0x31, 0xd9, 0x61, 0xa3, 0x37, 0x32, 0xef, 0x86,
-	0x7a, 0xb7, 0x16, 0xcd, 0xdb, 0x53, 0xf0, 0x82, 0x1c, 0xe4, 0x6d, 0x82, 0xb3, 0x2e, 0x0e, 0xfc, 0xd5, 0x5f,
-	0xfd, 0xf2, 0xad, 0x36, 0xfd, 0xc2, 0x1f, 0xbf, 0xf0, 0x4f, 0x7e, 0xf0, 0x7d, 0xef, 0xf9, 0xf4, 0x8f, 0xff,
-	0xe0, 0x1f, 0x7c, 0xdf, 0x9b, 0xde, 0xea, 0xfc, 0xd8, 0x47, 0xfe, 0x69, 0xff, 0x4b, 0x2f, 0xf8, 0xc2, 0xcf,
-	0xfc, 0xe8, 0x77, 0xff, 0x23, 0x85, 0xe3, 0x78, 0xdd, 0x2f, 0x01, 0x8e, 0xe3, 0xd0, 0x71, 0x1c, 0xea, 0xe1,
-	0x36, 0xe9, 0x4f, 0xf0, 0x60, 0x13, 0xe0, 0x1c, 0x27, 0x09, 0xce, 0xa1, 0xcd, 0x01, 0x8b, 0xd8, 0x1c, 0x76,
-	0x33, 0x0a, 0x0f, 0x22, 0x0f, 0x54, 0x0e, 0x81, 0x0b, 0x22, 0xb6, 0x21, 0x8f, 0x01, 0x0a, 0xe2, 0xd1, 0x5e,
-	0x65, 0x0e, 0xb9, 0x39, 0x2a, 0x4d, 0xad, 0xb5, 0x6d, 0xa0, 0x86, 0xba, 0x4d, 0x61, 0x07, 0x08, 0x20, 0x40,
-	0x00, 0x01, 0x02, 0x08, 0x10, 0x40, 0x80, 0x00, 0x02, 0x04, 0x10, 0x20, 0x80, 0x00, 0x01, 0x04, 0x08, 0x20,
-	0xfb, 0x4d, 0x00, 0xa9, 0x5e, 0xf8, 0x1d, 0x25, 0x13, 0xa4, 0x14, 0x57, 0x92, 0x5b, 0x6a, 0xa7, 0xf3, 0xe0,
-	0x2a, 0x21, 0x18, 0xde, 0xc2, 0xae, 0x87, 0xfb, 0x1e, 0xe9, 0xc4, 0x20, 0x0c, 0x53, 0x02, 0x07, 0xb5, 0x32,
-	0x39, 0x44, 0x34, 0xf0, 0xa6, 0xc5, 0xab, 0xee, 0x93, 0x04, 0x23, 0x99, 0x81, 0xd8, 0x6b, 0x86, 0x27, 0xa9,
-	0x26, 0x9e, 0x94, 0x35, 0x82, 0x9d, 0x46, 0x8d, 0x96, 0x28, 0xac, 0x72, 0x1b, 0x79, 0x32, 0xbb, 0x44, 0xf5,
-	0xe1, 0x28, 0xd5, 0xbc, 0x95, 0xaa, 0x12, 0x99, 0x34, 0x9a, 0x94, 0x08, 0x40, 0x2c, 0x00, 0x62, 0x01, 0x10,
-	0x0b, 0x80, 0x58, 0x8e, 0x37, 0x88, 0x45, 0x0c, 0xb0, 0x5b, 0x99, 0xf7, 0xf6, 0x78, 0x30, 0x59, 0xf6, 0x82,
-	0x43, 0x49, 0x78, 0x2e, 0x79, 0xbe, 0x45, 0x6d, 0x04, 0x0a, 0x20, 0x39, 0x76, 0x87, 0xe4, 0xa8, 0x77, 0xa9,
-	0xb3, 0x3e, 0x2a, 0x5c, 0xb1, 0xec, 0x8d, 0xb6, 0xf2, 0x07, 0x2e, 0xc5, 0xb7, 0x3d, 0xef, 0x94, 0x33, 0x3b,
-	0x00, 0xf4, 0x01, 0xa0, 0x0f, 0x00, 0x7d, 0x00, 0xe8, 0x03, 0x40, 0x1f, 0xa7, 0x05, 0xf4, 0xc1, 0x27, 0xe8,
-	0x89, 0x27, 0x9e, 0x48, 0xa6, 0x2a, 0x20, 0x7f, 0x1c, 0x0c, 0xf9, 0xa3, 0xa4, 0x9e, 0x01, 0x05, 0x52, 0x82,
-	0x02, 0xc9, 0xd5, 0xd7, 0x31, 0x62, 0x83, 0x64, 0xb3, 0x76, 0xdc, 0x2f, 0x41, 0x2f, 0xe9, 0x7b, 0x0f, 0x26,
-	0x3b, 0xa4, 0xa4, 0x32, 0x00, 0x26, 0x02, 0x30, 0x91, 0x53, 0x0d, 0x13, 0x99, 0xd9, 0xef, 0x4f, 0x29, 0x5d,
-	0x24, 0x5f, 0xee, 0xa3, 0xc7, 0x8d, 0xd4, 0x6d, 0x0b, 0xe0, 0x8f, 0xec, 0x99, 0x3f, 0x92, 0x9b, 0xab, 0x8f,
-	0x0b, 0x90, 0xa4, 0xa4, 0x0f, 0x1c, 0x02, 0xa1, 0x44, 0xa4, 0x2b, 0xf6, 0xda, 0xc7, 0x98, 0x53, 0x92, 0xab,
-	0x9c, 0x13, 0x04, 0x2e, 0x29, 0xcd, 0xfb, 0xf1, 0x25, 0x99, 0xd4, 0x79, 0x49, 0x8e, 0x2d, 0xda, 0xa4, 0x69,
-	0x5f, 0x39, 0xde, 0xac, 0x93, 0xfc, 0xbe, 0xe7, 0xc1, 0x80, 0x9f, 0xd4, 0x18, 0x94, 0x0e, 0x1e, 0x81, 0x72,
-	0x30, 0x59, 0x3c, 0x09, 0x14, 0x95, 0xfd, 0xea, 0x76, 0x47, 0x8f, 0x55, 0xd9, 0x4d, 0x2b, 0xed, 0x3b, 0x7a,
-	0xe3, 0x60, 0xb2, 0x78, 0xbc, 0xf9, 0x2c, 0xc7, 0xa6, 0x0b, 0xed, 0x1e, 0xd8, 0x02, 0x7d, 0xe7, 0xf0, 0xc8,
-	0x2f, 0xd5, 0x87, 0x68, 0xc7, 0x12, 0x05, 0x53, 0xb2, 0x64, 0x3f, 0xc5, 0x6c, 0x98, 0xca, 0x12, 0x1f, 0x7f,
-	0x58, 0x4c, 0xe9, 0x21, 0x72, 0x01, 0x3d, 0xa6, 0xd5, 0x14, 0x1f, 0x83, 0x5e, 0x8b, 0x96, 0xd0, 0x0a, 0x5a,
-	0x3c, 0x32, 0x8e, 0x4c, 0x49, 0xe3, 0x1c, 0x06, 0x58, 0x06, 0x3d, 0xff, 0x3c, 0x2a, 0x72, 0xbc, 0xe4, 0x61,
-	0x7f, 0x42, 0x1c, 0x80, 0xcf, 0xec, 0x3b, 0x7c, 0x66, 0x66, 0x63, 0x1f, 0x1c, 0x8d, 0x46, 0x34, 0x76, 0x91,
-	0xe3, 0x83, 0xd7, 0xd8, 0x87, 0x41, 0xac, 0x51, 0x8d, 0x61, 0x7e, 0xb4, 0x2b, 0xdd, 0x16, 0x51, 0x9b, 0x61,
-	0xaf, 0x5d, 0x01, 0x22, 0xe8, 0xb2, 0x28, 0x58, 0x29, 0x0c, 0x85, 0xc8, 0xfd, 0x89, 0xc4, 0xa5, 0x14, 0x03,
-	0x09, 0x00, 0x95, 0x13, 0xc4, 0x76, 0xa9, 0xf6, 0x47, 0xd1, 0x94, 0x71, 0x58, 0xbb, 0x3b, 0x74, 0x3d, 0xaf,
-	0x75, 0x2d, 0x18, 0xba, 0x81, 0x1b, 0x4e, 0xdb, 0x87, 0x41, 0xd5, 0xb9, 0xd3, 0x90, 0xa9, 0x33, 0x77, 0x67,
-	0xb1, 0x83, 0x96, 0xee, 0xaa, 0x02, 0xdf, 0x51, 0x1a, 0xb8, 0x65, 0xf3, 0x28, 0x6b, 0x08, 0x9d, 0x37, 0x8f,
-	0xaa, 0x9a, 0xd0, 0x05, 0xf3, 0x6c, 0x74, 0xb4, 0x8f, 0xc4, 0xfe, 0x25, 0xec, 0xe1, 0x51, 0xf5, 0x78, 0xd7,
-	0x6e, 0xde, 0x0c, 0xb4, 0x67, 0x9f, 0x88, 0x3d, 0x80, 0xeb, 0x01, 0x5c, 0x0f, 0xe0, 0x7a, 0x4e, 0x06, 0xae,
-	0x47, 0x9a, 0x39, 0xc9, 0x57, 0x65, 0x93, 0x30, 0x34, 0x8a, 0x30, 0xc3, 0x41, 0x48, 0x08, 0x37, 0x88, 0x01,
-	0x07, 0x87, 0x18, 0xf1, 0x10, 0xb3, 0x90, 0xab, 0x71, 0x0b, 0x87, 0xc8, 0x23, 0x98, 0x87, 0xd2, 0xe4, 0xe6,
-	0x19, 0x37, 0x10, 0x73, 0xca, 0x45, 0xc7, 0x61, 0x5d, 0x1d, 0xdb, 0xeb, 0x09, 0x99, 0x20, 0x37, 0x90, 0x06,
-	0x62, 0x68, 0xdb, 0x0d, 0xc7, 0x68, 0xe0, 0x3b, 0x3d, 0x11, 0x7f, 0x4f, 0xda, 0xe0, 0x04, 0xd8, 0xeb, 0x79,
-	0x4e, 0x4f, 0xc4, 0xdb, 0x1d, 0xd1, 0x95, 0xa2, 0x58, 0x54, 0xdd, 0x65, 0x5c, 0xf4, 0x0b, 0x2b, 0x97, 0x04,
-	0xda, 0x0c, 0xde, 0xea, 0xf0, 0x4f, 0xac, 0x65, 0xfd, 0x97, 0x4e, 0xaf, 0xa9, 0xc1, 0x26, 0xa4, 0x54, 0xda,
-	0xc9, 0x2d, 0xd8, 0x16, 0x7b, 0x80, 0x30, 0x02, 0x84, 0x51, 0x39, 0xc2, 0x48, 0xdb, 0x6d, 0x6b, 0x3a, 0x51,
-	0x06, 0x31, 0x51, 0x44, 0x34, 0x02, 0xca, 0x11, 0x50, 0x8e, 0x80, 0x72, 0x74, 0xb0, 0x94, 0xa3, 0x4f, 0xff,
-	0xfe, 0x2f, 0x7c, 0xe8, 0xa7, 0xbe, 0xb2, 0xfe, 0xa9, 0xa7, 0x1e, 0xfd, 0xeb, 0x0f, 0xfe, 0xc9, 0x17, 0xbe,
-	0xfd, 0x2f, 0x7f, 0xf4, 0xf7, 0xbe, 0xf0, 0x95, 0xbf, 0xf5, 0xd1, 0x4f, 0xff, 0xc4, 0x6f, 0xbc, 0xe7, 0xe5,
-	0xef, 0xb8, 0x8e, 0x9c, 0x57, 0x28, 0xca, 0xd1, 0xb7, 0xff, 0xd1, 0x71, 0xa7, 0x1c, 0x2d, 0x44, 0x5c, 0xd9,
-	0x4c, 0x0c, 0xc2, 0x85, 0x55, 0x1b, 0x7a, 0x74, 0x64, 0xac, 0x97, 0x5b, 0x62, 0xcc, 0xaf, 0xc3, 0x7b, 0xd1,
-	0x1e, 0x81, 0xf9, 0x02, 0xcc, 0x17, 0x60, 0xbe, 0x00, 0xf3, 0x05, 0x98, 0x2f, 0xc0, 0x7c, 0x01, 0xe6, 0xcb,
-	0x61, 0x30, 0x5f, 0xe4, 0xc4, 0x5b, 0xc0, 0x7d, 0xb1, 0x7f, 0x07, 0xf6, 0x4b, 0x1d, 0xf6, 0x8b, 0xac, 0xb1,
-	0x1a, 0x48, 0x15, 0xcb, 0x5f, 0x3d, 0xac, 0x4a, 0xb1, 0xe5, 0x72, 0x39, 0x5d, 0x45, 0x35, 0x5d, 0x96, 0xb0,
-	0x52, 0x95, 0x1b, 0x1b, 0xbb, 0x52, 0x2b, 0x4b, 0x19, 0x6d, 0xcb, 0x8c, 0xbc, 0xd4, 0xe5, 0x9c, 0x64, 0x3d,
-	0xef, 0x96, 0x75, 0x12, 0xc7, 0x53, 0x07, 0x45, 0x92, 0xf5, 0xdc, 0x28, 0xd1, 0x03, 0x05, 0x8d, 0xc8, 0x9c,
-	0x01, 0x6c, 0x04, 0x60, 0x23, 0x00, 0x1b, 0x01, 0xd8, 0x08, 0xc0, 0x46, 0x00, 0x36, 0x02, 0xb0, 0x11, 0x80,
-	0x8d, 0x00, 0x6c, 0x04, 0x60, 0x23, 0x00, 0x1b, 0x01, 0xd8, 0x08, 0xc0, 0x46, 0x00, 0x36, 0x02, 0xb0, 0x11,
-	0x80, 0x8d, 0x00, 0x6c, 0x04, 0x60, 0x23, 0x00, 0x1b, 0x01, 0xd8, 0x08, 0xc0, 0x46, 0x00, 0x36, 0x02, 0xb0,
-	0x11, 0x80, 0x8d, 0x00, 0x6c, 0x04, 0x60, 0x23, 0x00, 0x1b, 0x01, 0xd8, 0x08, 0xc0, 0x46, 0x00, 0x36, 0x02,
-	0xb0, 0x11, 0x80, 0x8d, 0x00, 0x6c, 0x04, 0x60, 0x23, 0x00, 0x1b, 0x01, 0xd8, 0x08, 0xc0, 0x46, 0x00, 0x36,
-	0x02, 0xb0, 0x11, 0x80, 0x8d, 0x00, 0x6c, 0x04, 0x60, 0x23, 0x00, 0x1b, 0x39, 0x70, 0xd8, 0xc8, 0x2d, 0x83,
-	0x19, 0x01, 0xe0, 0x48, 0x2d, 0xe0, 0x88, 0xac, 0x2f, 0x80, 0x8e, 0x00, 0x74, 0x04, 0xa0, 0x23, 0x00, 0x1d,
-	0x39, 0x75, 0xd0, 0x91, 0xc7, 0x96, 0x1e, 0x5f, 0xde, 0x03, 0x74, 0x24, 0xa4, 0x21, 0xf6, 0x8c, 0xca, 0x96,
-	0x0e, 0x33, 0x83, 0x4f, 0xe9, 0x90, 0x43, 0xee, 0x0f, 0x08, 0x71, 0x38, 0xf2, 0x5c, 0xdf, 0x0d, 0x81, 0x51,
-	0x02, 0x8c, 0x92, 0x6a, 0x46, 0x89, 0x62, 0x21, 0xfc, 0xfe, 0x93, 0xf8, 0xcb, 0xbf, 0x3c, 0xf7, 0xff, 0xfd,
-	0xd6, 0x0f, 0x9e, 0xfd, 0xeb, 0xf7, 0xfc, 0xe9, 0x3f, 0xbc, 0x74, 0xf7, 0x91, 0x17, 0x7f, 0xf6, 0xcb, 0x2f,
-	0x5a, 0xc5, 0x9f, 0xfb, 0x17, 0xff, 0xf3, 0x77, 0xfe, 0xc3, 0x67, 0xee, 0xbd, 0xfc, 0x7d, 0x9a, 0x85, 0xf0,
-	0x39, 0x60, 0x21, 0x00, 0x0b, 0xe1, 0x30, 0x59, 0x08, 0x6e, 0x30, 0x6a, 0x80, 0x43, 0x10, 0xbe, 0x81, 0x88,
-	0x00, 0x44, 0x04, 0x20, 0x22, 0x00, 0x11, 0x01, 0x88, 0x08, 0x40, 0x44, 0x00, 0x22, 0x02, 0x10, 0x11, 0x80,
-	0x88, 0x00, 0x44, 0x04, 0x20, 0x22, 0x00, 0x11, 0x01, 0x88, 0x08, 0x40, 0x44, 0x00, 0x22, 0x02, 0x10, 0x11,
-	0x80, 0x88, 0x00, 0x44, 0x04, 0x20, 0x22, 0x00, 0x11, 0x01, 0x88, 0x08, 0x40, 0x44, 0x00, 0x22, 0x02, 0x10,
-	0x11, 0x80, 0x88, 0x00, 0x44, 0x04, 0x20, 0x22, 0x00, 0x11, 0x01, 0x88, 0x08, 0x40, 0x44, 0x00, 0x22, 0x02,
-	0x10, 0x11, 0x80, 0x88, 0x00, 0x44, 0x04, 0x20, 0x22, 0x00, 0x11, 0x01, 0x88, 0x08, 0x40, 0x44, 0x00, 0x22,
-	0x02, 0x10, 0x11, 0x80, 0x88, 0x00, 0xaa, 0x76, 0x20, 0x22, 0x00, 0x11, 0x01, 0x88, 0x08, 0x40, 0x44, 0x00,
-	0x22, 0x02, 0x10, 0x11, 0x80, 0x88, 0x00, 0x44, 0x04, 0x20, 0x22, 0x00, 0x11, 0x01, 0x88, 0x08, 0x40, 0x44,
-	0x00, 0x22, 0x02, 0x10, 0x11, 0x80, 0x88, 0x00, 0x44, 0x04, 0x20, 0x22, 0x00, 0x11, 0x01, 0x88, 0x08, 0xa7,
-	0x8b, 0x88, 0xf0, 0x17, 0x1f, 0xfa, 0x99, 0xb7, 0x7e, 0x5f, 0x9f, 0xbe, 0xf7, 0xcf, 0x3e, 0xff, 0xc7, 0x1f,
-	0xbd, 0xfd, 0x3b, 0x5f, 0x3d, 0x7e, 0xf8, 0xfb, 0xce, 0x3d, 0xfe, 0xcb, 0xef, 0xbf, 0x7c, 0xfd, 0x2f, 0xaf,
-	0xfc, 0xf1, 0x37, 0xfe, 0xbb, 0xdf, 0xfe, 0xe9, 0x2f, 0x2b, 0x22, 0xc2, 0x53, 0x7f, 0x78, 0xdc, 0x89, 0x08,
-	0x09, 0x02, 0xa1, 0xd7, 0x13, 0x6b, 0x1a, 0xe4, 0x47, 0x5e, 0xe8, 0x4e, 0x3c, 0x82, 0x9e, 0xc6, 0x5b, 0x78,
-	0x63, 0xc0, 0xdc, 0x49, 0x68, 0xbf, 0x37, 0x7a, 0xc9, 0xc4, 0xdd, 0x60, 0xe4, 0xa9, 0x23, 0x2f, 0x1a, 0xa0,
-	0x8b, 0xeb, 0xd7, 0x3a, 0x32, 0x82, 0x89, 0x5c, 0xf2, 0x48, 0xcb, 0xde, 0x6d, 0xd2, 0x17, 0xa3, 0x4b, 0xe0,
-	0x60, 0xe6, 0x70, 0x24, 0x32, 0x8c, 0xae, 0x53, 0x87, 0x74, 0x9f, 0xe5, 0xc2, 0x7b, 0x57, 0x78, 0x97, 0x41,
-	0xae, 0xd8, 0x71, 0x8b, 0x86, 0x76, 0x1d, 0xc2, 0x88, 0xb3, 0x22, 0x1d, 0xcf, 0xa1, 0x27, 0x19, 0xdd, 0xe6,
-	0x84, 0x71, 0xfd, 0xa8, 0xa3, 0xd0, 0x4f, 0x57, 0xc4, 0x72, 0x85, 0xd1, 0x40, 0x3f, 0xae, 0x63, 0x36, 0x20,
-	0x9e, 0x7e, 0xb8, 0x4d, 0xfa, 0x13, 0x3c, 0xd8, 0xd4, 0x8a, 0x37, 0x6d, 0x9a, 0xaf, 0x87, 0x3e, 0x31, 0x81,
-	0x2e, 0xc4, 0x87, 0xda, 0x0b, 0xaa, 0x9b, 0xf5, 0x7a, 0xc6, 0xd9, 0x7a, 0x5d, 0x78, 0xc8, 0xe7, 0xe7, 0x76,
-	0x10, 0xf1, 0x38, 0x41, 0x56, 0x3c, 0xdb, 0x6e, 0xe0, 0xd0, 0xed, 0xe2, 0x78, 0x94, 0x5b, 0x57, 0xc7, 0xb5,
-	0xa6, 0xfd, 0xae, 0x16, 0x45, 0xc3, 0x89, 0x37, 0x2c, 0x8e, 0x44, 0xb8, 0x24, 0x51, 0x88, 0xa7, 0x24, 0x82,
-	0x42, 0x91, 0xde, 0x00, 0x07, 0x01, 0x0d, 0x91, 0x7a, 0x89, 0x45, 0xff, 0x6a, 0x05, 0xc4, 0x95, 0x0b, 0x35,
-	0x15, 0x4b, 0xc7, 0xe4, 0x39, 0xa0, 0x4c, 0xa5, 0xeb, 0x72, 0x64, 0xd4, 0x17, 0x45, 0xe2, 0xc0, 0xae, 0x16,
-	0xf1, 0xa1, 0x33, 0x67, 0x90, 0xce, 0xae, 0xf9, 0xa5, 0x30, 0xc7, 0x99, 0x50, 0x6b, 0xc6, 0x77, 0x41, 0xcc,
-	0x43, 0x2e, 0x22, 0x4d, 0x07, 0x68, 0x5b, 0xb4, 0x0d, 0x49, 0xb9, 0xd0, 0xbf, 0xb7, 0x16, 0x86, 0x5c, 0x0d,
-	0x58, 0x56, 0xbd, 0x09, 0x0f, 0x22, 0x0f, 0x5a, 0x59, 0x21, 0x62, 0x1b, 0xf2, 0x58, 0x52, 0x21, 0x1e, 0xed,
-	0x35, 0xc3, 0x90, 0x9b, 0x43, 0xca, 0xd4, 0xca, 0x29, 0xc9, 0xcc, 0x1a, 0x1a, 0xf2, 0xd5, 0x44, 0xab, 0x7a,
-	0x64, 0x2c, 0x90, 0x5a, 0x1c, 0x10, 0x60, 0x80, 0x64, 0xa8, 0x1e, 0x0d, 0x00, 0x20, 0x40, 0xff, 0x00, 0xfa,
-	0x07, 0xd0, 0x3f, 0x80, 0xfe, 0x01, 0xf4, 0x0f, 0xa0, 0x7f, 0x34, 0xa2, 0x7f, 0x14, 0x91, 0x3f, 0x80, 0xfa,
-	0xd1, 0x84, 0xfa, 0x61, 0x48, 0x1e, 0x95, 0x0b, 0xbf, 0x80, 0x3a, 0xe4, 0x92, 0xf2, 0x67, 0x2d, 0x00, 0x55,
-	0x48, 0xb5, 0x3e, 0x48, 0x47, 0x96, 0xd4, 0x7c, 0xca, 0x7c, 0xb8, 0x6f, 0x26, 0xd0, 0x24, 0xbe, 0x2e, 0x93,
-	0x1e, 0x9e, 0x72, 0x3d, 0x4f, 0xce, 0x8a, 0x7d, 0x6b, 0xe2, 0x53, 0xed, 0x5d, 0x90, 0xd9, 0x4a, 0x1e, 0x46,
-	0x23, 0x34, 0x49, 0x6d, 0x26, 0x49, 0x9e, 0x47, 0x92, 0xff, 0xdd, 0x14, 0x5b, 0xc9, 0x0d, 0xed, 0x1d, 0xf2,
-	0x1d, 0x4e, 0x06, 0x4a, 0x97, 0x72, 0x17, 0xad, 0x21, 0xdd, 0x3f, 0xbb, 0x63, 0x16, 0xba, 0xb9, 0x0d, 0x2e,
-	0x27, 0x03, 0xad, 0x01, 0x41, 0x67, 0x95, 0x92, 0xa5, 0x67, 0x14, 0x21, 0xd9, 0x9a, 0x49, 0x67, 0xd0, 0xe6,
-	0x8e, 0x94, 0x34, 0x66, 0x81, 0x4f, 0xab, 0x39, 0xa3, 0xd0, 0xf5, 0x16, 0xda, 0xb6, 0x6b, 0xbe, 0xfe, 0xeb,
-	0x92, 0x50, 0xf6, 0x83, 0x82, 0x92, 0x82, 0x9a, 0xcc, 0x2e, 0xd1, 0x65, 0x52, 0x55, 0x22, 0xed, 0x5a, 0x5c,
-	0xa2, 0x3a, 0x98, 0x95, 0x5d, 0x23, 0x56, 0xe4, 0x56, 0xda, 0x41, 0x74, 0x18, 0xfb, 0xe1, 0x52, 0xfd, 0x91,
-	0x6c, 0xd1, 0xbb, 0x07, 0x0d, 0x62, 0x01, 0x08, 0x0b, 0x40, 0x58, 0x00, 0xc2, 0x02, 0x10, 0x16, 0x80, 0xb0,
-	0x00, 0x84, 0x05, 0x20, 0x2c, 0x00, 0x61, 0x01, 0x08, 0x0b, 0x40, 0x58, 0x00, 0xc2, 0x02, 0x10, 0x16, 0x80,
-	0xb0, 0x00, 0x84, 0x05, 0x20, 0x2c, 0x00, 0x61, 0xd9, 0x17, 0x08, 0xcb, 0x52, 0x63, 0x0a, 0xcb, 0x12, 0x60,
-	0x58, 0x00, 0xc3, 0x02, 0x18, 0x16, 0xc0, 0xb0, 0x00, 0x86, 0x05, 0x30, 0x2c, 0x80, 0x61, 0x01, 0x0c, 0x0b,
-	0x60, 0x58, 0x00, 0xc3, 0x02, 0x18, 0x16, 0xc0, 0xb0, 0x00, 0x86, 0x65, 0x36, 0x01, 0x01, 0x18, 0x2a, 0xc0,
-	0x50, 0x01, 0x00, 0xca, 0x03, 0x0b, 0x40, 0x01, 0x7a, 0x09, 0xd0, 0x4b, 0x80, 0x5e, 0x02, 0xf4, 0x12, 0xa0,
-	0x97, 0x00, 0xbd, 0x04, 0xe8, 0x25, 0x40, 0x2f, 0x01, 0x7a, 0x89, 0x4d, 0x2f, 0x01, 0x72, 0x49, 0x7d, 0x72,
-	0x09, 0x50, 0x4b, 0x80, 0x5a, 0x02, 0xd4, 0x12, 0xa0, 0x96, 0xcc, 0xa0, 0x96, 0x00, 0x72, 0x03, 0x90, 0x1b,
-	0x39, 0xe4, 0x86, 0x92, 0xd2, 0xcc, 0x2b, 0xa3, 0x57, 0x3a, 0x44, 0x3e, 0x75, 0x22, 0x2f, 0xc7, 0x21, 0x40,
-	0x67, 0xce, 0xe4, 0x39, 0x04, 0x05, 0xbf, 0x75, 0x7d, 0xec, 0x06, 0xf2, 0x9b, 0x9f, 0x8e, 0xc7, 0xf6, 0x63,
-	0x44, 0x7d, 0x05, 0xbf, 0x75, 0xb7, 0x08, 0xe3, 0xd2, 0xa6, 0x4f, 0x3a, 0x3e, 0x54, 0xe2, 0xda, 0x25, 0x31,
-	0x95, 0x42, 0x55, 0xa6, 0xc8, 0xbb, 0xf1, 0x64, 0x0d, 0x54, 0xe8, 0xdb, 0xd0, 0x79, 0xab, 0x65, 0xc4, 0x8a,
-	0x8f, 0xa8, 0xae, 0x14, 0x71, 0x3c, 0x22, 0x2b, 0x68, 0x44, 0xef, 0x3d, 0xcb, 0xef, 0x6d, 0x63, 0xee, 0xdf,
-	0x23, 0xf7, 0xc9, 0x00, 0xdd, 0x11, 0x7f, 0xa2, 0xbe, 0x1b, 0x60, 0x36, 0xbd, 0x8b, 0xee, 0xc4, 0xf5, 0x7e,
-	0x57, 0xf7, 0x2e, 0x93, 0x86, 0x34, 0xeb, 0x52, 0x4b, 0x4e, 0xd9, 0x23, 0xac, 0x76, 0x17, 0xfd, 0xf5, 0x2a,
-	0x55, 0x15, 0x3f, 0xa2, 0x46, 0x88, 0x93, 0xca, 0x1c, 0x97, 0x0b, 0xfb, 0x65, 0xe3, 0x45, 0x89, 0x72, 0xf4,
-	0x60, 0x86, 0x39, 0x77, 0x47, 0x41, 0xeb, 0x39, 0x74, 0xeb, 0x99, 0xf5, 0xcb, 0xd7, 0x6e, 0xae, 0x24, 0x12,
-	0x28, 0xca, 0x17, 0xda, 0xdd, 0xd0, 0x9f, 0x28, 0x55, 0xe0, 0x4e, 0x27, 0x8e, 0x53, 0x8c, 0x7a, 0x26, 0x2a,
-	0xa5, 0xe6, 0xb1, 0xb3, 0x29, 0x5c, 0xec, 0x65, 0xa5, 0x5a, 0x2c, 0x86, 0xae, 0x94, 0x44, 0x8b, 0xf7, 0x02,
-	0x3b, 0x4f, 0xb9, 0x9e, 0x32, 0xcd, 0xb2, 0x73, 0x79, 0x67, 0xf9, 0x6e, 0xbb, 0x83, 0x46, 0x34, 0x65, 0xca,
-	0xd9, 0xee, 0x86, 0x63, 0x12, 0x48, 0xd5, 0x4e, 0xe4, 0x85, 0xc9, 0x18, 0x66, 0x02, 0x52, 0xad, 0x0a, 0x5a,
-	0xe8, 0xd8, 0x8a, 0x22, 0xb1, 0x3a, 0x30, 0x60, 0x12, 0xe1, 0xca, 0x91, 0x3b, 0x44, 0x41, 0xc6, 0xdc, 0x12,
-	0xb9, 0x1c, 0xe9, 0x2e, 0x9d, 0x56, 0x20, 0xc9, 0x2f, 0xc7, 0xe8, 0xcc, 0x19, 0xf4, 0x90, 0x2e, 0xa0, 0x65,
-	0x46, 0xdf, 0xeb, 0x21, 0x87, 0x60, 0xc7, 0xa3, 0x83, 0xcd, 0x8e, 0xb4, 0xd4, 0x54, 0x2b, 0x0f, 0x37, 0x90,
-	0x0c, 0x09, 0xca, 0x94, 0x15, 0xa8, 0xb4, 0x92, 0x0c, 0x19, 0x1e, 0x10, 0xb5, 0xd0, 0x2a, 0x7a, 0x7d, 0xd4,
-	0xeb, 0xb7, 0x68, 0x5e, 0xb0, 0x82, 0x77, 0x68, 0xc7, 0x9a, 0x00, 0xf4, 0xfb, 0x34, 0x92, 0xcb, 0x76, 0x5d,
-	0x1b, 0xdd, 0x78, 0x6f, 0x20, 0xbb, 0x45, 0xbb, 0x2b, 0x8f, 0xfb, 0x5a, 0xea, 0xb8, 0xcf, 0x54, 0x54, 0xba,
-	0x1b, 0xc6, 0xe7, 0x7c, 0x45, 0xfd, 0x4a, 0xa9, 0xce, 0x14, 0x0a, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x22, 0x83, 0xd8, 0x9b,
+	0x57, 0x58, 0x4a, 0x53, 0x00, 0x01, 0x00, 0x00, 0x00, 0x11, 0x00, 0x00, 0x10, 0xe0, 0x0c, 0x94, 0x9f, 0x49,
+	0x96, 0xf9, 0xa8, 0x96, 0x98, 0xe4, 0xb5, 0xc5, 0x86, 0xde, 0x32, 0x24, 0x9c, 0x3b, 0x69, 0xb7, 0xba, 0xad,
+	0xb6, 0x4d, 0x22, 0x00, 0x73, 0xcc, 0x04, 0xac, 0xba, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x97,
+	0x00, 0x00, 0x42, 0x60, 0x3a, 0x94, 0xaf, 0x69, 0xc2, 0x4a, 0x1b, 0x03, 0x51, 0xf1, 0x6d, 0x04, 0x6a, 0xd6,
+	0xb4, 0x0e, 0xc0, 0xf1, 0xbe, 0xdd, 0x35, 0x08, 0xbd, 0x57, 0x19, 0xcd, 0x39, 0x37, 0x90, 0xc6, 0x01, 0x79,
+	0x00, 0x00, 0x00, 0x97, 0x00, 0x00, 0x04, 0x34, 0x00, 0x00, 0x48, 0xed, 0x45, 0xce, 0x9d, 0xfe, 0x72, 0x11,
+	0x24, 0x75, 0x44, 0xab, 0x6f, 0x42, 0x68, 0xeb, 0x8c, 0xb5, 0xb6, 0xf3, 0xd5, 0xae, 0x60, 0x2d, 0xc3, 0xb5,
+	0x14, 0x47, 0xb7, 0xea, 0xda, 0x99, 0xc2, 0x29, 0x00, 0x00, 0x04, 0xcb, 0x00, 0x00, 0x00, 0xa0, 0x00, 0x00,
+	0x41, 0x2f, 0x46, 0xea, 0x07, 0xe1, 0xb5, 0x94, 0xc1, 0xd5, 0xb1, 0x63, 0xd8, 0x75, 0xc5, 0x4f, 0xac, 0x43,
+	0xb5, 0xd8, 0x78, 0xf1, 0x09, 0xc4, 0xe9, 0xed, 0x58, 0x01, 0xbc, 0xe2, 0x03, 0x55, 0xb3, 0xc2, 0x00, 0x00,
+	0x05, 0x6b, 0x00, 0x00, 0x05, 0xe7, 0x00, 0x00, 0x2e, 0x81, 0x58, 0xb8, 0x86, 0x44, 0x03, 0x15, 0x05, 0xcf,
+	0x9a, 0xd0, 0x41, 0x42, 0xfd, 0x9b, 0x67, 0x49, 0x98, 0x3d, 0x0a, 0x42, 0xdb, 0x92, 0x55, 0x37, 0xe0, 0xa7,
+	0x1c, 0xeb, 0x66, 0x55, 0xd0, 0xef, 0x00, 0x00, 0x0b, 0x52, 0x00, 0x00, 0x04, 0x4e, 0x00, 0x00, 0x47, 0xf7,
+	0x6d, 0x5c, 0x0b, 0xf4, 0x29, 0x72, 0xeb, 0x98, 0x74, 0x1e, 0xb3, 0x16, 0x25, 0xc2, 0x10, 0x6c, 0xfc, 0xf0,
+	0x57, 0x81, 0xbc, 0xfe, 0xc8, 0x08, 0x33, 0x17, 0x26, 0xa7, 0x3a, 0x33, 0x9e, 0x39, 0x00, 0x00, 0x0f, 0xa0,
+	0x00, 0x00, 0x04, 0x35, 0x00, 0x00, 0x47, 0xaa, 0x71, 0xcb, 0x6a, 0xa4, 0x98, 0x1c, 0xbb, 0x25, 0x5d, 0x54,
+	0x6d, 0x28, 0x2b, 0xcf, 0x8c, 0xae, 0x78, 0xa6, 0x34, 0xc2, 0x01, 0x68, 0x11, 0xc9, 0x65, 0xb4, 0x03, 0xe3,
+	0xa1, 0x62, 0x7d, 0x72, 0x00, 0x00, 0x13, 0xd5, 0x00, 0x00, 0x05, 0x5d, 0x00, 0x00, 0x39, 0x9b, 0x72, 0xb0,
+	0xcf, 0x61, 0x0c, 0xc6, 0xb9, 0xfb, 0x07, 0x70, 0x17, 0x75, 0x9c, 0xe3, 0xee, 0x0c, 0xf4, 0x5a, 0x5d, 0x8d,
+	0x69, 0xa7, 0x6c, 0x17, 0x6e, 0xd8, 0x2e, 0x46, 0x44, 0xb7, 0x3f, 0x9a, 0x00, 0x00, 0x19, 0x32, 0x00, 0x00,
+	0x05, 0x13, 0x00, 0x00, 0x33, 0x15, 0x9a, 0x48, 0x35, 0xc2, 0xc9, 0xd0, 0xc0, 0xe2, 0x9a, 0x0d, 0xa2, 0x0e,
+	0x5b, 0x27, 0x48, 0xc6, 0x89, 0x1e, 0x0d, 0xce, 0xaa, 0xde, 0xed, 0x33, 0x5c, 0xe4, 0x13, 0x3a, 0x16, 0x45,
+	0x3a, 0x17, 0x00, 0x00, 0x1e, 0x45, 0x00, 0x00, 0x04, 0x2d, 0x00, 0x00, 0x42, 0xd2, 0xb5, 0xbd, 0x07, 0xe2,
+	0xae, 0x71, 0x2b, 0xbb, 0x7a, 0xfe, 0x1d, 0x97, 0xff, 0x05, 0x80, 0x16, 0x4c, 0x8c, 0xc9, 0xfa, 0x45, 0x51,
+	0x30, 0x3e, 0x05, 0xec, 0x57, 0xaf, 0x45, 0xc6, 0xee, 0x6d, 0x00, 0x00, 0x22, 0x72, 0x00, 0x00, 0x04, 0x40,
+	0x00, 0x00, 0x48, 0x26, 0xc1, 0x25, 0x63, 0xc3, 0x30, 0xb0, 0x4b, 0x77, 0x71, 0xf0, 0x99, 0xe2, 0x1a, 0xd4,
+	0x39, 0xcc, 0x14, 0x62, 0x96, 0x15, 0x33, 0x0b, 0x90, 0x2f, 0x57, 0xf4, 0x79, 0x32, 0x02, 0x88, 0x75, 0xf0,
+	0x00, 0x00, 0x26, 0xb2, 0x00, 0x00, 0x05, 0x7c, 0x00, 0x00, 0x2c, 0x2d, 0xc3, 0x27, 0x1f, 0x75, 0xde, 0x43,
+	0xae, 0xad, 0x71, 0xf5, 0xcd, 0xb5, 0x62, 0x99, 0x90, 0x62, 0x7c, 0x24, 0x15, 0xde, 0xc3, 0xd0, 0x5d, 0x67,
+	0xd6, 0xfc, 0xee, 0xc0, 0x68, 0xbc, 0xaa, 0xe5, 0x00, 0x00, 0x2c, 0x2e, 0x00, 0x00, 0x05, 0x71, 0x00, 0x00,
+	0x2c, 0x82, 0xc9, 0x0a, 0x79, 0x1d, 0xbc, 0x68, 0xe7, 0xc1, 0xc5, 0xee, 0x1e, 0x2a, 0xab, 0x0b, 0x3b, 0x1b,
+	0xc6, 0x08, 0x87, 0x14, 0xc2, 0x82, 0x5c, 0xe8, 0x61, 0xd3, 0xbe, 0xd5, 0x64, 0x90, 0x96, 0x56, 0x00, 0x00,
+	0x31, 0x9f, 0x00, 0x00, 0x04, 0x76, 0x00, 0x00, 0x43, 0x67, 0xcb, 0xcb, 0xf1, 0x54, 0x29, 0x6f, 0xed, 0xe6,
+	0x03, 0xe7, 0x8f, 0xaa, 0xa2, 0xd8, 0xab, 0x8f, 0xdd, 0x84, 0x56, 0x71, 0x64, 0x9e, 0xc0, 0xa0, 0x62, 0xf0,
+	0x00, 0xed, 0xb1, 0x9d, 0x5a, 0x95, 0x00, 0x00, 0x36, 0x15, 0x00, 0x00, 0x04, 0x43, 0x00, 0x00, 0x48, 0xbe,
+	0xd8, 0xda, 0xbb, 0xba, 0xaf, 0xfc, 0x50, 0xd0, 0x46, 0x36, 0xff, 0xb7, 0xe7, 0xed, 0x40, 0xf9, 0x9d, 0xd6,
+	0xed, 0xfc, 0x23, 0xc1, 0xd8, 0xac, 0xce, 0xa2, 0x1d, 0x94, 0x4e, 0x20, 0x64, 0x1e, 0x00, 0x00, 0x3a, 0x58,
+	0x00, 0x00, 0x00, 0xe4, 0x00, 0x00, 0x40, 0xe4, 0xda, 0x42, 0x61, 0xf1, 0xbf, 0x09, 0x18, 0xd2, 0x8f, 0x2b,
+	0xff, 0xa2, 0xec, 0x93, 0x43, 0x5d, 0x35, 0x07, 0xe2, 0xf1, 0x05, 0x3b, 0x61, 0xea, 0xa5, 0xf8, 0xd4, 0xd1,
+	0xde, 0x5f, 0x1d, 0xaa, 0x00, 0x00, 0x3b, 0x3c, 0x00, 0x00, 0x00, 0xdf, 0x00, 0x00, 0x40, 0xea, 0xf7, 0xba,
+	0xb1, 0x71, 0x84, 0x62, 0x6f, 0xa7, 0xf3, 0xeb, 0xe6, 0xc1, 0x57, 0xd4, 0x02, 0x68, 0x25, 0x84, 0x2d, 0x39,
+	0xbf, 0xae, 0x44, 0x4e, 0xf9, 0x45, 0xe6, 0x0e, 0xc7, 0xd3, 0xb0, 0xf1, 0x00, 0x00, 0x3c, 0x1b, 0x00, 0x00,
+	0x04, 0x40, 0x00, 0x00, 0x46, 0xe3, 0x78, 0xda, 0xec, 0x3b, 0x7f, 0x73, 0xe3, 0xb6, 0x72, 0x7f, 0x4b, 0x9f,
+	0x62, 0xa3, 0x79, 0xef, 0x95, 0x8a, 0x75, 0x92, 0xed, 0x73, 0x1c, 0xc7, 0xaa, 0xd2, 0xb9, 0x9c, 0x9d, 0xeb,
+	0xa5, 0x89, 0xed, 0xb1, 0x2f, 0x49, 0xdf, 0xdc, 0xbb, 0xc9, 0x83, 0xc4, 0x25, 0xc5, 0x98, 0x04, 0x58, 0x00,
+	0x94, 0xad, 0x5e, 0xfc, 0xdd, 0x3b, 0x0b, 0x80, 0x14, 0x48, 0x91, 0xb6, 0x7c, 0x69, 0xda, 0xce, 0xf4, 0xdd,
+	0x1f, 0x67, 0x91, 0xd8, 0x5d, 0xec, 0x2f, 0xec, 0x2e, 0x16, 0xe0, 0x64, 0x02, 0xaf, 0x45, 0xbe, 0x96, 0x49,
+	0xbc, 0xd4, 0x70, 0xb8, 0x7f, 0x70, 0x02, 0xef, 0x96, 0x08, 0x6f, 0x04, 0xbc, 0x2a, 0xf4, 0x52, 0x48, 0x35,
+	0x86, 0x57, 0x69, 0x0a, 0x66, 0x58, 0x81, 0x44, 0x85, 0x72, 0x85, 0xe1, 0xb8, 0x3f, 0x99, 0xc0, 0x8f, 0x0a,
+	0x41, 0x44, 0xa0, 0x97, 0x89, 0x02, 0x25, 0x0a, 0xb9, 0x40, 0x58, 0x88, 0x10, 0x21, 0x51, 0x10, 0x8b, 0x15,
+	0x4a, 0x8e, 0x21, 0xcc, 0xd7, 0xc0, 0xe0, 0x9b, 0x9b, 0xb3, 0x17, 0x4a, 0xaf, 0x53, 0x24, 0xac, 0x34, 0x59,
+	0x20, 0x57, 0x08, 0x7a, 0xc9, 0x34, 0x2c, 0x18, 0x87, 0x39, 0x42, 0x24, 0x0a, 0x1e, 0x42, 0xc2, 0x41, 0x2f,
+	0x11, 0xbe, 0x7f, 0xfb, 0xfa, 0xfc, 0xe2, 0xe6, 0x1c, 0xa2, 0x24, 0xc5, 0x71, 0xbf, 0x3f, 0x28, 0x14, 0x82,
+	0xd2, 0x32, 0x59, 0xe8, 0xc1, 0xb4, 0xdf, 0x0f, 0x82, 0x21, 0xcc, 0xbe, 0x86, 0x8f, 0xfd, 0xde, 0x42, 0x70,
+	0xa5, 0x01, 0xb9, 0x50, 0x6b, 0x05, 0x33, 0xa8, 0xde, 0x97, 0x03, 0x52, 0xc2, 0x0c, 0x38, 0xde, 0xc1, 0xb9,
+	0x94, 0x42, 0x06, 0x03, 0x2e, 0x34, 0x24, 0x59, 0x9e, 0x62, 0x86, 0x5c, 0x63, 0x38, 0x18, 0x4e, 0xfb, 0xbd,
+	0x1e, 0x4a, 0x39, 0x36, 0x4c, 0xcf, 0x60, 0x70, 0x7e, 0x71, 0x79, 0xf3, 0xd7, 0x9b, 0x01, 0xbd, 0x96, 0xa8,
+	0x0b, 0xc9, 0x89, 0xc6, 0xb4, 0xdf, 0x7b, 0x98, 0xf6, 0xfb, 0xbd, 0x24, 0x82, 0xe0, 0xb3, 0x38, 0x15, 0x73,
+	0x96, 0xbe, 0x5b, 0x26, 0x6a, 0x1c, 0xa9, 0xa1, 0x99, 0x2c, 0x45, 0x0d, 0xa2, 0xd0, 0x79, 0xa1, 0xbf, 0x29,
+	0x22, 0xa2, 0x62, 0xf0, 0x6b, 0x80, 0x30, 0x33, 0x90, 0x96, 0x2f, 0xc6, 0xb5, 0x3a, 0x85, 0x8f, 0x70, 0xf9,
+	0xcb, 0xcf, 0xd7, 0x97, 0x17, 0xdf, 0xff, 0xf5, 0x14, 0x5e, 0x1c, 0x8c, 0xe0, 0xf2, 0x97, 0xeb, 0xb3, 0x9f,
+	0xaf, 0xcb, 0xdf, 0xaf, 0xaf, 0xcf, 0x5f, 0xbd, 0x2b, 0x1f, 0xde, 0x5d, 0xff, 0x78, 0xf1, 0xba, 0x7c, 0x78,
+	0x75, 0x75, 0x75, 0x7e, 0x71, 0x56, 0x3e, 0x9d, 0xff, 0xfb, 0xeb, 0xef, 0xcb, 0xdf, 0x67, 0x6f, 0xaf, 0xcf,
+	0x5f, 0xbf, 0xbb, 0xbc, 0x36, 0x04, 0xe1, 0x61, 0x04, 0x93, 0x09, 0x14, 0xbc, 0x50, 0x18, 0xd2, 0xd4, 0x77,
+	0x32, 0xd1, 0x78, 0xb3, 0xe6, 0x8b, 0x20, 0x0a, 0x47, 0x30, 0x2f, 0x22, 0xcb, 0x7c, 0xaf, 0xb7, 0x61, 0x7d,
+	0x6f, 0x06, 0x21, 0x92, 0x2a, 0xe4, 0xd8, 0xfe, 0x0d, 0x08, 0x6c, 0xda, 0xef, 0x95, 0x9c, 0x03, 0x4f, 0x61,
+	0xb6, 0x91, 0x75, 0x9c, 0x32, 0xa5, 0xdf, 0xf2, 0x10, 0xef, 0x2f, 0xa3, 0x60, 0xf0, 0x37, 0x3e, 0x70, 0xb0,
+	0xa4, 0x28, 0x9e, 0xc2, 0x67, 0x33, 0x78, 0x71, 0x50, 0xce, 0x62, 0x08, 0x88, 0x14, 0xc7, 0xa9, 0x88, 0x83,
+	0x0d, 0x05, 0x55, 0xcc, 0xc9, 0xb0, 0x3c, 0x0e, 0xf6, 0x47, 0xc0, 0xd3, 0xa1, 0xa3, 0xd0, 0xf3, 0xf5, 0xd9,
+	0x06, 0xcd, 0x53, 0xd8, 0x83, 0x03, 0x07, 0xfd, 0x60, 0xfe, 0x77, 0x06, 0x9b, 0x13, 0x5f, 0xc8, 0x63, 0xbd,
+	0x34, 0x83, 0x0f, 0xa3, 0x4a, 0xf8, 0x52, 0xf0, 0x11, 0x88, 0x28, 0x52, 0xa8, 0x47, 0x60, 0xe1, 0x46, 0x90,
+	0x0b, 0x95, 0xe8, 0x44, 0xf0, 0x11, 0x2c, 0x58, 0x9a, 0xce, 0xd9, 0xe2, 0xb6, 0x64, 0x9b, 0x44, 0xb1, 0xd0,
+	0xf0, 0xd9, 0x6c, 0x06, 0xfb, 0xf0, 0xdb, 0x6f, 0x0e, 0xcb, 0x3c, 0x6f, 0x26, 0xa3, 0x81, 0x92, 0x8c, 0x19,
+	0xe2, 0x45, 0x9a, 0x6e, 0x84, 0x77, 0x64, 0x03, 0xeb, 0xab, 0x41, 0x25, 0xa7, 0x65, 0xda, 0x17, 0xc3, 0x29,
+	0x1a, 0x66, 0x66, 0x55, 0x8d, 0xb7, 0xcd, 0x36, 0xed, 0xd7, 0x28, 0xd2, 0x3c, 0x23, 0xe0, 0x43, 0x4f, 0xda,
+	0xc5, 0x32, 0x13, 0x61, 0x90, 0x33, 0x12, 0x2d, 0x13, 0x21, 0xd6, 0xc4, 0x82, 0x16, 0x5e, 0xa0, 0xc4, 0x13,
+	0x77, 0xdc, 0xe1, 0x15, 0x49, 0x38, 0x82, 0x98, 0xfe, 0xdb, 0x11, 0x37, 0x15, 0xca, 0x6a, 0x78, 0x37, 0xf8,
+	0xc8, 0x32, 0x19, 0x85, 0xcf, 0x63, 0x31, 0xb2, 0x3c, 0x46, 0xe1, 0xf3, 0x39, 0x8c, 0x94, 0x66, 0xfa, 0x39,
+	0x1c, 0xaa, 0x52, 0xeb, 0xad, 0xf0, 0xc6, 0xbe, 0x15, 0xac, 0x96, 0x05, 0x5f, 0x30, 0xe7, 0x63, 0xa5, 0x5b,
+	0xed, 0x36, 0x4f, 0xfa, 0x7b, 0xd4, 0x9e, 0x26, 0xfc, 0xd6, 0xa1, 0xd2, 0xcf, 0x9d, 0xd1, 0x8c, 0x2e, 0x2c,
+	0xde, 0x6e, 0x18, 0xd9, 0x6d, 0x98, 0x48, 0x87, 0x91, 0xa3, 0xcc, 0x76, 0xc5, 0x13, 0x39, 0x96, 0xb2, 0x45,
+	0x29, 0x8b, 0xd5, 0xf3, 0xcc, 0x2d, 0x91, 0x85, 0xa5, 0xe3, 0x47, 0x28, 0x77, 0x5c, 0xb9, 0x4f, 0x10, 0xdc,
+	0x08, 0xb2, 0x3b, 0x8e, 0xa7, 0xe7, 0x5d, 0x91, 0x38, 0xcb, 0x30, 0x88, 0xa4, 0xc8, 0x46, 0xa0, 0xc5, 0xce,
+	0x68, 0xd9, 0x73, 0xb9, 0x7b, 0xb6, 0x25, 0xd5, 0x3a, 0xfb, 0x44, 0xaf, 0xa9, 0x9c, 0xdc, 0xa1, 0x3e, 0xcb,
+	0xcd, 0x0b, 0xfe, 0x6c, 0x1d, 0x16, 0x3a, 0xc9, 0x50, 0x39, 0x14, 0x46, 0x0f, 0x23, 0xc8, 0xec, 0x9f, 0x9d,
+	0x08, 0x3c, 0x50, 0xe6, 0x6e, 0x49, 0xdc, 0xb9, 0x14, 0x0b, 0x54, 0x2e, 0x7b, 0x6f, 0xbf, 0x2f, 0x93, 0x75,
+	0x8c, 0xba, 0x48, 0xc2, 0x80, 0xa6, 0x70, 0xb9, 0xe5, 0xc5, 0x41, 0xc9, 0x5a, 0x8c, 0x3a, 0x7e, 0x64, 0x0c,
+	0x1f, 0x43, 0xc4, 0xc7, 0x30, 0x63, 0x29, 0x8a, 0x5c, 0x99, 0x61, 0xbd, 0x94, 0xe2, 0x0e, 0x4a, 0xa1, 0x4a,
+	0x98, 0x3c, 0x09, 0x4d, 0xc2, 0x37, 0xbf, 0xfd, 0x87, 0x22, 0x63, 0xea, 0xf6, 0x11, 0xc4, 0xc5, 0x5d, 0xf8,
+	0xd8, 0xe8, 0x92, 0x1c, 0xaf, 0x73, 0xfc, 0xa1, 0x43, 0x93, 0x4c, 0x2f, 0xb7, 0xd5, 0xc8, 0xf4, 0xb2, 0xd4,
+	0xa1, 0x44, 0x25, 0xd2, 0x15, 0x06, 0xe3, 0xb1, 0x79, 0x7d, 0x83, 0x31, 0x95, 0x5e, 0xaa, 0x4c, 0x8c, 0x4e,
+	0x03, 0xfe, 0xd0, 0xf8, 0x57, 0x91, 0xf0, 0x60, 0x30, 0x71, 0xc5, 0xc4, 0x43, 0xf7, 0xe4, 0x0b, 0xb9, 0xce,
+	0xb5, 0xb0, 0xa4, 0x2c, 0xd3, 0x5e, 0xa9, 0xb7, 0x05, 0x07, 0x89, 0x02, 0xaa, 0xff, 0xd8, 0x8a, 0x25, 0x29,
+	0x9b, 0xa7, 0x48, 0xc1, 0x23, 0x5d, 0x47, 0x09, 0x95, 0xb6, 0xf8, 0x1f, 0x45, 0x22, 0x31, 0x84, 0xc0, 0xc2,
+	0x8e, 0x63, 0xd4, 0xd7, 0x8c, 0x87, 0x22, 0xfb, 0x89, 0xa5, 0x05, 0x2a, 0x10, 0x3c, 0x5d, 0x0f, 0x0d, 0x47,
+	0xad, 0x5a, 0x40, 0x19, 0x09, 0x99, 0x31, 0xbe, 0xc0, 0xa7, 0xb9, 0xf1, 0x80, 0x77, 0x63, 0xc9, 0x43, 0x18,
+	0x73, 0x71, 0xf7, 0x04, 0x2f, 0xef, 0xf0, 0x5e, 0x9f, 0x73, 0x53, 0xc4, 0x3d, 0xcd, 0x8b, 0x07, 0xbc, 0x0b,
+	0x2f, 0x8f, 0x4e, 0x7a, 0x86, 0xcf, 0x98, 0xf4, 0x0c, 0x9f, 0x3f, 0x69, 0x59, 0xec, 0x5b, 0x4c, 0x5b, 0xd7,
+	0x7b, 0x02, 0x04, 0x83, 0x42, 0x47, 0x2f, 0x4e, 0x0c, 0xb8, 0x05, 0x0d, 0xb1, 0x09, 0x7a, 0x86, 0x4d, 0xd0,
+	0xbe, 0xef, 0xb8, 0x6f, 0x04, 0xcc, 0x60, 0x91, 0x32, 0xa5, 0x36, 0x9b, 0x08, 0x59, 0x2c, 0xb4, 0x30, 0x0b,
+	0xc3, 0x44, 0x40, 0x02, 0x63, 0x32, 0x5e, 0xc1, 0x0c, 0xde, 0x0f, 0x7e, 0x55, 0x83, 0x0f, 0xd3, 0xea, 0x35,
+	0x72, 0x7a, 0xfb, 0xf1, 0xc1, 0x7b, 0x73, 0x9f, 0x68, 0xda, 0x96, 0xd0, 0xa4, 0xd5, 0xd6, 0xc4, 0xd6, 0x94,
+	0xf4, 0xca, 0x56, 0x94, 0x5b, 0x05, 0xf2, 0x1d, 0x93, 0x3c, 0x18, 0x18, 0x5c, 0x82, 0x3a, 0x1d, 0x8c, 0xcc,
+	0x5f, 0xbf, 0xd6, 0xf5, 0xe6, 0xf8, 0x85, 0x00, 0xaf, 0xa4, 0xc8, 0x12, 0x85, 0x4e, 0x52, 0xf7, 0x14, 0x04,
+	0x6e, 0xf1, 0x79, 0x53, 0x5b, 0x14, 0xf7, 0xfe, 0xbc, 0x86, 0xe9, 0x5e, 0xda, 0x45, 0x37, 0xf4, 0x26, 0xc8,
+	0x91, 0x87, 0x09, 0x8f, 0xcf, 0x57, 0xc8, 0x49, 0x1a, 0xaa, 0x7b, 0xbc, 0x51, 0xb5, 0x58, 0x62, 0x58, 0xa4,
+	0x18, 0xbe, 0x4b, 0x32, 0x14, 0x85, 0x56, 0x8e, 0x89, 0x1f, 0x58, 0x1e, 0xf8, 0x54, 0x38, 0xde, 0xeb, 0xd7,
+	0x2e, 0x50, 0x3b, 0xd0, 0xb7, 0x67, 0x30, 0x83, 0x03, 0xb2, 0x41, 0x59, 0xf5, 0x2a, 0xd4, 0x6f, 0xb9, 0x3e,
+	0x3e, 0x22, 0xa5, 0xb1, 0x30, 0x94, 0x23, 0x58, 0x35, 0x99, 0xcf, 0x30, 0x1b, 0x2b, 0xd4, 0x3f, 0x26, 0x5c,
+	0xbf, 0x3c, 0x34, 0x40, 0xb0, 0x07, 0xfb, 0x23, 0x58, 0x8d, 0x40, 0xcb, 0xa2, 0xd4, 0x52, 0x37, 0xe8, 0xd1,
+	0x08, 0x7e, 0x60, 0x7a, 0x39, 0x8e, 0x52, 0x21, 0x64, 0xb0, 0x82, 0x09, 0x1c, 0x1d, 0x7e, 0x75, 0xf4, 0xd5,
+	0xf1, 0x97, 0x87, 0x5f, 0x1d, 0x0f, 0x7d, 0x1a, 0x0f, 0x5b, 0x7c, 0xbd, 0x3c, 0xfc, 0xbd, 0x7c, 0xf9, 0x34,
+	0xe3, 0x86, 0xac, 0x1e, 0x41, 0x0b, 0x90, 0x8a, 0xbb, 0x72, 0x13, 0x40, 0xc4, 0xe3, 0x16, 0xe2, 0x9e, 0xc4,
+	0x16, 0x67, 0x99, 0xc4, 0xcb, 0x06, 0xd2, 0xdb, 0x86, 0xf4, 0x1e, 0x8e, 0x8b, 0xbe, 0x34, 0xd1, 0x9e, 0x45,
+	0xfd, 0xdc, 0xd3, 0xc6, 0x16, 0xc7, 0xa9, 0x60, 0xa1, 0x89, 0x87, 0x9d, 0x2c, 0x47, 0x8d, 0xb9, 0xbf, 0x4d,
+	0x05, 0xd3, 0xc7, 0x47, 0x4e, 0x67, 0xde, 0xcc, 0xb4, 0x04, 0x22, 0x98, 0xd5, 0xfc, 0xdf, 0x71, 0x53, 0xf0,
+	0x10, 0xa3, 0x84, 0x63, 0xe8, 0x6f, 0x8a, 0x4c, 0xc0, 0x49, 0xd4, 0x05, 0xbb, 0x08, 0xa2, 0x61, 0x13, 0x23,
+	0x2a, 0x21, 0x3d, 0x4e, 0x92, 0xb0, 0x5b, 0x77, 0x6d, 0x3a, 0xb0, 0x4e, 0xba, 0x32, 0xd1, 0xfe, 0x7d, 0x12,
+	0x7e, 0xd8, 0x76, 0x01, 0x2d, 0x24, 0xd6, 0xa4, 0xaf, 0x3b, 0x81, 0x85, 0xe2, 0x8c, 0xff, 0x2b, 0x32, 0x9a,
+	0x7b, 0xff, 0xfe, 0xcb, 0x6f, 0xbf, 0x3d, 0xd9, 0xdf, 0xdf, 0xdf, 0x9f, 0xf6, 0x2b, 0x11, 0xf4, 0x3a, 0x47,
+	0x11, 0xc1, 0xca, 0x48, 0x3e, 0xe0, 0x45, 0x36, 0x47, 0x39, 0x80, 0xbf, 0xfc, 0x05, 0x56, 0x8d, 0x58, 0x40,
+	0xc0, 0x56, 0xdc, 0xd5, 0x46, 0xdc, 0xc7, 0xbd, 0xda, 0x4d, 0x5d, 0x13, 0xae, 0x13, 0x65, 0xd4, 0xf0, 0x9e,
+	0xfa, 0x46, 0xd4, 0x29, 0xbd, 0x86, 0x5c, 0x37, 0x65, 0x7d, 0xb5, 0x35, 0x76, 0xb1, 0x95, 0xb8, 0x56, 0xce,
+	0xca, 0xa0, 0x95, 0x20, 0xdd, 0x74, 0xf7, 0x9f, 0xa4, 0x9b, 0xa2, 0x6f, 0xdc, 0x5f, 0x92, 0x50, 0x91, 0x75,
+	0x83, 0x95, 0xe7, 0x58, 0x49, 0xd8, 0x31, 0x6f, 0x0d, 0xef, 0x4a, 0x88, 0x74, 0x9c, 0x8b, 0x32, 0x4a, 0x3d,
+	0x81, 0xea, 0xe3, 0x5a, 0x2f, 0xf1, 0x9b, 0x0c, 0x75, 0x8d, 0x79, 0x6e, 0x04, 0x33, 0x58, 0x4d, 0xfd, 0xa1,
+	0x58, 0x5c, 0x63, 0xf4, 0x5a, 0x14, 0x5c, 0x97, 0xe3, 0xfb, 0xb5, 0x71, 0x92, 0x47, 0x91, 0x3c, 0x23, 0x48,
+	0xc2, 0x5a, 0x7b, 0xa3, 0x15, 0x7f, 0x6f, 0x6f, 0x5a, 0x69, 0x85, 0x9c, 0xeb, 0xdb, 0x94, 0xc5, 0x1b, 0x9a,
+	0xea, 0x2e, 0xd1, 0x8b, 0xe5, 0xc6, 0xed, 0xbc, 0x76, 0x84, 0x42, 0x18, 0x88, 0xf9, 0xaf, 0xb8, 0xd0, 0x83,
+	0xd3, 0x52, 0x42, 0x63, 0xb1, 0xad, 0xd6, 0x45, 0xaf, 0xe7, 0x11, 0x3e, 0x28, 0xdd, 0xc5, 0xc9, 0xdb, 0x9b,
+	0x4b, 0x64, 0xb7, 0x53, 0x9f, 0xaa, 0x6d, 0xd0, 0x54, 0x54, 0x3d, 0xe4, 0xc3, 0x69, 0x37, 0xd2, 0x3a, 0x9b,
+	0x8b, 0xb4, 0x0d, 0xe9, 0x65, 0x37, 0x52, 0x54, 0xf0, 0x05, 0xed, 0xfd, 0xda, 0xd0, 0x8e, 0x5a, 0xd0, 0x1e,
+	0xfa, 0xbb, 0x2e, 0x22, 0xf8, 0xad, 0xd2, 0xe6, 0x2e, 0x69, 0x85, 0x6c, 0xd5, 0x15, 0xe6, 0x29, 0x68, 0xde,
+	0x50, 0xc3, 0xb3, 0x33, 0x68, 0x32, 0x29, 0xd9, 0x1a, 0x66, 0x55, 0x42, 0xa8, 0xe2, 0x7b, 0x2d, 0xb2, 0xa7,
+	0xc8, 0x5b, 0x60, 0x4e, 0xea, 0x51, 0x8c, 0x12, 0x2f, 0xf1, 0x75, 0xf2, 0x8a, 0x68, 0x06, 0xce, 0xa5, 0xb8,
+	0xd2, 0x63, 0xbc, 0xcf, 0x85, 0xd4, 0x96, 0xf9, 0x72, 0x2f, 0x6d, 0x26, 0x36, 0xdb, 0xb8, 0x47, 0xd8, 0xbe,
+	0x8c, 0x5c, 0x09, 0xfc, 0x47, 0xb2, 0xef, 0x28, 0xb9, 0xd2, 0xc1, 0x32, 0x5f, 0xb1, 0xd5, 0x8b, 0x84, 0x84,
+	0xc0, 0xac, 0x7b, 0xe3, 0xda, 0x90, 0xc0, 0x3f, 0x13, 0xbd, 0x29, 0x24, 0x7b, 0x7b, 0x95, 0x9b, 0xb2, 0xf7,
+	0x09, 0xad, 0xa6, 0x2a, 0x47, 0x05, 0x96, 0xaf, 0x3d, 0x48, 0xe0, 0xf3, 0x6a, 0x9e, 0x5a, 0xa3, 0x90, 0xb5,
+	0x0b, 0x6d, 0xdc, 0xb7, 0x53, 0x5a, 0x65, 0x38, 0xff, 0x6f, 0x31, 0x56, 0xa3, 0xd5, 0x4a, 0x92, 0x9f, 0x31,
+	0xcd, 0x7e, 0x4a, 0xf0, 0xee, 0x29, 0xcb, 0x29, 0xeb, 0x75, 0xa4, 0xa2, 0x6d, 0xd3, 0x69, 0x54, 0xa6, 0xd2,
+	0x3a, 0x37, 0x88, 0x46, 0x92, 0x11, 0xcc, 0xb7, 0x8a, 0xc0, 0x1a, 0x71, 0xc2, 0xb1, 0xf0, 0xfb, 0x41, 0x5b,
+	0x62, 0xec, 0x00, 0xb6, 0x94, 0xb7, 0x39, 0x48, 0x32, 0xbc, 0x94, 0x49, 0x9c, 0x90, 0x16, 0xce, 0x98, 0x36,
+	0xfb, 0x96, 0x60, 0x08, 0x2f, 0xa0, 0xb1, 0x97, 0xf1, 0x2b, 0xc4, 0x24, 0x23, 0x8a, 0x97, 0x26, 0x26, 0x95,
+	0xbb, 0xc7, 0xde, 0x2f, 0xb1, 0xa0, 0xd9, 0x4e, 0x2b, 0x2b, 0x87, 0xe1, 0xa9, 0x27, 0x0f, 0x83, 0x3d, 0x98,
+	0x8f, 0x36, 0x2d, 0x56, 0xcb, 0xd5, 0x69, 0x43, 0x05, 0x16, 0xc0, 0x6e, 0x73, 0x7b, 0xb1, 0xf8, 0x55, 0x55,
+	0xe4, 0x26, 0x13, 0x78, 0x23, 0xfe, 0x49, 0xc1, 0xcd, 0x15, 0x84, 0x02, 0xed, 0x5e, 0x64, 0xb1, 0x64, 0x3c,
+	0x46, 0x60, 0x0a, 0x52, 0xc1, 0x63, 0xfa, 0xcb, 0x05, 0x9d, 0x85, 0x94, 0xe7, 0x1a, 0xb2, 0xe0, 0x3c, 0xe1,
+	0xf1, 0x18, 0x6e, 0x44, 0x86, 0x20, 0x72, 0x94, 0x8c, 0x82, 0x90, 0x82, 0x00, 0xc7, 0xf1, 0xd8, 0x34, 0x23,
+	0xd4, 0x88, 0x6c, 0xaf, 0x51, 0x2a, 0x60, 0x3c, 0x04, 0x65, 0x7f, 0x0f, 0xab, 0x39, 0x33, 0xb6, 0x06, 0x6a,
+	0x2e, 0x2e, 0xa5, 0xe0, 0xa2, 0x50, 0xe9, 0x1a, 0xb4, 0x4c, 0xe2, 0x18, 0x25, 0x30, 0x9a, 0x09, 0x4d, 0xa5,
+	0xbd, 0x64, 0x3c, 0x4c, 0x51, 0x8e, 0x81, 0xb6, 0x26, 0x90, 0xb1, 0x5b, 0x54, 0x15, 0x1b, 0x31, 0x6a, 0xc0,
+	0x7b, 0x5c, 0x14, 0x1a, 0xab, 0x33, 0x93, 0x2c, 0x09, 0xc3, 0xd4, 0x9d, 0xc6, 0x20, 0x58, 0x6d, 0x62, 0x58,
+	0xcd, 0x59, 0x06, 0xcb, 0x31, 0xbc, 0x82, 0x58, 0x48, 0x51, 0xe8, 0x84, 0xa3, 0x39, 0x7c, 0x71, 0x59, 0x42,
+	0x0b, 0x60, 0x66, 0xf9, 0x29, 0xcd, 0x16, 0xb7, 0x90, 0x58, 0x3a, 0x8b, 0x42, 0x4a, 0xe2, 0xc6, 0xbd, 0x54,
+	0xa0, 0x85, 0x00, 0x95, 0xb1, 0x34, 0x85, 0x40, 0x21, 0x42, 0x26, 0x24, 0xda, 0xb1, 0x72, 0x82, 0xe1, 0xb8,
+	0x9a, 0xd3, 0x70, 0x6e, 0xf5, 0xa9, 0x0c, 0xb5, 0x9b, 0xab, 0x11, 0xe8, 0x65, 0xa1, 0xe0, 0x0e, 0x61, 0xc9,
+	0x56, 0x48, 0x93, 0x16, 0x79, 0xc8, 0x34, 0xba, 0x61, 0x28, 0x94, 0x3d, 0x31, 0xf2, 0x65, 0xd8, 0xf0, 0xde,
+	0xaf, 0x89, 0x03, 0x77, 0x4c, 0x65, 0xb4, 0x89, 0xb1, 0xbb, 0x29, 0x13, 0x8f, 0x9d, 0x92, 0x07, 0xb2, 0xe0,
+	0xe4, 0x85, 0xe3, 0x12, 0x64, 0x70, 0x0a, 0x81, 0xca, 0xbd, 0x45, 0xd0, 0xeb, 0xa9, 0x1c, 0xbe, 0xfe, 0xfa,
+	0xeb, 0x4d, 0x02, 0x76, 0xce, 0xeb, 0x8e, 0x82, 0xb6, 0x4b, 0x68, 0x95, 0xd3, 0x1a, 0x6e, 0xab, 0xaf, 0x68,
+	0x0b, 0x86, 0xa6, 0x3e, 0x90, 0x05, 0x96, 0x23, 0x21, 0xa6, 0xa8, 0xd1, 0x5b, 0x3f, 0xad, 0x03, 0xb6, 0x54,
+	0x68, 0x1d, 0xf2, 0x52, 0x7d, 0x3b, 0xcd, 0xb0, 0xeb, 0x3d, 0x95, 0x36, 0x5b, 0x0c, 0x06, 0xde, 0x26, 0x92,
+	0x16, 0xc3, 0xb6, 0x2a, 0x7f, 0x76, 0x87, 0x24, 0x50, 0x24, 0x5c, 0xe7, 0x5a, 0x8e, 0x20, 0x87, 0x82, 0x2b,
+	0x16, 0xe1, 0xf8, 0x4a, 0x24, 0x5c, 0x53, 0xe4, 0xe1, 0xdd, 0x6a, 0x36, 0xe8, 0xbb, 0xeb, 0x39, 0x0a, 0xfd,
+	0x10, 0x69, 0x95, 0x3b, 0xac, 0x83, 0xe4, 0x5b, 0x10, 0x07, 0xc7, 0x0d, 0x10, 0xde, 0x6d, 0xaa, 0xc3, 0xa3,
+	0x86, 0xad, 0xa2, 0xe6, 0x69, 0xca, 0xf3, 0xd2, 0x65, 0x4e, 0xa7, 0x2c, 0x9d, 0x0a, 0x94, 0xa8, 0x50, 0xff,
+	0x80, 0x99, 0x90, 0xeb, 0x2a, 0x8a, 0x37, 0xb5, 0xd4, 0x02, 0xb3, 0x8b, 0xbe, 0x4a, 0xf9, 0x5c, 0x7a, 0xdc,
+	0x2d, 0x49, 0x74, 0x32, 0xca, 0x19, 0x17, 0xc4, 0xcd, 0x41, 0x30, 0x24, 0x5b, 0x1e, 0x1f, 0x35, 0x98, 0xac,
+	0xc6, 0x77, 0x61, 0x4d, 0xd5, 0xed, 0x37, 0x82, 0xc0, 0x8b, 0xfd, 0x7b, 0xdb, 0xe1, 0x7e, 0x08, 0x9f, 0xc3,
+	0xc1, 0xbe, 0xf9, 0xf7, 0x88, 0x2b, 0xa6, 0x29, 0x51, 0x09, 0x86, 0x14, 0x62, 0x16, 0x96, 0xc9, 0x11, 0x70,
+	0xf7, 0xbb, 0xcd, 0xf9, 0x2c, 0xc2, 0xee, 0xbe, 0x97, 0x11, 0xad, 0x19, 0x94, 0x39, 0x17, 0x87, 0xe4, 0x3a,
+	0xef, 0xcc, 0x9c, 0x9d, 0x92, 0x19, 0x9c, 0x89, 0xe1, 0xbe, 0x75, 0x77, 0xe5, 0x79, 0xde, 0xc1, 0xf1, 0x08,
+	0x02, 0x03, 0xff, 0x67, 0x0b, 0xbf, 0x91, 0xba, 0xee, 0x92, 0x5b, 0xb2, 0x97, 0xdd, 0x14, 0xd7, 0x21, 0x31,
+	0x3d, 0x97, 0x20, 0xc4, 0x94, 0xad, 0xad, 0x1a, 0x86, 0x56, 0x03, 0x0d, 0x05, 0xb4, 0x61, 0xed, 0xae, 0x0c,
+	0x6f, 0x67, 0xd3, 0xda, 0xa4, 0xa9, 0x09, 0xdb, 0x0e, 0xb2, 0xb7, 0x57, 0x07, 0xda, 0x6a, 0x0a, 0x99, 0x3d,
+	0x0d, 0x15, 0xc9, 0xca, 0xea, 0x59, 0x14, 0x3a, 0x70, 0x18, 0xbd, 0xa0, 0xc6, 0xa4, 0xd7, 0xa3, 0x2a, 0x7c,
+	0x7b, 0xf4, 0x7a, 0x77, 0xcb, 0x24, 0x45, 0x08, 0xba, 0x66, 0x58, 0x32, 0x15, 0x24, 0xa1, 0xb7, 0x5b, 0x76,
+	0x6a, 0x15, 0x12, 0x14, 0x65, 0x6a, 0x89, 0x4c, 0x09, 0x4e, 0x29, 0x34, 0x62, 0x49, 0x8a, 0x21, 0xe5, 0x1e,
+	0x89, 0x71, 0xa2, 0x34, 0x4a, 0x93, 0x6e, 0xb4, 0xa5, 0x64, 0xd3, 0xef, 0x08, 0x52, 0x11, 0x9b, 0xec, 0xad,
+	0xe5, 0x1a, 0x58, 0xcc, 0x12, 0x5e, 0x23, 0x1b, 0x68, 0xa4, 0xec, 0xc4, 0xe4, 0x1a, 0xee, 0x84, 0xbc, 0x65,
+	0xd2, 0x5c, 0x5f, 0xa0, 0xc9, 0x96, 0x5a, 0xe7, 0xea, 0x74, 0x32, 0x89, 0x13, 0xbd, 0x2c, 0xe6, 0xe3, 0x85,
+	0xc8, 0x26, 0xb1, 0x48, 0x19, 0x8f, 0x27, 0xb1, 0x98, 0x24, 0x4a, 0x15, 0xa8, 0x26, 0x87, 0x27, 0x5f, 0x7d,
+	0xf9, 0xc5, 0x70, 0x43, 0xb0, 0xde, 0xfa, 0x6b, 0x33, 0xe7, 0x29, 0x64, 0x89, 0xa2, 0xdc, 0x58, 0xe3, 0x72,
+	0xe0, 0xa9, 0xa7, 0x53, 0x6f, 0xe5, 0x56, 0xad, 0xac, 0x80, 0xec, 0x39, 0x43, 0x2d, 0xec, 0x96, 0x03, 0xc3,
+	0x5d, 0x3c, 0x3b, 0x09, 0x9f, 0x70, 0xe2, 0x45, 0x8a, 0x4c, 0xd6, 0x3c, 0x38, 0x09, 0xdb, 0x57, 0xee, 0x16,
+	0xe4, 0xa7, 0x78, 0xed, 0xd3, 0x49, 0xda, 0x9f, 0xa6, 0xd3, 0x7f, 0x62, 0xe3, 0xa1, 0x0d, 0x0d, 0xb4, 0xc0,
+	0xd9, 0x5c, 0x1b, 0x24, 0x61, 0xa7, 0xfc, 0xd5, 0xe1, 0x01, 0x45, 0xea, 0x40, 0xc2, 0xfb, 0x0f, 0xf3, 0xb5,
+	0xc6, 0xa6, 0xec, 0x35, 0xa8, 0x9d, 0xe4, 0x6e, 0x3d, 0x9a, 0x08, 0xaa, 0x9d, 0x5a, 0x69, 0xcc, 0x4e, 0xbe,
+	0xa2, 0x84, 0xb3, 0x34, 0xf9, 0x4f, 0xbc, 0xc6, 0x28, 0x58, 0x81, 0xc4, 0xa8, 0x64, 0x49, 0xad, 0x15, 0x95,
+	0xad, 0x93, 0x5f, 0xd5, 0xd8, 0x83, 0xf9, 0x1d, 0xb6, 0x70, 0x7b, 0xe3, 0xee, 0x8a, 0xa9, 0xd9, 0xc7, 0x78,
+	0xf1, 0x62, 0xea, 0x75, 0x21, 0x3a, 0x5a, 0x25, 0xb5, 0x0e, 0x59, 0x39, 0xf7, 0xaa, 0xd1, 0x96, 0xa9, 0x9a,
+	0x77, 0x1d, 0xed, 0x98, 0xaa, 0x7d, 0x5d, 0xef, 0xb9, 0x38, 0xab, 0xae, 0x86, 0x5b, 0x63, 0xb6, 0x4f, 0x54,
+	0xa8, 0xa5, 0x67, 0xf2, 0xde, 0x43, 0x87, 0x8a, 0x6d, 0xf3, 0xe3, 0x27, 0x96, 0x06, 0x66, 0x52, 0xf7, 0x3c,
+	0x24, 0x65, 0x6f, 0xeb, 0xba, 0x02, 0xde, 0x29, 0xd3, 0x56, 0xfd, 0xc7, 0x4d, 0x75, 0xb3, 0xd9, 0xaf, 0x3e,
+	0x69, 0x7b, 0xc3, 0xce, 0x1b, 0x6a, 0x2c, 0x11, 0x2f, 0x54, 0xd9, 0x3d, 0xca, 0x5a, 0x09, 0xbe, 0xbb, 0x0f,
+	0x50, 0xdc, 0x49, 0x69, 0xe3, 0x76, 0x8d, 0x51, 0x8a, 0x0b, 0x6d, 0xd6, 0xd3, 0x66, 0x37, 0x5e, 0x06, 0x9a,
+	0x2d, 0x9e, 0x0f, 0x8e, 0x37, 0x4b, 0x4e, 0xe5, 0x9b, 0xfe, 0x9c, 0x5f, 0xd5, 0xc4, 0xa8, 0x55, 0x1e, 0x0c,
+	0x69, 0x5e, 0xda, 0xff, 0x4f, 0x26, 0xa0, 0x10, 0x61, 0x21, 0x32, 0x3a, 0x30, 0x04, 0x36, 0x17, 0x2b, 0xec,
+	0xd0, 0xd2, 0xcb, 0xc3, 0x91, 0xe3, 0xec, 0x71, 0xcd, 0xdc, 0x6c, 0x6b, 0x66, 0x04, 0xf7, 0x1d, 0x6b, 0xa4,
+	0xc4, 0xd8, 0x45, 0x39, 0xa5, 0x36, 0xd4, 0xce, 0xda, 0x18, 0x41, 0x03, 0xee, 0xe5, 0xe1, 0x13, 0x66, 0x3d,
+	0x73, 0xbe, 0xdb, 0xb0, 0x6c, 0x07, 0xe3, 0x16, 0xfa, 0x39, 0xbc, 0xdb, 0xb5, 0x71, 0x25, 0x69, 0xaf, 0xab,
+	0xd7, 0xcf, 0x34, 0x6a, 0x3b, 0xcb, 0xe6, 0xd2, 0x58, 0xc9, 0x71, 0x42, 0xc9, 0xe1, 0x31, 0x47, 0x34, 0xd0,
+	0x9f, 0xba, 0x48, 0x9e, 0xf0, 0xc7, 0xad, 0xfd, 0x45, 0x2b, 0xe7, 0xa5, 0xc9, 0xb7, 0xf9, 0x7e, 0xda, 0x4d,
+	0x76, 0xe6, 0xfe, 0x09, 0x5f, 0xd9, 0xe2, 0x74, 0xcb, 0x53, 0x0e, 0x8f, 0x9e, 0x50, 0x3b, 0x15, 0x6e, 0x25,
+	0xf7, 0x59, 0xe5, 0xe7, 0x4c, 0xc6, 0x0a, 0xde, 0x7f, 0x20, 0x29, 0x20, 0x30, 0x63, 0x73, 0x21, 0xd2, 0x2e,
+	0x89, 0x88, 0xc6, 0x2e, 0xd2, 0x50, 0xdd, 0xd4, 0x12, 0xab, 0xb7, 0x04, 0x9b, 0xd6, 0x61, 0xb2, 0x46, 0x08,
+	0x59, 0x3d, 0x1e, 0x32, 0xaa, 0x26, 0x64, 0xac, 0x1c, 0xf1, 0x5a, 0xd7, 0xb2, 0x5a, 0x41, 0x0d, 0xf8, 0xad,
+	0x68, 0xc5, 0xf2, 0x3c, 0x5d, 0x07, 0x99, 0x39, 0xd9, 0x20, 0x62, 0x1b, 0x84, 0xdf, 0x1f, 0x94, 0xb6, 0xdc,
+	0xf2, 0x8b, 0xe3, 0x66, 0x54, 0xda, 0x6e, 0x2d, 0x9f, 0x58, 0x50, 0xda, 0xf9, 0x1c, 0x6c, 0xd2, 0x0e, 0x2c,
+	0x98, 0xe9, 0xe8, 0xa3, 0x94, 0x5e, 0x26, 0xfc, 0x83, 0x58, 0xa4, 0x49, 0x76, 0xe1, 0x6f, 0xff, 0xc9, 0xb4,
+	0xe8, 0x56, 0xf1, 0x4a, 0xdc, 0x56, 0x61, 0xea, 0x79, 0x4e, 0x67, 0x71, 0xff, 0x40, 0xb7, 0x7b, 0xdc, 0x81,
+	0x0e, 0x8e, 0x9b, 0xf0, 0x1d, 0x0e, 0xb4, 0x1a, 0x6d, 0xce, 0x8e, 0xfe, 0x07, 0x1c, 0xe9, 0x68, 0x7f, 0x67,
+	0x47, 0x3a, 0x3a, 0xf9, 0xdf, 0x71, 0x24, 0x62, 0x71, 0x17, 0x47, 0x3a, 0x3a, 0xd9, 0xd9, 0x91, 0x2e, 0xf0,
+	0xee, 0xd3, 0xbc, 0xe8, 0x02, 0xef, 0xfe, 0x2f, 0xbb, 0x50, 0x75, 0xdf, 0x24, 0x58, 0xfd, 0xc3, 0x77, 0xfe,
+	0x20, 0xdf, 0xf9, 0xde, 0x1c, 0xda, 0xba, 0xed, 0x0f, 0xe5, 0xef, 0x0e, 0x57, 0xb1, 0x70, 0xcf, 0x6f, 0x81,
+	0xd1, 0x06, 0x39, 0x67, 0x52, 0xe1, 0x5b, 0xde, 0x92, 0xc4, 0xdd, 0x99, 0x71, 0x77, 0x89, 0x71, 0x25, 0x31,
+	0x67, 0x12, 0x5d, 0xb6, 0x5b, 0x81, 0xe7, 0xd8, 0x54, 0x23, 0x75, 0x30, 0x5b, 0xc3, 0xda, 0xbd, 0x64, 0x57,
+	0x9a, 0x4e, 0xb2, 0xdc, 0x85, 0xaa, 0xb1, 0xfd, 0x1b, 0xb8, 0xa9, 0xb7, 0x58, 0xf7, 0xaa, 0xf4, 0x86, 0x95,
+	0x48, 0x64, 0xa5, 0x65, 0x47, 0xe7, 0x8c, 0x4a, 0x30, 0xa5, 0x65, 0x29, 0x79, 0x97, 0xe0, 0xdf, 0x6f, 0x72,
+	0xbc, 0x5b, 0xda, 0xf3, 0xc6, 0xbe, 0x79, 0xcb, 0x42, 0x15, 0xc6, 0x73, 0x25, 0xee, 0x5c, 0xc8, 0x8d, 0xad,
+	0xf4, 0xc1, 0xf1, 0xd0, 0xd4, 0x62, 0x9e, 0x70, 0x5d, 0x99, 0x4d, 0x69, 0xea, 0x71, 0x5e, 0x46, 0x25, 0xf3,
+	0xda, 0x5a, 0x8e, 0x82, 0x51, 0x67, 0x46, 0x2b, 0x71, 0x9e, 0xd3, 0x02, 0x6e, 0x38, 0x3e, 0x29, 0x77, 0xdb,
+	0x54, 0x90, 0x38, 0xda, 0x22, 0x6a, 0xca, 0x4a, 0xa5, 0x13, 0xfc, 0x0b, 0x1c, 0xc0, 0x29, 0x74, 0x37, 0x60,
+	0x17, 0x22, 0x5f, 0x7f, 0xb3, 0xd6, 0xa8, 0xde, 0x89, 0x37, 0x22, 0x08, 0x95, 0x76, 0x96, 0x18, 0x81, 0x92,
+	0x0b, 0xe7, 0x92, 0xa6, 0xf2, 0xed, 0x88, 0xb5, 0x35, 0xfc, 0xdd, 0x8d, 0x43, 0x13, 0x79, 0xe1, 0xb3, 0xfd,
+	0x48, 0x80, 0x38, 0x98, 0xb5, 0xec, 0x90, 0xfc, 0xf6, 0xc1, 0x67, 0x01, 0x41, 0x79, 0x4a, 0xd8, 0xf4, 0xf8,
+	0xe9, 0x2b, 0x8d, 0xb6, 0xc1, 0xd7, 0x29, 0xcb, 0x72, 0x0c, 0x0d, 0x8c, 0xdf, 0x42, 0xdc, 0x29, 0xe0, 0xd4,
+	0xef, 0xce, 0xf4, 0x1e, 0x6a, 0x0c, 0x6b, 0x41, 0x9f, 0x56, 0xc1, 0x8c, 0xa6, 0xa5, 0xef, 0x57, 0xcc, 0xb1,
+	0x34, 0x7d, 0xec, 0x12, 0x2a, 0xdd, 0x58, 0x14, 0x3d, 0x7a, 0x45, 0x0e, 0x67, 0x71, 0x3a, 0x16, 0x14, 0x05,
+	0x45, 0x0b, 0xd0, 0x44, 0xdf, 0x25, 0x7a, 0x3f, 0x66, 0xec, 0xef, 0x6e, 0x8c, 0xb1, 0x8d, 0x03, 0x93, 0x92,
+	0xdc, 0xfa, 0xdb, 0xd9, 0xd8, 0xdf, 0xdd, 0x7c, 0x8a, 0xb1, 0x5b, 0x57, 0x62, 0xd3, 0xd8, 0xf5, 0x55, 0x59,
+	0x37, 0x36, 0x91, 0xea, 0x34, 0x76, 0xdb, 0xe0, 0xff, 0x3f, 0x63, 0x0f, 0x42, 0x9c, 0x17, 0x26, 0x4e, 0x9a,
+	0xd8, 0x53, 0x33, 0x90, 0xff, 0x5d, 0x96, 0x1d, 0xdd, 0x60, 0xd7, 0x2f, 0x9c, 0x9a, 0x23, 0x7e, 0x46, 0x07,
+	0xd7, 0x74, 0x08, 0x1e, 0x94, 0x6a, 0x75, 0x1a, 0xb4, 0xc6, 0x28, 0x5f, 0xfa, 0x4a, 0xff, 0x19, 0xe7, 0xaf,
+	0x94, 0xc2, 0x6c, 0x9e, 0xae, 0xc7, 0x65, 0xd8, 0xab, 0xf4, 0xbe, 0x75, 0x53, 0xf8, 0x8d, 0x18, 0xcb, 0x82,
+	0x9f, 0xb6, 0x62, 0x01, 0xde, 0xe7, 0xb8, 0xa8, 0xbe, 0xdc, 0xb3, 0xbc, 0x6d, 0x6a, 0x0e, 0x98, 0x55, 0xd3,
+	0x4e, 0xfb, 0xbf, 0xef, 0xe0, 0xcc, 0xef, 0x1c, 0xd2, 0x5d, 0x5f, 0xaa, 0x5b, 0xbe, 0xbb, 0x01, 0xf7, 0xc2,
+	0x7c, 0xb8, 0xf8, 0x46, 0x94, 0xa7, 0xe3, 0xe9, 0x1a, 0x96, 0x4c, 0xd1, 0xc2, 0x41, 0x89, 0x7c, 0x41, 0x00,
+	0x82, 0x12, 0x76, 0x88, 0xf7, 0xf6, 0x34, 0xbb, 0x1a, 0x81, 0xc4, 0x9e, 0xc8, 0x5f, 0xb0, 0x0b, 0xab, 0xdd,
+	0x7d, 0xfb, 0xc7, 0x7c, 0xc4, 0xd5, 0xb7, 0xc5, 0x67, 0x81, 0xf6, 0x57, 0xc4, 0x52, 0xe5, 0x7e, 0x6e, 0xee,
+	0x28, 0x8f, 0xaa, 0x1b, 0x1c, 0xe6, 0x97, 0x77, 0xfd, 0xd8, 0xef, 0x97, 0xd6, 0x2e, 0xd2, 0xb4, 0x5c, 0x5a,
+	0x1b, 0x8e, 0xe9, 0x8a, 0x75, 0xf0, 0x96, 0x47, 0x09, 0x4f, 0xf4, 0x7a, 0x68, 0xca, 0x32, 0x7b, 0x1d, 0x11,
+	0x44, 0x54, 0x13, 0xc4, 0x49, 0x4a, 0xf2, 0x99, 0x1b, 0x02, 0xa5, 0x12, 0x1e, 0x95, 0xaf, 0xea, 0xab, 0x7a,
+	0x97, 0x81, 0x8d, 0x0a, 0x33, 0x96, 0xe7, 0x74, 0xbd, 0x86, 0x3e, 0x8b, 0xf1, 0xf5, 0x29, 0x6a, 0x24, 0x94,
+	0x11, 0xf2, 0xfd, 0xfe, 0x08, 0x0e, 0x3e, 0x58, 0x81, 0xdf, 0x1b, 0x05, 0xc1, 0x61, 0xf9, 0x68, 0xb4, 0x04,
+	0x2f, 0xcb, 0x47, 0xab, 0x2a, 0x38, 0x2a, 0x9f, 0x3d, 0x7d, 0xc1, 0x17, 0x15, 0x8e, 0x79, 0x3c, 0xb6, 0x8f,
+	0x1f, 0x7c, 0x33, 0xdb, 0x26, 0x2f, 0x99, 0xf9, 0xc3, 0x14, 0x60, 0xf3, 0xd9, 0x24, 0xb1, 0x62, 0x35, 0x60,
+	0x2e, 0x2b, 0xcc, 0x11, 0x39, 0xc4, 0x4c, 0xce, 0x59, 0x4c, 0x05, 0x6c, 0x9a, 0x1a, 0x57, 0xec, 0x6f, 0xdd,
+	0x02, 0x30, 0xdc, 0x18, 0x95, 0xde, 0x2d, 0x51, 0x2f, 0xdd, 0xf1, 0xd2, 0x1b, 0x01, 0xb9, 0x14, 0xb1, 0x64,
+	0x99, 0x51, 0xa6, 0x85, 0x36, 0xeb, 0x73, 0x32, 0x81, 0x2b, 0xa6, 0x94, 0xa9, 0x89, 0xe9, 0xc4, 0x29, 0x4d,
+	0x38, 0x52, 0xfd, 0x5f, 0x98, 0xef, 0x21, 0xcc, 0x21, 0x14, 0xf2, 0x55, 0x22, 0x05, 0xa7, 0x17, 0xb0, 0x62,
+	0x32, 0xa1, 0xbb, 0xf2, 0x46, 0x6d, 0xde, 0x2a, 0x21, 0x43, 0xd0, 0xf9, 0x36, 0xe9, 0x57, 0x2f, 0x31, 0xa3,
+	0x61, 0x9a, 0x98, 0xc8, 0x31, 0x09, 0x99, 0x39, 0x77, 0x36, 0x97, 0x33, 0xcc, 0x07, 0xab, 0xf6, 0xa3, 0xc6,
+	0x19, 0x1c, 0xed, 0xd3, 0x55, 0x5f, 0xff, 0xa2, 0xab, 0xbc, 0x32, 0x65, 0x92, 0xa9, 0x7b, 0x9a, 0xf7, 0x9f,
+	0x72, 0x33, 0x64, 0x91, 0xfd, 0x1b, 0x4f, 0x94, 0x29, 0xd4, 0x76, 0x35, 0xa9, 0x34, 0x5d, 0x7c, 0x1a, 0xfc,
+	0x6d, 0xdf, 0x2d, 0xd8, 0x5e, 0xdb, 0x91, 0xbb, 0x7f, 0xc8, 0x5e, 0x7e, 0xdf, 0x65, 0xe8, 0x55, 0xde, 0x4a,
+	0x71, 0xd2, 0xbc, 0x71, 0x54, 0x1c, 0xf3, 0x7b, 0xb3, 0x1a, 0xdc, 0xb4, 0xf9, 0xc5, 0xe6, 0x9f, 0xe1, 0xa4,
+	0x71, 0xaf, 0x76, 0x83, 0x78, 0x02, 0x2f, 0x7c, 0xb8, 0xb6, 0x0b, 0x62, 0xb9, 0x96, 0x53, 0x17, 0xfc, 0xfa,
+	0xfe, 0x2e, 0x6f, 0x51, 0x6e, 0x74, 0xe8, 0x43, 0x80, 0x6a, 0xee, 0x1a, 0xc8, 0xea, 0x4a, 0x4b, 0x65, 0x1d,
+	0xaa, 0xf6, 0xd9, 0xc0, 0x38, 0x12, 0xf2, 0x9c, 0x2d, 0x96, 0x41, 0xc0, 0x64, 0xec, 0x29, 0xb7, 0x44, 0xb1,
+	0x07, 0x0d, 0xd6, 0x04, 0x06, 0x64, 0xe8, 0xdf, 0xc7, 0xaf, 0x43, 0xed, 0x0f, 0xfd, 0x49, 0x6f, 0xd1, 0x7c,
+	0x02, 0x6d, 0xef, 0x4f, 0x8d, 0xe9, 0x29, 0x28, 0xbf, 0x4a, 0x18, 0x8e, 0x95, 0x90, 0xda, 0x1d, 0x09, 0xd2,
+	0xc8, 0x86, 0x8b, 0x5b, 0x5c, 0x3f, 0xc5, 0xc5, 0xdf, 0xff, 0xf4, 0xf1, 0x16, 0xd7, 0x0f, 0xb3, 0x3f, 0x7d,
+	0x2c, 0xe9, 0xbd, 0xbf, 0xc5, 0xf5, 0x87, 0x87, 0xbf, 0xef, 0xce, 0x9b, 0xfb, 0x60, 0xc2, 0xf3, 0x9a, 0x0a,
+	0xba, 0xe2, 0x24, 0xaf, 0x39, 0x5b, 0xcb, 0x45, 0xcb, 0xd2, 0x33, 0xcc, 0xc5, 0x94, 0xc7, 0xef, 0x64, 0x96,
+	0x56, 0xa6, 0x1b, 0x9d, 0xf5, 0x13, 0x77, 0xcf, 0xfe, 0x15, 0xf3, 0xfd, 0xf2, 0xb2, 0x92, 0x59, 0x2a, 0xb7,
+	0x28, 0x21, 0x2e, 0x98, 0x64, 0x5c, 0x23, 0x2a, 0xb0, 0x91, 0x04, 0x42, 0xa6, 0x19, 0x28, 0xcd, 0xa4, 0x56,
+	0x36, 0x76, 0x31, 0x0d, 0x29, 0x32, 0xa5, 0xcd, 0xc5, 0x99, 0x1f, 0x12, 0x4e, 0xb9, 0xe5, 0x55, 0x18, 0xca,
+	0xb1, 0xa3, 0xf6, 0x6f, 0x88, 0x39, 0x24, 0xdc, 0x5c, 0xf3, 0x82, 0xbb, 0x44, 0x2f, 0x61, 0x91, 0x85, 0x13,
+	0xa2, 0x3f, 0x31, 0x37, 0x69, 0x38, 0x4b, 0x27, 0x69, 0x38, 0x21, 0xba, 0xe3, 0x58, 0x9c, 0xb6, 0x51, 0xb1,
+	0xba, 0x6b, 0x8c, 0xb8, 0x05, 0x4b, 0xc5, 0xd2, 0xc1, 0x57, 0xf6, 0x42, 0xad, 0xe7, 0xf0, 0x5f, 0xcf, 0x9a,
+	0xf0, 0x9d, 0x69, 0x56, 0x0b, 0xcd, 0xd2, 0xf2, 0x53, 0x66, 0x11, 0x35, 0x82, 0x4f, 0x67, 0xc8, 0xc1, 0xfb,
+	0x05, 0x62, 0xa8, 0x20, 0x4d, 0xb2, 0x44, 0x0f, 0xfc, 0xab, 0x7f, 0x2d, 0x49, 0x95, 0xaa, 0x04, 0x5a, 0x2f,
+	0xa6, 0x9b, 0xb1, 0x1a, 0x56, 0xdc, 0x7a, 0xe1, 0x72, 0xf8, 0xc4, 0x87, 0x29, 0x81, 0x97, 0xe8, 0xd9, 0x1d,
+	0x4b, 0x34, 0x6c, 0x7d, 0xf5, 0x52, 0x95, 0x26, 0xd5, 0xd9, 0xf7, 0xa6, 0x20, 0x69, 0x9d, 0x69, 0xab, 0xe0,
+	0xa8, 0x05, 0x66, 0x96, 0xd2, 0xf7, 0x9e, 0x6b, 0x17, 0xa0, 0xdb, 0x0b, 0x8d, 0x8d, 0x84, 0xfe, 0x69, 0xfb,
+	0xa7, 0x8b, 0x66, 0xd9, 0xa7, 0x3b, 0x7e, 0xdf, 0x16, 0x7c, 0xf1, 0xb3, 0x64, 0x79, 0x8e, 0x32, 0x48, 0x4a,
+	0x12, 0xee, 0x83, 0x11, 0xe1, 0xe2, 0xce, 0xb4, 0xbf, 0x89, 0x51, 0xe5, 0xd5, 0x38, 0x28, 0xa5, 0x76, 0xc0,
+	0xe8, 0xbe, 0xd6, 0xf9, 0x08, 0xf4, 0x85, 0xa0, 0x39, 0xb2, 0x5f, 0x26, 0xea, 0x14, 0x6c, 0x12, 0xa4, 0xe6,
+	0xd2, 0xa9, 0x97, 0x62, 0xec, 0xf7, 0x44, 0xbd, 0x58, 0x6c, 0x7d, 0xed, 0x63, 0xe8, 0x6c, 0x46, 0x6b, 0xf2,
+	0x96, 0x3c, 0x18, 0x98, 0xb1, 0xed, 0x25, 0x4d, 0xbd, 0x6a, 0xb1, 0xf7, 0xd0, 0x7f, 0x18, 0x12, 0xec, 0x7f,
+	0x0d, 0x00, 0x43, 0xa6, 0x14, 0xe6, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6, 0xec, 0xdc, 0x81, 0x0c, 0x00, 0x00,
+	0x00, 0x80, 0x30, 0x7f, 0xeb, 0x3c, 0xda, 0x45, 0xce, 0x97, 0xe0, 0x4b, 0xf0, 0x25, 0xf8, 0x12, 0x7c, 0x09,
+	0xbe, 0x04, 0x5f, 0x82, 0x2f, 0xc1, 0x97, 0xe0, 0x4b, 0xf0, 0x25, 0xf8, 0x12, 0x7c, 0x09, 0xbe, 0x04, 0x5f,
+	0x82, 0x2f, 0xc1, 0x97, 0xe0, 0x4b, 0xf0, 0x25, 0xf8, 0x12, 0x7c, 0x09, 0xbe, 0x04, 0x5f, 0x82, 0x2f, 0xc1,
+	0x97, 0xe0, 0x4b, 0xf0, 0x25, 0xf8, 0x12, 0x7c, 0x09, 0xbe, 0x04, 0x5f, 0x82, 0x2f, 0xc1, 0x97, 0xe0, 0x4b,
+	0xf0, 0x25, 0xf8, 0x12, 0x7c, 0x09, 0xbe, 0x04, 0x5f, 0x82, 0x2f, 0xc1, 0x97, 0xe0, 0x4b, 0xf0, 0x25, 0xf8,
+	0x12, 0x7c, 0x09, 0x46, 0x00, 0x5f, 0x82, 0x2f, 0xc1, 0x97, 0xe0, 0x4b, 0xf0, 0x25, 0xf8, 0x12, 0x7c, 0x09,
+	0xbe, 0x04, 0x5f, 0x82, 0x2f, 0xc1, 0x97, 0xe0, 0x4b, 0xf0, 0x25, 0xf8, 0x12, 0x7c, 0x09, 0xbe, 0x04, 0x5f,
+	0x82, 0x2f, 0xc1, 0x97, 0x78, 0xfb, 0x12, 0x0d, 0x00, 0x43, 0xa6, 0x14, 0xe6, 0x78, 0xf9, 0x43, 0xa6, 0x14,
+	0xe6, 0xec, 0x9c, 0x5d, 0x4f, 0xdb, 0x3c, 0x14, 0xc7, 0xaf, 0x1f, 0x3e, 0x85, 0xe9, 0x05, 0x6a, 0xf5, 0x74,
+	0xe9, 0xc6, 0xee, 0x60, 0xbd, 0xd8, 0x06, 0x4c, 0x4c, 0xda, 0x84, 0xb6, 0x49, 0xbb, 0xa8, 0x10, 0x4a, 0x13,
+	0x27, 0x18, 0x52, 0xbb, 0xb2, 0x53, 0x2a, 0x34, 0xf1, 0xdd, 0xa7, 0xe3, 0x63, 0x27, 0x0e, 0x35, 0xac, 0x9d,
+	0x78, 0xd9, 0xb4, 0x73, 0x83, 0xea, 0xd8, 0x39, 0x71, 0xfc, 0x12, 0x1f, 0x9b, 0xf3, 0xff, 0xfd, 0xe9, 0x7c,
+	0x89, 0x16, 0x28, 0x31, 0x1a, 0x81, 0xaf, 0xc2, 0x66, 0x8b, 0xaa, 0x16, 0xf3, 0x8a, 0xb3, 0x8f, 0xe9, 0x55,
+	0xfa, 0x35, 0xd3, 0x62, 0x5e, 0x87, 0xf3, 0xc1, 0xb9, 0x42, 0x46, 0xc8, 0xb2, 0xc2, 0xa3, 0x2c, 0x25, 0xd9,
+	0xdb, 0x93, 0xe3, 0xa1, 0x35, 0x30, 0xb7, 0xae, 0x8c, 0x8d, 0x22, 0x5e, 0xf2, 0x29, 0x7c, 0x35, 0x64, 0x9e,
+	0xea, 0xdc, 0x30, 0xa8, 0x30, 0xfb, 0xac, 0x72, 0x9e, 0x5c, 0x18, 0x28, 0x9e, 0x40, 0x71, 0x7b, 0xcb, 0x61,
+	0x68, 0x1b, 0x3a, 0x50, 0xe4, 0x5c, 0xf3, 0x7c, 0xcf, 0x66, 0xbe, 0x60, 0xef, 0xb4, 0x5a, 0x1a, 0xae, 0x8d,
+	0x4b, 0x3a, 0x13, 0x2e, 0x75, 0x08, 0x6e, 0x88, 0x56, 0xd2, 0x25, 0x4f, 0x52, 0x9d, 0xf1, 0xca, 0x25, 0xbe,
+	0xf3, 0xe9, 0x3c, 0xcd, 0x2e, 0x9d, 0x62, 0xcf, 0xc9, 0x00, 0xdc, 0x27, 0x0d, 0x16, 0xc6, 0x5e, 0x73, 0x58,
+	0xdd, 0xc3, 0xe1, 0x33, 0x1a, 0xf9, 0xec, 0x60, 0x1a, 0x98, 0xda, 0x6c, 0xfd, 0x77, 0xc3, 0x78, 0x65, 0x38,
+	0x0b, 0xec, 0x2c, 0x85, 0xcc, 0xd5, 0x32, 0x6e, 0x07, 0xf3, 0x12, 0x67, 0x6b, 0xec, 0xca, 0xee, 0xc7, 0xcc,
+	0x18, 0x5e, 0x15, 0x71, 0x23, 0x90, 0xd3, 0x9a, 0x80, 0x54, 0x6b, 0x20, 0x2a, 0x32, 0xcc, 0x52, 0x29, 0x55,
+	0xcd, 0x70, 0x72, 0xc2, 0xf8, 0xea, 0x4b, 0x2e, 0xac, 0x03, 0x86, 0x56, 0x86, 0xbe, 0xce, 0x52, 0x69, 0x7c,
+	0xae, 0x30, 0xcc, 0x3d, 0x33, 0xaa, 0xa8, 0x4c, 0x9c, 0x08, 0x11, 0x24, 0x3a, 0xae, 0xba, 0xfe, 0x4a, 0xb4,
+	0xc6, 0xb7, 0xee, 0x1a, 0xfb, 0xd2, 0x11, 0xcb, 0x85, 0x01, 0xa3, 0xdd, 0x1b, 0x06, 0x01, 0xbb, 0xc4, 0x32,
+	0x43, 0xdc, 0xf5, 0x7e, 0xaf, 0x30, 0xb6, 0x7a, 0x61, 0x37, 0x42, 0x81, 0xf1, 0xb8, 0x51, 0x71, 0x80, 0xb5,
+	0xc2, 0x34, 0xf2, 0x0d, 0x48, 0x86, 0xbe, 0x40, 0x61, 0xfc, 0xe1, 0x63, 0xc7, 0x23, 0x6a, 0x2b, 0x33, 0x66,
+	0x85, 0xd9, 0x6f, 0xb5, 0xb6, 0xcf, 0x46, 0x56, 0x59, 0x8b, 0xaa, 0xf2, 0x14, 0x44, 0x95, 0xbf, 0x0a, 0xa0,
+	0xb2, 0x01, 0x3d, 0x85, 0xd0, 0x29, 0x84, 0x4e, 0x21, 0x74, 0x0a, 0xa1, 0x53, 0x08, 0x9d, 0x42, 0xe8, 0x94,
+	0x8d, 0xd0, 0x29, 0x31, 0x6c, 0x0a, 0x21, 0x53, 0x36, 0x41, 0xa6, 0x78, 0x12, 0xc9, 0xbd, 0x8e, 0x9f, 0x54,
+	0x39, 0x7f, 0x8f, 0xe5, 0x02, 0x07, 0x10, 0xef, 0x44, 0xff, 0xa0, 0x6b, 0xac, 0x6d, 0xf9, 0x4e, 0x58, 0xf0,
+	0xd4, 0x2f, 0xa0, 0xad, 0xbd, 0x44, 0xdb, 0x02, 0x47, 0xa2, 0xaa, 0xec, 0xaa, 0x38, 0x0d, 0x16, 0x3e, 0xec,
+	0xef, 0x48, 0x65, 0xef, 0xe5, 0x79, 0x6c, 0x84, 0x56, 0x59, 0x9b, 0xa9, 0xb2, 0xca, 0x53, 0x59, 0xbd, 0xee,
+	0x5f, 0x1b, 0x85, 0x85, 0xe1, 0xce, 0x77, 0x62, 0x78, 0x86, 0x6a, 0x11, 0x08, 0xf7, 0x75, 0xe3, 0x33, 0x39,
+	0xd7, 0xb5, 0x58, 0xd9, 0xb8, 0x1a, 0x9e, 0x39, 0x65, 0x06, 0xfb, 0x9f, 0xc9, 0x56, 0xdf, 0x61, 0x45, 0xfb,
+	0xdd, 0x96, 0xe9, 0x56, 0x30, 0xe4, 0xa6, 0xdc, 0xd1, 0x99, 0x91, 0x92, 0x41, 0x77, 0x2e, 0x6a, 0x51, 0xf5,
+	0x06, 0x61, 0xee, 0x6a, 0xfb, 0xaf, 0x4b, 0x72, 0x79, 0x08, 0x8a, 0x4b, 0x07, 0xca, 0xf2, 0xeb, 0x37, 0x3a,
+	0xe0, 0xf7, 0xbd, 0x91, 0xcb, 0x8d, 0xbf, 0xd1, 0x3a, 0x98, 0x98, 0xdf, 0x46, 0xc4, 0xd8, 0xad, 0x74, 0x0e,
+	0x27, 0x06, 0xbe, 0x8c, 0xb1, 0x9a, 0x8c, 0x76, 0x8b, 0x9e, 0x3c, 0x36, 0x48, 0x86, 0x20, 0x32, 0x04, 0x91,
+	0x21, 0xe0, 0x0b, 0x01, 0x5f, 0x08, 0xf8, 0x42, 0xc0, 0x17, 0x02, 0xbe, 0x10, 0xf0, 0x85, 0x80, 0x2f, 0x04,
+	0x7c, 0x79, 0x6c, 0xe0, 0xcb, 0x83, 0xe1, 0x56, 0x4a, 0x45, 0x68, 0x14, 0x42, 0xa3, 0x10, 0x1a, 0x85, 0xd0,
+	0x28, 0x84, 0x46, 0x21, 0x34, 0x0a, 0xa1, 0x51, 0x08, 0x8d, 0xf2, 0xcf, 0xa3, 0x51, 0x60, 0xcc, 0x0e, 0x51,
+	0xb5, 0xe6, 0xbb, 0xc7, 0x86, 0x89, 0xd9, 0x60, 0x60, 0x03, 0x7f, 0x6a, 0xc5, 0x0a, 0xa1, 0x39, 0x5b, 0xcc,
+	0xe1, 0xe7, 0x2b, 0x36, 0x13, 0x55, 0x25, 0x0c, 0xcf, 0x94, 0xcc, 0x19, 0x4f, 0x75, 0x75, 0x4d, 0x70, 0x15,
+	0x82, 0xab, 0x10, 0x5c, 0x85, 0xe0, 0x2a, 0x04, 0x57, 0x21, 0xb8, 0x0a, 0xc1, 0x55, 0x08, 0xae, 0x42, 0x70,
+	0x15, 0x82, 0xab, 0x10, 0x5c, 0x85, 0xe0, 0x2a, 0x04, 0x57, 0x21, 0xb8, 0x0a, 0xc1, 0x55, 0x08, 0xae, 0x42,
+	0x70, 0x15, 0x82, 0xab, 0x10, 0x5c, 0x85, 0xe0, 0x2a, 0x04, 0x57, 0x21, 0xb8, 0x0a, 0xc1, 0x55, 0x9e, 0x09,
+	0xae, 0x42, 0x60, 0x95, 0xf5, 0xc1, 0x2a, 0x04, 0x55, 0x21, 0xa8, 0x0a, 0x41, 0x55, 0x08, 0xaa, 0x42, 0x50,
+	0x15, 0x82, 0xaa, 0x10, 0x54, 0xe5, 0xc9, 0xa1, 0x2a, 0x28, 0xaa, 0xda, 0xc2, 0xf0, 0x67, 0x55, 0x80, 0x2a,
+	0x78, 0x51, 0xad, 0x10, 0x29, 0xd8, 0xce, 0xce, 0x2a, 0x91, 0x22, 0x72, 0x2d, 0x99, 0xa5, 0x10, 0x38, 0x3a,
+	0x1e, 0x7b, 0x3b, 0x61, 0x19, 0x2f, 0xef, 0x8c, 0x5c, 0x4b, 0xae, 0xb8, 0x36, 0x36, 0xba, 0xd3, 0x66, 0x6e,
+	0xdf, 0x91, 0x9b, 0xf0, 0x86, 0x4f, 0x82, 0x8d, 0x09, 0x75, 0xf7, 0x85, 0x82, 0x6f, 0x3d, 0x7b, 0xc3, 0x5e,
+	0x07, 0x3d, 0x03, 0x4e, 0x33, 0xc7, 0xa1, 0xb4, 0x30, 0x69, 0xc9, 0xf7, 0x58, 0xa9, 0xce, 0x2e, 0xcc, 0x19,
+	0xcc, 0xc4, 0x33, 0x88, 0xef, 0x64, 0x13, 0xf8, 0xc9, 0xa6, 0x42, 0xa6, 0xfa, 0xfa, 0x94, 0x4d, 0x9a, 0x76,
+	0x3f, 0x75, 0xa3, 0xcb, 0x3f, 0xc3, 0x06, 0xf8, 0xa1, 0xd7, 0x6e, 0x47, 0x44, 0xd0, 0xef, 0x30, 0x5e, 0x3f,
+	0x28, 0x6c, 0xf8, 0x52, 0x79, 0x49, 0x56, 0xa7, 0x72, 0xc6, 0xee, 0x8d, 0x76, 0x7d, 0x11, 0x94, 0x67, 0xb9,
+	0xf5, 0x20, 0x35, 0x46, 0x94, 0xb2, 0xff, 0x83, 0x7d, 0xfb, 0x74, 0x72, 0x70, 0xfc, 0x65, 0xaf, 0x15, 0xc3,
+	0x29, 0xd3, 0x1b, 0x24, 0xf5, 0x6c, 0x8e, 0xfa, 0xd0, 0x9b, 0x61, 0x63, 0x13, 0x16, 0x0e, 0x6f, 0x0a, 0x75,
+	0x5d, 0x61, 0x35, 0x21, 0x27, 0xf4, 0xcc, 0xd1, 0xdf, 0xae, 0x85, 0x15, 0xc7, 0xc3, 0xbc, 0x48, 0xf3, 0x23,
+	0x51, 0x61, 0x90, 0x5e, 0x58, 0xcb, 0xc9, 0xee, 0x29, 0xfc, 0x0b, 0x4b, 0x75, 0x82, 0x7a, 0x07, 0x49, 0x7d,
+	0xce, 0x65, 0xbf, 0xef, 0x8e, 0x21, 0x9b, 0x65, 0xc0, 0xdf, 0xa8, 0x9c, 0x3e, 0xac, 0x37, 0x0c, 0xb5, 0x65,
+	0xe0, 0x60, 0x79, 0x44, 0x0d, 0xe4, 0x1a, 0x26, 0x0a, 0x26, 0x6f, 0x05, 0xde, 0x32, 0x61, 0x98, 0x1b, 0xd2,
+	0x5d, 0x2d, 0x9a, 0x8d, 0x22, 0x00, 0x79, 0xcb, 0x76, 0xa9, 0x6e, 0x4d, 0x58, 0xf8, 0x7e, 0xe7, 0xa0, 0x06,
+	0x57, 0xd9, 0xe5, 0xd0, 0xc6, 0xec, 0xa2, 0xf3, 0x26, 0xa4, 0xa5, 0x89, 0x28, 0x8d, 0xf1, 0xc0, 0x36, 0x5e,
+	0xb6, 0xd6, 0x69, 0xc6, 0xd1, 0x57, 0x8d, 0x4d, 0x1f, 0x9c, 0x7e, 0x2f, 0xfd, 0x04, 0x8b, 0xcc, 0xa1, 0x9b,
+	0x60, 0x0d, 0x75, 0xf3, 0xa9, 0xb4, 0x3b, 0x1f, 0xd7, 0x1a, 0x49, 0xb3, 0xbd, 0xb2, 0xc3, 0x62, 0x90, 0xd8,
+	0x43, 0xd7, 0x3e, 0x1e, 0xba, 0xfa, 0x86, 0xea, 0x0e, 0xc3, 0xe6, 0xa8, 0x34, 0x36, 0xae, 0x06, 0xfb, 0x0d,
+	0xec, 0x68, 0xeb, 0xe7, 0x00, 0x41, 0x94, 0x1f, 0x26, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6, 0xec, 0xdc, 0x41,
+	0x09, 0x00, 0x00, 0x08, 0x03, 0xc0, 0xfe, 0xad, 0xad, 0xe0, 0x47, 0x64, 0xec, 0x8a, 0x1c, 0x5f, 0x22, 0xde,
+	0x97, 0xc0, 0x49, 0xe0, 0x24, 0x70, 0x12, 0x38, 0x09, 0x9c, 0x04, 0x4e, 0x02, 0x27, 0x81, 0x93, 0xb8, 0xe1,
+	0x24, 0x88, 0x0e, 0x44, 0x07, 0xa2, 0x03, 0xd1, 0x81, 0xe8, 0x40, 0x74, 0x20, 0x3a, 0x10, 0x1d, 0x88, 0x0e,
+	0x44, 0x07, 0xa2, 0x03, 0xd1, 0xa1, 0x5d, 0x74, 0x78, 0xf0, 0x14, 0x70, 0x09, 0xb8, 0x04, 0x5c, 0x02, 0x2e,
+	0x01, 0x97, 0x80, 0x4b, 0xc0, 0x25, 0xe0, 0x12, 0x70, 0x09, 0xb8, 0x04, 0x5c, 0x02, 0x2e, 0x01, 0x97, 0x80,
+	0x4b, 0xc0, 0x25, 0xe0, 0x12, 0x70, 0x09, 0xb8, 0x04, 0x5c, 0x02, 0x2e, 0x01, 0x97, 0x80, 0x4b, 0xc0, 0x25,
+	0xe0, 0x12, 0x70, 0x09, 0xb8, 0x04, 0x5c, 0x02, 0x2e, 0x01, 0x97, 0x80, 0x4b, 0xc0, 0x25, 0xe0, 0x12, 0x70,
+	0x09, 0xb8, 0x04, 0x5c, 0x02, 0x2e, 0x01, 0x97, 0x80, 0x4b, 0xc0, 0x25, 0xe0, 0x12, 0x70, 0x09, 0x6b, 0x2e,
+	0x61, 0x06, 0x00, 0xa6, 0x86, 0xb8, 0x03, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6, 0xec, 0x9d, 0xcf, 0x6f, 0xdb,
+	0x36, 0x14, 0xc7, 0xcf, 0xe9, 0x5f, 0xc1, 0x05, 0x18, 0x60, 0x61, 0x86, 0x82, 0x24, 0x3b, 0x65, 0xeb, 0x61,
+	0x58, 0xd0, 0xad, 0x3b, 0xac, 0x03, 0xb2, 0x5b, 0x10, 0x04, 0xb2, 0x44, 0x3b, 0x6c, 0x65, 0x32, 0x10, 0xe5,
+	0x1a, 0xc5, 0xd0, 0xff, 0x7d, 0x78, 0x8f, 0xa4, 0x44, 0x52, 0xa4, 0x25, 0x25, 0x8a, 0x63, 0x17, 0xbd, 0x04,
+	0xb1, 0x48, 0x3d, 0x8a, 0xa4, 0xf4, 0xc4, 0x1f, 0xdf, 0xcf, 0xd3, 0xa1, 0xc7, 0x97, 0x68, 0x03, 0x4a, 0x9c,
+	0x9d, 0xc1, 0x58, 0x85, 0x6c, 0xe9, 0x82, 0x2c, 0x2a, 0xb1, 0x95, 0xb4, 0x22, 0xbf, 0xfd, 0xf3, 0x1e, 0x9f,
+	0x87, 0xbf, 0x45, 0x41, 0xd3, 0x8f, 0x12, 0x7f, 0xe3, 0x48, 0x48, 0x32, 0xbe, 0x2a, 0xd5, 0x4a, 0x96, 0xe0,
+	0x78, 0x78, 0xf6, 0x88, 0xa3, 0x18, 0x14, 0x10, 0x83, 0x09, 0x18, 0x8a, 0x16, 0x59, 0x55, 0x48, 0x02, 0xd7,
+	0x6a, 0x5b, 0x48, 0x52, 0x03, 0xa9, 0x31, 0x09, 0x87, 0xff, 0xba, 0x81, 0xbe, 0x55, 0x32, 0x7d, 0x43, 0xa1,
+	0xc2, 0x9b, 0xeb, 0xb4, 0x59, 0x4d, 0x86, 0x98, 0x12, 0x9a, 0x0a, 0xc1, 0xfc, 0x36, 0x75, 0x99, 0x6a, 0x56,
+	0x0e, 0x29, 0x2e, 0xfc, 0xaf, 0x0d, 0x40, 0xa1, 0x82, 0x4f, 0xe8, 0xc3, 0xb3, 0xd3, 0xa5, 0x54, 0xd4, 0x9b,
+	0x2e, 0x9d, 0x8b, 0x82, 0xfe, 0xae, 0xf0, 0x48, 0x2b, 0x93, 0x52, 0x1a, 0x9c, 0x26, 0x96, 0x95, 0xdc, 0x64,
+	0x32, 0x64, 0xac, 0xa3, 0x41, 0x58, 0x98, 0x9b, 0xaf, 0xb5, 0x97, 0x56, 0x98, 0xe1, 0x1d, 0x2b, 0x4b, 0xd4,
+	0xdb, 0x2d, 0xac, 0x00, 0x05, 0xea, 0x85, 0xaa, 0x2d, 0xdb, 0x3c, 0xa4, 0x36, 0xaf, 0xd4, 0xc2, 0xf6, 0xed,
+	0x7c, 0x2b, 0x69, 0xae, 0x24, 0x60, 0xb0, 0x87, 0xaf, 0x5b, 0x28, 0x7d, 0xa8, 0x6a, 0xd6, 0xb9, 0x1b, 0x25,
+	0xcd, 0xb5, 0xdc, 0x8a, 0xfc, 0x44, 0x78, 0x2b, 0xda, 0x42, 0x12, 0xc7, 0xbd, 0x02, 0x65, 0x7b, 0x53, 0xb3,
+	0xd2, 0xae, 0x3d, 0xfc, 0x76, 0xea, 0x6e, 0x53, 0x92, 0x6f, 0x31, 0xbb, 0x7d, 0xc8, 0xcb, 0x78, 0x4d, 0x3b,
+	0x19, 0xf5, 0x21, 0xc0, 0x18, 0x09, 0x2d, 0x25, 0xc5, 0x9a, 0x59, 0x38, 0xd0, 0x96, 0xf1, 0x42, 0x6c, 0xfd,
+	0xfe, 0xd6, 0x0d, 0xa0, 0x12, 0x53, 0x55, 0x02, 0x79, 0xab, 0x33, 0xe3, 0x03, 0xa6, 0x8c, 0x59, 0x86, 0x24,
+	0x2d, 0x97, 0x11, 0x33, 0x90, 0xd4, 0x1a, 0x81, 0x5f, 0x96, 0x89, 0xff, 0xde, 0x84, 0xfc, 0x62, 0x9e, 0x71,
+	0x2e, 0x6a, 0xa2, 0x5c, 0x1d, 0x3c, 0xad, 0x33, 0x4e, 0x19, 0x0e, 0x67, 0xf5, 0x05, 0x73, 0x51, 0xa9, 0x22,
+	0x99, 0x24, 0xba, 0x38, 0xc5, 0xa5, 0x2a, 0x7f, 0xb6, 0x2b, 0x2c, 0xca, 0x3e, 0x42, 0xa2, 0x1c, 0x55, 0x04,
+	0x94, 0x11, 0xe1, 0x4f, 0xc6, 0xc6, 0x3e, 0x11, 0x8f, 0x94, 0x63, 0xad, 0x3b, 0x51, 0x18, 0xbc, 0x57, 0xc6,
+	0xa0, 0x68, 0x39, 0xd1, 0x78, 0x39, 0xe6, 0x0e, 0x52, 0x01, 0x73, 0xda, 0xe7, 0xec, 0xe5, 0xd9, 0xdd, 0xe3,
+	0xe4, 0x76, 0x73, 0x57, 0x44, 0xf9, 0x2c, 0x6e, 0x56, 0xad, 0x71, 0xd8, 0x42, 0x4a, 0x3d, 0xa0, 0x55, 0xd3,
+	0x2a, 0x04, 0x0e, 0x34, 0xd7, 0xb0, 0x7d, 0xa0, 0x1c, 0x7d, 0x1d, 0x95, 0x38, 0x53, 0x04, 0x01, 0xbf, 0x3d,
+	0x37, 0xb4, 0x51, 0xa2, 0x31, 0x0b, 0x26, 0x5f, 0x07, 0x63, 0xbc, 0x6b, 0xba, 0x9e, 0x25, 0x03, 0x18, 0xde,
+	0x48, 0xbe, 0x27, 0x01, 0xbc, 0x03, 0x61, 0x5b, 0x55, 0xe4, 0x18, 0xd2, 0xb6, 0x39, 0x63, 0xdf, 0x98, 0x6d,
+	0x53, 0xf0, 0x4e, 0xc6, 0x76, 0x3c, 0x33, 0x1b, 0x6a, 0x82, 0x03, 0x03, 0x66, 0x7b, 0x28, 0xd9, 0xf8, 0x7d,
+	0x13, 0x46, 0x64, 0x43, 0xf9, 0x87, 0xf1, 0xb1, 0xcd, 0x99, 0x63, 0xe1, 0x58, 0x03, 0x66, 0x7a, 0x44, 0x66,
+	0xf3, 0xd6, 0xbe, 0x9a, 0xb4, 0x2a, 0xe7, 0xbb, 0xaa, 0x82, 0x05, 0xc3, 0xea, 0xda, 0xb4, 0x65, 0x5e, 0xf4,
+	0x96, 0x09, 0xc9, 0xd3, 0x96, 0x79, 0xd9, 0x5b, 0x26, 0xae, 0xcc, 0x4d, 0x5b, 0xe8, 0xcf, 0x3b, 0x0a, 0xb5,
+	0x90, 0xe5, 0x8a, 0x36, 0x6c, 0xfc, 0x7d, 0x45, 0x97, 0x5d, 0x68, 0x19, 0x33, 0x04, 0xd1, 0x63, 0xfb, 0xd4,
+	0x10, 0x7a, 0xec, 0xa4, 0xe0, 0xea, 0xcb, 0xe7, 0xc4, 0x49, 0xc2, 0xf2, 0x34, 0x54, 0x0c, 0xbb, 0x77, 0xf6,
+	0xc0, 0xe1, 0x49, 0xe0, 0x70, 0x1c, 0xf1, 0x3d, 0x7f, 0x0a, 0xe2, 0x7b, 0xf1, 0x34, 0xc4, 0xf7, 0x32, 0x8a,
+	0xf8, 0xf6, 0xf6, 0x6c, 0x8c, 0xef, 0x0d, 0x77, 0xb1, 0xda, 0xd1, 0xfd, 0x4e, 0xf7, 0x7e, 0xa7, 0x7b, 0x0f,
+	0x81, 0xee, 0x9d, 0x8c, 0xad, 0x5d, 0x09, 0x9b, 0x83, 0x9d, 0x00, 0xa8, 0x74, 0xf8, 0x49, 0x6f, 0x60, 0xb4,
+	0x6f, 0x78, 0x12, 0x7c, 0xde, 0xe1, 0x20, 0x90, 0x53, 0x13, 0x8f, 0xa1, 0xc6, 0xdd, 0x2f, 0xee, 0x68, 0x28,
+	0xc1, 0x1e, 0x88, 0x30, 0xd0, 0x16, 0x87, 0x8e, 0x0c, 0x4e, 0x4b, 0x08, 0x36, 0x6f, 0x94, 0x49, 0xf1, 0x40,
+	0x33, 0x21, 0x1c, 0x8e, 0x06, 0x9a, 0x33, 0xa2, 0x15, 0x7e, 0x01, 0x0a, 0xd0, 0x9f, 0xe1, 0x0e, 0x80, 0x00,
+	0x89, 0xb3, 0x58, 0x6e, 0x4a, 0x68, 0x17, 0xcc, 0x49, 0x9c, 0x61, 0x83, 0x86, 0x9d, 0x2b, 0x79, 0xa1, 0xb1,
+	0x8e, 0xeb, 0xf9, 0xb8, 0x6b, 0x2b, 0xe1, 0x4f, 0x2d, 0xc8, 0x92, 0x55, 0x94, 0x6c, 0x1e, 0xe1, 0xdf, 0x73,
+	0xb2, 0x66, 0x65, 0xc9, 0x24, 0xcd, 0x05, 0xec, 0x76, 0x64, 0x55, 0xf9, 0xa5, 0x43, 0xc1, 0xc5, 0x7a, 0x6f,
+	0x18, 0x02, 0x77, 0x63, 0xd8, 0xb1, 0xa6, 0xbb, 0x76, 0x72, 0x70, 0x9d, 0xec, 0xbb, 0xfb, 0x6a, 0x88, 0x83,
+	0x0d, 0x80, 0x6f, 0x9d, 0x4e, 0x09, 0x73, 0x6f, 0x9d, 0x6c, 0xfb, 0xc2, 0xde, 0x9e, 0x4d, 0xb9, 0x4d, 0x87,
+	0x60, 0x1d, 0x0c, 0x71, 0x15, 0x80, 0x9a, 0x9e, 0x40, 0x59, 0xed, 0x87, 0x80, 0xda, 0x07, 0xf0, 0x34, 0x11,
+	0x3d, 0x74, 0x4c, 0xb0, 0xd0, 0x51, 0xb3, 0x41, 0x07, 0x8a, 0x02, 0x85, 0x98, 0x97, 0x28, 0x0f, 0xd4, 0x9e,
+	0xe6, 0x4c, 0x12, 0x47, 0x61, 0x3a, 0x7d, 0x8c, 0x4d, 0xcc, 0xf2, 0x6b, 0x02, 0x36, 0x2f, 0x0e, 0x43, 0x84,
+	0x44, 0xff, 0xbd, 0x50, 0xcd, 0xee, 0x36, 0x1b, 0xc6, 0x2b, 0xf4, 0xc1, 0x06, 0x31, 0xcb, 0xaf, 0x43, 0xa9,
+	0xbc, 0x6a, 0x3f, 0x74, 0xc9, 0x94, 0x23, 0xe8, 0x80, 0xe7, 0xa3, 0x1e, 0x87, 0x4f, 0x76, 0x7c, 0xf3, 0x20,
+	0xc7, 0x71, 0x71, 0x1b, 0xa1, 0x3b, 0xd6, 0x0c, 0x1b, 0xc7, 0x20, 0x1a, 0xaf, 0xa1, 0xdf, 0xee, 0x91, 0x51,
+	0x7b, 0x5a, 0xe8, 0x7f, 0x3f, 0x5c, 0x7f, 0xb8, 0xf2, 0xc5, 0xb0, 0x4c, 0x70, 0x57, 0xdd, 0xdc, 0xba, 0xed,
+	0xe1, 0x2a, 0xe7, 0x79, 0x2c, 0x46, 0xdd, 0x9a, 0xae, 0x77, 0xa8, 0x9f, 0x61, 0xc1, 0x27, 0xb2, 0x85, 0x6a,
+	0xe6, 0x32, 0x37, 0x0f, 0x9b, 0xba, 0x10, 0x5b, 0xde, 0x48, 0x74, 0x63, 0xd2, 0xdd, 0xce, 0xce, 0xea, 0x88,
+	0xcd, 0xd0, 0x23, 0x52, 0xf4, 0x96, 0x34, 0xa4, 0xe7, 0xf5, 0x56, 0x89, 0x42, 0xe2, 0xdc, 0xd6, 0x0d, 0xa0,
+	0x10, 0x01, 0x1f, 0xb6, 0xb8, 0xf0, 0xb7, 0x23, 0xbd, 0x74, 0x4e, 0x9f, 0xa1, 0x10, 0xd7, 0x3a, 0xf4, 0x63,
+	0x3b, 0xa9, 0x3a, 0x48, 0x1d, 0xee, 0x78, 0xc9, 0xad, 0x6b, 0x14, 0xf2, 0x39, 0x4e, 0xf4, 0x45, 0x14, 0xb9,
+	0xcf, 0x11, 0xdf, 0x0e, 0xd3, 0xdd, 0x3e, 0x57, 0x72, 0x6b, 0xc2, 0x39, 0x41, 0x4e, 0x67, 0x11, 0x3e, 0x77,
+	0x57, 0x7d, 0xfa, 0x83, 0x9a, 0x9f, 0xec, 0x5a, 0x34, 0xf2, 0x74, 0x11, 0x51, 0x95, 0x66, 0x48, 0x01, 0xb5,
+	0xc8, 0x8a, 0xe6, 0x6a, 0xae, 0xc8, 0x10, 0x9d, 0x68, 0x3b, 0x26, 0x39, 0xb1, 0x16, 0xb9, 0xf4, 0x95, 0xe1,
+	0xc8, 0x45, 0x51, 0x10, 0x9f, 0x28, 0x91, 0x9b, 0x8a, 0x12, 0x56, 0x13, 0x26, 0x49, 0x66, 0x05, 0x83, 0xd4,
+	0xbe, 0x5b, 0xef, 0x68, 0xd8, 0x1a, 0xe7, 0x7e, 0x6d, 0x6d, 0xb4, 0x6e, 0x9d, 0x1d, 0x32, 0x25, 0xa5, 0xf5,
+	0x5a, 0xda, 0x95, 0xa3, 0xca, 0x3a, 0xab, 0x59, 0x4e, 0x50, 0x95, 0x6a, 0xda, 0xf4, 0x4f, 0x5a, 0x2a, 0x61,
+	0xea, 0x9c, 0x68, 0x9d, 0xa8, 0x49, 0x91, 0x73, 0xb2, 0xd2, 0x9f, 0x62, 0xf0, 0xa5, 0xa9, 0x8d, 0x94, 0xcf,
+	0x3f, 0x45, 0xdd, 0xd4, 0xad, 0x42, 0xb5, 0xa3, 0x4a, 0x4d, 0x1c, 0xe1, 0x69, 0x78, 0x45, 0xd0, 0x7d, 0xbb,
+	0xd9, 0x17, 0x8d, 0x12, 0x56, 0xef, 0xca, 0x1f, 0x2b, 0x14, 0xab, 0x5e, 0xd3, 0x65, 0xb6, 0x29, 0xd1, 0x93,
+	0x89, 0x47, 0x08, 0x6c, 0x92, 0xad, 0x32, 0xf5, 0x15, 0x19, 0x38, 0xf0, 0x7e, 0xbd, 0xa6, 0x05, 0xcb, 0x6a,
+	0xea, 0xa4, 0x2c, 0x79, 0xa4, 0x7a, 0x68, 0xd1, 0xfe, 0x5e, 0x94, 0x5b, 0x88, 0x49, 0x39, 0xc1, 0x83, 0xa9,
+	0x9b, 0x38, 0x4b, 0xfc, 0x28, 0xe7, 0xde, 0x15, 0x79, 0x67, 0x7b, 0xa9, 0xe1, 0xd3, 0x43, 0xd7, 0x1f, 0xb0,
+	0x13, 0xca, 0xe6, 0x1a, 0x5c, 0x9a, 0xca, 0x79, 0xaa, 0xde, 0x90, 0x12, 0x55, 0x55, 0x5c, 0xe9, 0x32, 0x2d,
+	0x7f, 0x4c, 0x7e, 0x25, 0x97, 0xba, 0x6c, 0x93, 0x2a, 0xeb, 0x02, 0x24, 0x64, 0xea, 0x93, 0x5c, 0xa7, 0x1b,
+	0x99, 0xad, 0xe8, 0x15, 0x59, 0x89, 0xfb, 0x8f, 0xf2, 0x1e, 0xf6, 0x50, 0xee, 0x21, 0xb0, 0x29, 0xb9, 0x85,
+	0x7f, 0xc9, 0x82, 0xf1, 0xac, 0xfa, 0x72, 0x47, 0x6e, 0x9b, 0x9b, 0xe2, 0xae, 0xd1, 0xd8, 0x19, 0x73, 0xb8,
+	0xb1, 0x73, 0xde, 0xca, 0x0e, 0x2d, 0xb1, 0x34, 0x3c, 0xca, 0x7f, 0x08, 0x55, 0xa7, 0x95, 0x30, 0xfa, 0x2f,
+	0xe7, 0x2a, 0x25, 0x0e, 0x1b, 0x2f, 0x4c, 0x16, 0xca, 0xed, 0x1c, 0x94, 0x7f, 0x36, 0xc7, 0x95, 0x22, 0xcc,
+	0x2e, 0x13, 0x52, 0x6c, 0x46, 0x4d, 0x0d, 0x99, 0x6a, 0x86, 0x1f, 0x81, 0x92, 0x29, 0x38, 0x87, 0x77, 0xac,
+	0x54, 0xbb, 0x2f, 0x76, 0x91, 0xb7, 0x17, 0x77, 0xb0, 0x60, 0x23, 0x9c, 0x4d, 0xb9, 0x24, 0xad, 0x1f, 0x28,
+	0x47, 0xe7, 0x86, 0xb7, 0x8c, 0xf1, 0x57, 0xe6, 0x44, 0xa1, 0x95, 0x65, 0xa7, 0x73, 0x5b, 0x95, 0x06, 0xbe,
+	0xc4, 0x88, 0x8a, 0x21, 0x55, 0x12, 0xb6, 0x24, 0x5c, 0x34, 0xcf, 0x35, 0xf8, 0x16, 0xfd, 0xd0, 0xb9, 0x02,
+	0x36, 0x0c, 0xa5, 0x05, 0x71, 0xe7, 0x7f, 0x58, 0x09, 0xdf, 0x57, 0x9c, 0x9d, 0x91, 0x82, 0x66, 0x45, 0x29,
+	0xf2, 0x4f, 0x73, 0xe5, 0xa9, 0xd0, 0xf1, 0x31, 0x8e, 0x0a, 0x41, 0x51, 0xa9, 0x90, 0xb6, 0x18, 0xf2, 0xb5,
+	0xae, 0xb2, 0x9c, 0x6a, 0x8f, 0x05, 0x0f, 0x69, 0x60, 0xa0, 0x65, 0x6d, 0xea, 0x41, 0x8e, 0x8e, 0x0b, 0x73,
+	0xd4, 0x71, 0x89, 0xad, 0x6c, 0x5f, 0x21, 0x07, 0xa8, 0x5b, 0x24, 0x6d, 0x06, 0xab, 0xd8, 0xcf, 0x49, 0x8a,
+	0x33, 0xca, 0x99, 0x9a, 0x51, 0x9a, 0xc6, 0x72, 0xf4, 0x87, 0x5f, 0x93, 0x5f, 0x1a, 0x01, 0xfa, 0x9b, 0xff,
+	0x07, 0x00, 0xce, 0xb1, 0x3e, 0x44, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6, 0xec, 0x9c, 0xcf, 0x4f, 0xe3, 0x38,
+	0x14, 0xc7, 0xcf, 0xcb, 0x5f, 0x61, 0x7a, 0x40, 0xad, 0xb6, 0x9b, 0x2e, 0xec, 0x0d, 0xb6, 0x87, 0xdd, 0x05,
+	0x56, 0xac, 0xb4, 0x23, 0x34, 0x33, 0xd2, 0x1c, 0x2a, 0x84, 0xd2, 0xc4, 0x09, 0x86, 0xd4, 0xae, 0xec, 0x94,
+	0x0a, 0x8d, 0xf8, 0xdf, 0x47, 0xcf, 0xcf, 0x4e, 0x1c, 0x6a, 0x3a, 0x2d, 0x82, 0x19, 0x98, 0x79, 0x17, 0x44,
+	0x62, 0xe7, 0xc5, 0xf1, 0xcf, 0x67, 0xf7, 0x7d, 0x3f, 0xaf, 0x9d, 0x2f, 0xd1, 0x02, 0x25, 0x46, 0x23, 0xf0,
+	0x55, 0xd8, 0x6c, 0x51, 0xd5, 0x62, 0x5e, 0x71, 0xf6, 0x5f, 0x7a, 0x9b, 0x7e, 0xc8, 0xb4, 0x98, 0xd7, 0xe1,
+	0x78, 0x70, 0xae, 0x90, 0x11, 0xb2, 0xac, 0xf0, 0x28, 0x4b, 0x49, 0xf6, 0xd7, 0xf9, 0xd9, 0xd0, 0x1a, 0x98,
+	0x5b, 0x57, 0xc6, 0x46, 0x11, 0x2f, 0xf9, 0x14, 0x66, 0x0d, 0x99, 0xa7, 0x3a, 0x37, 0x0c, 0x0a, 0xcc, 0xde,
+	0xa9, 0x9c, 0x27, 0xd7, 0x06, 0xb2, 0x27, 0x90, 0xdd, 0x3e, 0x72, 0x12, 0xda, 0x86, 0x06, 0x14, 0x39, 0xd7,
+	0x3c, 0x3f, 0xb4, 0x89, 0xbf, 0xb1, 0xbf, 0xb5, 0x5a, 0x1a, 0xae, 0x8d, 0xbb, 0x74, 0x26, 0xdc, 0xd5, 0x09,
+	0xb8, 0x21, 0x5a, 0x49, 0x77, 0x79, 0x9e, 0xea, 0x8c, 0x57, 0xee, 0xe2, 0x13, 0x9f, 0xce, 0xd3, 0xec, 0xc6,
+	0x29, 0xf6, 0x9c, 0x0c, 0xc0, 0x4d, 0x69, 0xb0, 0x30, 0xf6, 0x9a, 0xc3, 0xea, 0x1e, 0x76, 0x9f, 0xd1, 0xc8,
+	0x27, 0x07, 0xc3, 0xc0, 0xd4, 0x66, 0xe7, 0x97, 0x7b, 0xc6, 0x2b, 0xc3, 0x59, 0x60, 0x67, 0x29, 0x64, 0xae,
+	0x96, 0x71, 0x3b, 0x98, 0x96, 0x38, 0x5b, 0x63, 0x97, 0xf7, 0x28, 0x66, 0xc6, 0xf0, 0xaa, 0x88, 0x1b, 0x81,
+	0x94, 0xd6, 0x04, 0x5c, 0xb5, 0x06, 0xa2, 0x22, 0xc3, 0x2c, 0x95, 0x52, 0xd5, 0x0c, 0x07, 0x27, 0xf4, 0xaf,
+	0xbe, 0xe4, 0xc2, 0x3a, 0x60, 0x68, 0x65, 0xe8, 0xcb, 0x2c, 0x95, 0xc6, 0xf7, 0x0a, 0xc3, 0xdc, 0x3b, 0xa3,
+	0x8a, 0xca, 0xc4, 0x89, 0x10, 0x41, 0xa2, 0xe3, 0x8a, 0xeb, 0xef, 0x44, 0x4b, 0xfc, 0xe0, 0xa9, 0xb1, 0xcf,
+	0x1d, 0xb1, 0x5c, 0x18, 0x30, 0xda, 0x7d, 0x60, 0x10, 0xb0, 0x4b, 0x2c, 0x33, 0xc4, 0xdd, 0xef, 0xf7, 0x0a,
+	0x63, 0x8b, 0x17, 0x36, 0x23, 0x64, 0x18, 0x8f, 0x1b, 0x15, 0x07, 0x58, 0x2b, 0x4c, 0x23, 0xdf, 0x80, 0xcb,
+	0xd0, 0x17, 0x28, 0x8c, 0x3f, 0x7c, 0xec, 0x78, 0x44, 0x6d, 0x61, 0xc6, 0xac, 0x30, 0x47, 0xad, 0xd6, 0xf6,
+	0xbb, 0x91, 0x55, 0x36, 0xa2, 0xaa, 0x7c, 0x0b, 0xa2, 0xca, 0x9b, 0x02, 0xa8, 0x6c, 0x41, 0x4f, 0x21, 0x74,
+	0x0a, 0xa1, 0x53, 0x08, 0x9d, 0x42, 0xe8, 0x14, 0x42, 0xa7, 0x10, 0x3a, 0x65, 0x2b, 0x74, 0x4a, 0x0c, 0x9b,
+	0x42, 0xc8, 0x94, 0x6d, 0x90, 0x29, 0x9e, 0x44, 0xb2, 0xd6, 0xf1, 0x93, 0x2a, 0xe7, 0xff, 0x60, 0xbe, 0xc0,
+	0x01, 0xc4, 0x27, 0xd1, 0x3f, 0xe8, 0x1a, 0x6b, 0x6b, 0xbe, 0x13, 0x16, 0x3c, 0xf5, 0x0b, 0x68, 0x6b, 0x2f,
+	0xd1, 0x36, 0xc3, 0xa9, 0xa8, 0x2a, 0xbb, 0x2a, 0x4e, 0x83, 0x85, 0x0f, 0xdb, 0x3b, 0x52, 0xd8, 0xb5, 0x3c,
+	0x8f, 0xad, 0xd0, 0x2a, 0x1b, 0x33, 0x55, 0x56, 0x79, 0x2a, 0xab, 0xf7, 0xfd, 0x67, 0xa3, 0xb0, 0x30, 0xdc,
+	0xf9, 0x4e, 0x0c, 0xcf, 0x50, 0x2d, 0x02, 0xe1, 0xbe, 0xae, 0x7f, 0x26, 0x57, 0xba, 0x16, 0x2b, 0x1b, 0x57,
+	0xc3, 0x33, 0xa7, 0xcc, 0x60, 0xbf, 0x32, 0xd9, 0xea, 0x3b, 0xac, 0x68, 0xbf, 0x5b, 0x33, 0xdd, 0x02, 0x86,
+	0xdc, 0x94, 0x47, 0x1a, 0x33, 0x92, 0x33, 0x68, 0xce, 0x45, 0x2d, 0xaa, 0xde, 0x20, 0x4c, 0x5d, 0xad, 0xff,
+	0x4d, 0x49, 0x2e, 0xcf, 0x41, 0x71, 0xe9, 0x40, 0x59, 0xbe, 0xfe, 0x45, 0xc7, 0x7c, 0xdd, 0x17, 0xb9, 0xd4,
+	0xf8, 0x17, 0x6d, 0x82, 0x89, 0x79, 0x32, 0x22, 0xc6, 0x6e, 0xa5, 0x73, 0x38, 0x31, 0xf0, 0x79, 0x8c, 0xd5,
+	0x64, 0xb4, 0x5b, 0xf4, 0xe4, 0xa5, 0x41, 0x32, 0x04, 0x91, 0x21, 0x88, 0x0c, 0x01, 0x5f, 0x08, 0xf8, 0x42,
+	0xc0, 0x17, 0x02, 0xbe, 0x10, 0xf0, 0x85, 0x80, 0x2f, 0x04, 0x7c, 0x21, 0xe0, 0xcb, 0x4b, 0x03, 0x5f, 0x9e,
+	0x0d, 0xb7, 0x52, 0x2a, 0x42, 0xa3, 0x10, 0x1a, 0x85, 0xd0, 0x28, 0x84, 0x46, 0x21, 0x34, 0xca, 0x6b, 0x46,
+	0xa3, 0xec, 0x6f, 0xcd, 0x46, 0xd9, 0x27, 0x38, 0x0a, 0xc1, 0x51, 0x08, 0x8e, 0xf2, 0x14, 0x38, 0x0a, 0xf4,
+	0xd9, 0x21, 0xea, 0xd6, 0x7c, 0xf3, 0xd8, 0x40, 0x31, 0x1b, 0x0e, 0x6c, 0xe0, 0x4f, 0xad, 0x58, 0x21, 0x34,
+	0x67, 0x8b, 0x39, 0xfc, 0xbb, 0xcf, 0x66, 0xa2, 0xaa, 0x84, 0xe1, 0x99, 0x92, 0x39, 0xe3, 0xa9, 0xae, 0xee,
+	0x08, 0xaf, 0x42, 0x78, 0x15, 0xc2, 0xab, 0x10, 0x5e, 0x85, 0xf0, 0x2a, 0x84, 0x57, 0x21, 0xbc, 0x0a, 0xe1,
+	0x55, 0x08, 0xaf, 0xf2, 0xa6, 0xf0, 0x2a, 0xc4, 0x46, 0x21, 0x36, 0x0a, 0x81, 0x4d, 0x7e, 0x5a, 0xb0, 0x09,
+	0x51, 0x49, 0x88, 0x4a, 0x42, 0x54, 0x12, 0xa2, 0x92, 0x10, 0x95, 0x84, 0xa8, 0x24, 0x44, 0x25, 0x21, 0x2a,
+	0x09, 0x51, 0x49, 0x42, 0x2a, 0x09, 0x11, 0x49, 0x36, 0x27, 0x92, 0x10, 0x8d, 0x84, 0x68, 0x24, 0x44, 0x23,
+	0x21, 0x1a, 0xc9, 0x8f, 0x47, 0x23, 0xc1, 0x71, 0xfb, 0x64, 0x1a, 0x49, 0x67, 0xb2, 0xa9, 0x95, 0xb2, 0x01,
+	0x66, 0x84, 0x17, 0x21, 0xbc, 0xc8, 0x7a, 0xbc, 0x08, 0xca, 0x8b, 0x76, 0x30, 0x10, 0x58, 0x15, 0xa0, 0x8f,
+	0x5d, 0x54, 0x2b, 0x6c, 0x06, 0xb6, 0xb7, 0xb7, 0xca, 0x66, 0x88, 0xdc, 0x4b, 0x66, 0x29, 0x84, 0x50, 0x8e,
+	0xc7, 0xde, 0x4e, 0x98, 0xc7, 0x0b, 0x1d, 0x23, 0xf7, 0x92, 0x5b, 0xae, 0x8d, 0x8d, 0x73, 0xb4, 0x89, 0xbb,
+	0x8f, 0xa4, 0x26, 0xbc, 0x21, 0x75, 0x60, 0x65, 0x42, 0xd9, 0x7d, 0xa6, 0x60, 0xf2, 0x66, 0x7f, 0xb2, 0x3f,
+	0x82, 0x96, 0x01, 0x2f, 0x98, 0x63, 0x57, 0x5a, 0x98, 0xb4, 0xe4, 0x87, 0xac, 0x54, 0x97, 0xd7, 0xe6, 0x12,
+	0x86, 0xd6, 0x25, 0x44, 0x3a, 0xb2, 0x09, 0xfc, 0xcb, 0xa6, 0x42, 0xa6, 0xfa, 0xee, 0x82, 0x4d, 0x9a, 0x7a,
+	0xbf, 0x70, 0xbd, 0xcb, 0xbf, 0xc3, 0x86, 0xba, 0xa1, 0x1b, 0x6e, 0x7b, 0x44, 0xd0, 0xee, 0xd0, 0x5f, 0xff,
+	0x55, 0x58, 0xf1, 0xa5, 0xf2, 0xe2, 0xa4, 0x4e, 0xe1, 0x8c, 0xdd, 0xec, 0x1c, 0xf8, 0x2c, 0x28, 0x54, 0x72,
+	0x13, 0x7c, 0x6a, 0x8c, 0x28, 0x65, 0xff, 0x33, 0xfb, 0xf8, 0xff, 0xf9, 0xf1, 0xd9, 0xfb, 0xc3, 0x56, 0x16,
+	0xa6, 0x4c, 0x6f, 0x90, 0xd4, 0xb3, 0x39, 0x2a, 0x25, 0xef, 0x87, 0x8d, 0x4d, 0x58, 0x09, 0xbc, 0x29, 0x54,
+	0x38, 0x85, 0xc5, 0x84, 0x94, 0xd0, 0xd5, 0x46, 0x07, 0xba, 0x16, 0x56, 0x26, 0x0e, 0xe3, 0x22, 0xcd, 0x4f,
+	0x45, 0x85, 0xe1, 0x6a, 0x61, 0x29, 0x27, 0x07, 0x17, 0xf0, 0x53, 0x8e, 0xea, 0x84, 0xb7, 0x0e, 0x92, 0xfa,
+	0x8a, 0xcb, 0x7e, 0xdf, 0x1d, 0x0a, 0x36, 0xf3, 0xba, 0x7f, 0x50, 0x39, 0xa5, 0x54, 0x6f, 0x18, 0xaa, 0xac,
+	0xc0, 0x63, 0xf2, 0xb0, 0x16, 0x48, 0x35, 0x4c, 0x14, 0x4c, 0x3e, 0x08, 0x41, 0x65, 0xc2, 0x30, 0xd7, 0xa5,
+	0xbb, 0xaa, 0x2c, 0xfb, 0x6b, 0x3a, 0x08, 0x3d, 0x76, 0x4b, 0xf5, 0x60, 0xc0, 0xc2, 0x84, 0x9c, 0x83, 0x2e,
+	0x5a, 0x65, 0x37, 0x43, 0x1b, 0xbd, 0x8a, 0xde, 0x98, 0x90, 0x96, 0xab, 0xa1, 0x34, 0x46, 0xc6, 0xda, 0xc8,
+	0xd1, 0x5a, 0xa7, 0x19, 0x47, 0xe7, 0x33, 0x36, 0x7c, 0x70, 0xf8, 0xfd, 0xee, 0x07, 0x58, 0x64, 0x0c, 0xdd,
+	0x07, 0x8b, 0xa2, 0x1b, 0x4f, 0xa5, 0xdd, 0xca, 0xb8, 0xda, 0x48, 0x9a, 0xfd, 0x92, 0xed, 0x16, 0x83, 0xc4,
+	0x1e, 0x81, 0xf6, 0xf1, 0x08, 0xd4, 0x57, 0x54, 0xb7, 0x1b, 0x36, 0x67, 0x9f, 0xb1, 0x7e, 0x35, 0x38, 0x6a,
+	0xb0, 0x3f, 0x3b, 0x5f, 0x06, 0x00, 0x28, 0x24, 0xd1, 0x6b, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6, 0xec, 0x9c,
+	0xd1, 0x4f, 0xdb, 0x3e, 0x10, 0xc7, 0x9f, 0x7f, 0xfc, 0x15, 0xa6, 0x0f, 0xa8, 0xd5, 0xaf, 0x4b, 0x37, 0xf6,
+	0x06, 0xeb, 0xc3, 0x36, 0x60, 0x62, 0xd2, 0x26, 0xb4, 0x4d, 0xda, 0x43, 0x85, 0x50, 0x9a, 0x38, 0xc1, 0x90,
+	0xda, 0x95, 0x9d, 0x52, 0xa1, 0x89, 0xff, 0x7d, 0x3a, 0x9f, 0x9d, 0x38, 0xd4, 0x40, 0x3b, 0xc1, 0xd8, 0xb4,
+	0x7b, 0x41, 0x24, 0x76, 0x2e, 0x8e, 0x7d, 0x76, 0x2e, 0xee, 0x7d, 0x3f, 0x7f, 0x3a, 0x5f, 0xa2, 0x05, 0x4a,
+	0x8c, 0x46, 0x10, 0xab, 0xb0, 0xd9, 0xa2, 0xaa, 0xc5, 0xbc, 0xe2, 0xec, 0x63, 0x7a, 0x95, 0x7e, 0xcd, 0xb4,
+	0x98, 0xd7, 0xe1, 0x7c, 0x70, 0xa1, 0x90, 0x11, 0xb2, 0xac, 0x70, 0x2b, 0x4b, 0x49, 0xf6, 0xf6, 0xe4, 0x78,
+	0x68, 0x0d, 0xcc, 0x6d, 0x28, 0x63, 0xb3, 0x88, 0x97, 0x7c, 0x0a, 0xab, 0x86, 0xcc, 0x53, 0x9d, 0x1b, 0x06,
+	0x0d, 0x66, 0x9f, 0x55, 0xce, 0x93, 0x0b, 0x03, 0xd5, 0x13, 0xa8, 0x6e, 0x2f, 0x39, 0x0c, 0x6d, 0xc3, 0x00,
+	0x8a, 0x9c, 0x6b, 0x9e, 0xef, 0xd9, 0xc2, 0x17, 0xec, 0x9d, 0x56, 0x4b, 0xc3, 0xb5, 0x71, 0x87, 0xce, 0x84,
+	0x3b, 0x3a, 0x84, 0x30, 0x44, 0x2b, 0xe9, 0x0e, 0x4f, 0x52, 0x9d, 0xf1, 0xca, 0x1d, 0x7c, 0xe7, 0xd3, 0x79,
+	0x9a, 0x5d, 0x3a, 0xc5, 0x9e, 0x93, 0x01, 0xb8, 0x25, 0x0d, 0x5e, 0x8c, 0xbd, 0x66, 0xb3, 0xba, 0x87, 0xee,
+	0x33, 0x1a, 0xf9, 0xe2, 0x60, 0x1a, 0x98, 0xda, 0x6c, 0xfd, 0x77, 0xc3, 0x78, 0x65, 0x38, 0x0b, 0xec, 0x2c,
+	0x85, 0xcc, 0xd5, 0x32, 0x6e, 0x07, 0xcb, 0x12, 0x67, 0x6b, 0xec, 0xea, 0xee, 0xc7, 0xcc, 0x18, 0x5e, 0x15,
+	0x71, 0x23, 0x50, 0xd2, 0x9a, 0x80, 0xa3, 0xd6, 0x40, 0x54, 0x64, 0x98, 0xa5, 0x52, 0xaa, 0x9a, 0xe1, 0xe4,
+	0x04, 0xff, 0xea, 0x4b, 0x2e, 0x6c, 0x00, 0x86, 0x56, 0x86, 0xbe, 0xcd, 0x52, 0x69, 0xbc, 0xaf, 0x30, 0xcc,
+	0xdd, 0x33, 0xaa, 0xa8, 0x4c, 0x9c, 0x08, 0x11, 0x24, 0x3a, 0xae, 0xb9, 0xfe, 0x4c, 0xb4, 0xc5, 0xb7, 0xae,
+	0x1a, 0xfb, 0xda, 0x11, 0xcb, 0x85, 0x01, 0xa3, 0xdd, 0x0b, 0x06, 0x01, 0xbb, 0xc4, 0x32, 0x43, 0xdc, 0xf9,
+	0x7e, 0xaf, 0x30, 0xb6, 0x79, 0xe1, 0x30, 0x42, 0x85, 0xf1, 0xb8, 0x51, 0x71, 0x80, 0xb5, 0xc2, 0x34, 0xf2,
+	0x0d, 0x38, 0x0c, 0x63, 0x81, 0xc2, 0xf8, 0xcd, 0xc7, 0x4e, 0x44, 0xd4, 0x36, 0x66, 0xcc, 0x0a, 0xb3, 0xdf,
+	0x6a, 0x6d, 0x9f, 0x8d, 0xac, 0xb2, 0x16, 0x55, 0xe5, 0x77, 0x10, 0x55, 0xfe, 0x2a, 0x80, 0xca, 0x06, 0xf4,
+	0x14, 0x42, 0xa7, 0x10, 0x3a, 0x85, 0xd0, 0x29, 0x84, 0x4e, 0x21, 0x74, 0x0a, 0xa1, 0x53, 0x36, 0x42, 0xa7,
+	0xc4, 0xb0, 0x29, 0x84, 0x4c, 0xd9, 0x04, 0x99, 0xe2, 0x49, 0x24, 0xf7, 0x06, 0x7e, 0x52, 0xe5, 0xfc, 0x3d,
+	0xd6, 0x0b, 0x02, 0x40, 0xbc, 0x12, 0xe3, 0x83, 0xae, 0xb1, 0xb6, 0xe7, 0x3b, 0x69, 0xc1, 0x53, 0xff, 0x02,
+	0x6d, 0xed, 0x25, 0xda, 0x56, 0x38, 0x12, 0x55, 0x65, 0xdf, 0x8a, 0xd3, 0xe0, 0xc5, 0x87, 0xe3, 0x1d, 0x69,
+	0xec, 0xbd, 0x3c, 0x8f, 0x8d, 0xd0, 0x2a, 0x6b, 0x33, 0x55, 0x56, 0x79, 0x2a, 0xab, 0xe7, 0xfd, 0x63, 0xa3,
+	0xb0, 0x30, 0xfc, 0xf2, 0x9d, 0x18, 0x9e, 0xa1, 0x5a, 0x04, 0xd2, 0x7d, 0x9d, 0x7f, 0x26, 0xe7, 0xba, 0x16,
+	0x2b, 0x1f, 0xae, 0x86, 0x67, 0x4e, 0x99, 0xc1, 0xfe, 0x67, 0xb2, 0xd5, 0x77, 0x58, 0xd1, 0x7e, 0xb7, 0x67,
+	0xba, 0x0d, 0x0c, 0xb9, 0x29, 0x77, 0x0c, 0x66, 0xa4, 0x66, 0x30, 0x9c, 0x8b, 0x5a, 0x54, 0xbd, 0x41, 0x58,
+	0xba, 0xda, 0xff, 0xeb, 0x92, 0x5c, 0x1e, 0x83, 0xe2, 0xd2, 0x81, 0xb2, 0x3c, 0xfc, 0x44, 0x07, 0xfc, 0xbe,
+	0x27, 0x72, 0xa5, 0xf1, 0x27, 0x5a, 0x07, 0x13, 0xf3, 0xcb, 0x88, 0x18, 0xfb, 0x29, 0x9d, 0xc3, 0x8e, 0x81,
+	0xaf, 0x63, 0xac, 0x26, 0xa3, 0xfd, 0x44, 0x4f, 0x9e, 0x1a, 0x24, 0x43, 0x10, 0x19, 0x82, 0xc8, 0x10, 0xf0,
+	0x85, 0x80, 0x2f, 0x04, 0x7c, 0x21, 0xe0, 0x0b, 0x01, 0x5f, 0x08, 0xf8, 0x42, 0xc0, 0x17, 0x02, 0xbe, 0x3c,
+	0x35, 0xf0, 0xe5, 0xd1, 0x70, 0x2b, 0xa5, 0x22, 0x34, 0x0a, 0xa1, 0x51, 0x08, 0x8d, 0x42, 0x68, 0x14, 0x42,
+	0xa3, 0x10, 0x1a, 0x85, 0xd0, 0x28, 0x84, 0x46, 0xf9, 0xe7, 0xd1, 0x28, 0xe0, 0xb3, 0x43, 0x54, 0xad, 0xf9,
+	0xe1, 0xb1, 0x69, 0x62, 0x36, 0x19, 0xd8, 0xc0, 0x9f, 0x5a, 0xb1, 0x42, 0x68, 0xce, 0x16, 0x73, 0xf8, 0xf7,
+	0x15, 0x9b, 0x89, 0xaa, 0x12, 0x86, 0x67, 0x4a, 0xe6, 0x8c, 0xa7, 0xba, 0xba, 0x26, 0xb8, 0x0a, 0xc1, 0x55,
+	0x08, 0xae, 0x42, 0x70, 0x15, 0x82, 0xab, 0x10, 0x5c, 0x85, 0xe0, 0x2a, 0x04, 0x57, 0x21, 0xb8, 0x0a, 0xc1,
+	0x55, 0x08, 0xae, 0x42, 0x70, 0x15, 0x82, 0xab, 0x10, 0x5c, 0x85, 0xe0, 0x2a, 0x04, 0x57, 0x21, 0xb8, 0x0a,
+	0xc1, 0x55, 0x08, 0xae, 0x42, 0x70, 0x15, 0x82, 0xab, 0x10, 0x5c, 0x85, 0xe0, 0x2a, 0xcf, 0x04, 0x57, 0x21,
+	0xb0, 0xca, 0xfa, 0x60, 0x15, 0x82, 0xaa, 0x10, 0x54, 0x85, 0xa0, 0x2a, 0x04, 0x55, 0x79, 0x00, 0xaa, 0x42,
+	0x44, 0x10, 0x22, 0x82, 0xac, 0x10, 0x41, 0x50, 0x11, 0xb4, 0x85, 0xb9, 0xbb, 0xaa, 0x00, 0x49, 0xeb, 0xa2,
+	0x5a, 0xc1, 0x29, 0xb0, 0x9d, 0x9d, 0x55, 0x9c, 0x42, 0xe4, 0x5c, 0x32, 0x4b, 0x21, 0xeb, 0x71, 0x3c, 0xf6,
+	0x76, 0xc2, 0x3a, 0x5e, 0x9b, 0x18, 0x39, 0x97, 0x5c, 0x71, 0x6d, 0x6c, 0x6a, 0xa2, 0x2d, 0xdc, 0xbe, 0xa3,
+	0x34, 0xe1, 0x0d, 0x5c, 0x03, 0x3b, 0x13, 0xda, 0xee, 0x2b, 0x05, 0x0b, 0x15, 0x7b, 0xc3, 0x5e, 0x07, 0x23,
+	0x03, 0x11, 0x1f, 0x47, 0x57, 0x5a, 0x98, 0xb4, 0xe4, 0x7b, 0xac, 0x54, 0x67, 0x17, 0xe6, 0x0c, 0x52, 0xc1,
+	0xce, 0x20, 0x39, 0x91, 0x4d, 0xe0, 0x5f, 0x36, 0x15, 0x32, 0xd5, 0xd7, 0xa7, 0x6c, 0xd2, 0xf4, 0xfb, 0xa9,
+	0xf3, 0x2e, 0x7f, 0x0f, 0x9b, 0x9d, 0x86, 0x21, 0xa7, 0xf5, 0x88, 0x60, 0xdc, 0xc1, 0x5f, 0x3f, 0x28, 0xec,
+	0xf8, 0x52, 0x79, 0x3d, 0x51, 0xa7, 0x71, 0xc6, 0x06, 0xf6, 0xbb, 0xbe, 0x0a, 0x6a, 0x8b, 0xdc, 0x62, 0x96,
+	0x1a, 0x23, 0x4a, 0xd9, 0xff, 0xc1, 0xbe, 0x7d, 0x3a, 0x39, 0x38, 0xfe, 0xb2, 0xd7, 0x2a, 0xb9, 0x94, 0xe9,
+	0x0d, 0x92, 0x7a, 0x36, 0x47, 0x71, 0xe3, 0xcd, 0xb0, 0xb1, 0x09, 0xab, 0x9e, 0x37, 0x85, 0xa2, 0xa4, 0xb0,
+	0x99, 0x50, 0x12, 0x86, 0x95, 0x18, 0x2c, 0xd6, 0xc2, 0x2a, 0xbb, 0x61, 0x5e, 0xa4, 0xf9, 0x91, 0xa8, 0x30,
+	0xc3, 0x2c, 0x6c, 0xe5, 0x64, 0xf7, 0x14, 0x7e, 0x7f, 0x51, 0x9d, 0x8c, 0xd4, 0x41, 0x52, 0x9f, 0x73, 0xd9,
+	0xef, 0xbb, 0x3d, 0xb4, 0x66, 0x0d, 0xf3, 0x17, 0x2a, 0x27, 0x6e, 0xea, 0x0d, 0x43, 0x61, 0x14, 0x44, 0x07,
+	0x9e, 0xaf, 0x02, 0xa5, 0x86, 0x89, 0x82, 0xc9, 0x5b, 0x59, 0xa3, 0x4c, 0x18, 0xe6, 0x5c, 0xba, 0x2b, 0xa4,
+	0xb2, 0x3f, 0x81, 0x83, 0x36, 0x63, 0xbb, 0x54, 0xb7, 0x26, 0x2c, 0x44, 0x13, 0x39, 0x48, 0x99, 0x55, 0x76,
+	0x39, 0xb4, 0x09, 0xa7, 0x18, 0x79, 0x08, 0x69, 0x51, 0x18, 0x4a, 0x63, 0x32, 0xab, 0x4d, 0xf6, 0xac, 0x75,
+	0x9a, 0x71, 0x0c, 0xb4, 0x62, 0xd3, 0x07, 0xa7, 0xdf, 0x4b, 0x3f, 0xc1, 0x22, 0x73, 0xe8, 0x26, 0x78, 0x01,
+	0xb8, 0xf9, 0x54, 0xda, 0xb0, 0xdd, 0xf5, 0x46, 0xd2, 0x7c, 0x1b, 0x58, 0xb7, 0x18, 0x24, 0x76, 0xc7, 0xb0,
+	0x8f, 0x3b, 0x86, 0xbe, 0xa3, 0xba, 0x6e, 0xd8, 0xec, 0xf3, 0xc5, 0xfc, 0x6a, 0xb0, 0xdf, 0x90, 0x7a, 0xb6,
+	0x7e, 0x0e, 0x00, 0x0a, 0xd2, 0xb5, 0x49, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6, 0xec, 0x9d, 0x5f, 0x6f, 0xdb,
+	0x36, 0x17, 0xc6, 0xaf, 0x9b, 0x4f, 0xc1, 0xfa, 0xa2, 0xb0, 0x51, 0xbf, 0x72, 0x93, 0xbc, 0x57, 0xee, 0x72,
+	0xb1, 0x2d, 0xed, 0xd0, 0x01, 0x5b, 0x83, 0xad, 0x77, 0x41, 0x10, 0x28, 0x16, 0xed, 0xb0, 0x93, 0x49, 0x83,
+	0x94, 0x63, 0x14, 0x43, 0xbe, 0xfb, 0x70, 0x0e, 0x49, 0x99, 0xb2, 0x28, 0x4b, 0xb6, 0x55, 0x59, 0x4e, 0x75,
+	0x13, 0xc4, 0x22, 0x75, 0x48, 0xf0, 0x9f, 0x68, 0xea, 0xf9, 0x3d, 0x6e, 0xbb, 0xbf, 0xc4, 0xda, 0x50, 0x62,
+	0x34, 0x82, 0xbd, 0x0a, 0x99, 0x2f, 0xe3, 0x84, 0x2d, 0x62, 0x4a, 0x7e, 0x0f, 0x9f, 0xc2, 0xbf, 0x27, 0x92,
+	0x2d, 0x12, 0x77, 0x3e, 0x98, 0xad, 0x90, 0x62, 0x7c, 0x16, 0xeb, 0xa3, 0x2c, 0xc1, 0xc9, 0xcf, 0x37, 0x9f,
+	0x86, 0x18, 0x60, 0x81, 0x5b, 0x19, 0x54, 0x11, 0xaf, 0xe8, 0x03, 0xac, 0x1a, 0x3c, 0x0a, 0x65, 0xa4, 0x08,
+	0x54, 0x98, 0xfc, 0x29, 0x22, 0x1a, 0x7c, 0x55, 0x90, 0x3d, 0x80, 0xec, 0x78, 0xcb, 0x07, 0x37, 0x36, 0x74,
+	0x20, 0x8b, 0xa8, 0xa4, 0xd1, 0x18, 0x13, 0xff, 0x47, 0x7e, 0x91, 0x62, 0xa5, 0xa8, 0x54, 0xe6, 0xa3, 0x09,
+	0x61, 0x3e, 0x7d, 0x80, 0x6d, 0x88, 0x14, 0xdc, 0x7c, 0xbc, 0x09, 0xe5, 0x84, 0xc6, 0x86, 0xd1, 0x33, 0xc2,
+	0x7f, 0xb3, 0x88, 0xc1, 0xa3, 0xb0, 0x97, 0x1e, 0x4f, 0xf7, 0xf4, 0x80, 0x19, 0x8d, 0x6c, 0xb2, 0x33, 0xf0,
+	0x55, 0xa2, 0xce, 0x5e, 0x3d, 0x13, 0x1a, 0x2b, 0x4a, 0x9c, 0x38, 0x2b, 0xc6, 0x23, 0xb1, 0xf2, 0xc7, 0xd1,
+	0x69, 0x81, 0x89, 0x75, 0x65, 0xf2, 0xbe, 0xf7, 0x85, 0x51, 0x34, 0x9e, 0xfa, 0x83, 0x40, 0xca, 0x3a, 0x04,
+	0x7c, 0x5a, 0x07, 0xf0, 0x62, 0x85, 0x93, 0x90, 0x73, 0x91, 0x10, 0x3d, 0x1d, 0x61, 0x44, 0xf5, 0x39, 0x65,
+	0xb8, 0xe5, 0xd2, 0x51, 0x86, 0xb6, 0xce, 0x5c, 0x48, 0x5d, 0x2e, 0x53, 0xc4, 0x94, 0xe9, 0x65, 0x28, 0x03,
+	0x83, 0x1d, 0x02, 0x94, 0x63, 0xaa, 0x6b, 0xaf, 0x78, 0x6b, 0xbc, 0x71, 0xd7, 0x95, 0xcd, 0xed, 0x89, 0x3c,
+	0x55, 0x10, 0x34, 0x7b, 0x43, 0x26, 0x08, 0xda, 0x84, 0x98, 0x84, 0x7e, 0x6f, 0xaa, 0xbc, 0xf5, 0xab, 0x62,
+	0x3a, 0xd2, 0x84, 0xe1, 0xc8, 0x49, 0xf9, 0x8b, 0xec, 0x60, 0x2e, 0x72, 0x6a, 0xce, 0x22, 0xb9, 0x29, 0xe1,
+	0xf5, 0xb8, 0xa9, 0xd1, 0x5c, 0xa4, 0x82, 0xa1, 0x83, 0x53, 0x54, 0x35, 0x0b, 0x9e, 0x42, 0x13, 0x1e, 0xa7,
+	0x2a, 0xe9, 0x8b, 0x80, 0x43, 0x9c, 0x21, 0x9a, 0xa8, 0x99, 0xd7, 0x37, 0x24, 0xdf, 0xac, 0xce, 0x2d, 0x1e,
+	0x9f, 0x82, 0x0c, 0xdc, 0x6e, 0x7a, 0x4d, 0x44, 0xf4, 0x57, 0xbc, 0xea, 0x2e, 0x13, 0x3a, 0x9f, 0xae, 0x6c,
+	0xe6, 0x56, 0xc7, 0xc5, 0x20, 0xa3, 0x17, 0x7b, 0xb0, 0xb5, 0x59, 0xc7, 0x0b, 0x24, 0x66, 0xf8, 0xc8, 0xe2,
+	0x18, 0x07, 0xc3, 0x43, 0x49, 0xdd, 0x72, 0xbc, 0x7b, 0xfe, 0xba, 0x2d, 0x5d, 0x83, 0x1f, 0x6e, 0xbb, 0xdf,
+	0x2a, 0x3a, 0xd1, 0x6a, 0x5e, 0x90, 0x63, 0x19, 0xcb, 0x85, 0xe0, 0x51, 0x26, 0x2c, 0xb7, 0xb1, 0x50, 0x74,
+	0x62, 0x94, 0xb3, 0xe4, 0x2d, 0xe1, 0x6b, 0xfd, 0x2d, 0x42, 0x95, 0xdb, 0x2a, 0x98, 0x83, 0xe0, 0xf3, 0xd7,
+	0xdd, 0x36, 0x5c, 0x26, 0x2c, 0xee, 0x0d, 0xdc, 0xd4, 0x82, 0x98, 0x19, 0xc6, 0x3d, 0x7f, 0xbd, 0x20, 0xe6,
+	0x35, 0x75, 0x63, 0xe2, 0xe3, 0x3e, 0x82, 0x5d, 0x8d, 0x45, 0xdd, 0x15, 0xea, 0x46, 0xd7, 0xdb, 0x88, 0xe0,
+	0x7b, 0xc3, 0xee, 0x1d, 0xe8, 0x7e, 0x6c, 0xd0, 0x5d, 0x1f, 0x4a, 0xba, 0xca, 0x67, 0xf3, 0x0d, 0x54, 0xaf,
+	0x66, 0x48, 0x08, 0x19, 0x10, 0x69, 0xf5, 0x48, 0x39, 0x0e, 0x2b, 0xaa, 0xf0, 0x68, 0x07, 0x88, 0x1b, 0xf7,
+	0x30, 0xc7, 0x65, 0xff, 0x76, 0x39, 0xe1, 0x7c, 0xae, 0xcc, 0xdd, 0xcf, 0xe9, 0xbc, 0x3f, 0xa8, 0x00, 0xdd,
+	0x17, 0xe4, 0xdb, 0x8b, 0xb8, 0xaf, 0x48, 0xc7, 0xeb, 0x22, 0x77, 0x41, 0xe3, 0xd3, 0x3b, 0x9a, 0xe6, 0xe2,
+	0xd3, 0x82, 0x8f, 0x0c, 0xc5, 0xfb, 0x9a, 0xac, 0x65, 0x44, 0x7c, 0x09, 0x06, 0x5f, 0x3c, 0xce, 0xfc, 0x0c,
+	0xbc, 0x2f, 0x7f, 0x35, 0x00, 0x7e, 0x0d, 0xb1, 0xbf, 0xab, 0xbb, 0xf8, 0xf3, 0x0a, 0xc5, 0xa7, 0x77, 0xee,
+	0x0a, 0xdf, 0x5b, 0xf0, 0x7b, 0x83, 0xf8, 0x4e, 0x47, 0xd1, 0xf8, 0xec, 0xd5, 0xb6, 0x02, 0xb6, 0x36, 0x0d,
+	0x46, 0x82, 0xb5, 0x71, 0x5c, 0x6b, 0x7b, 0x5c, 0x94, 0x96, 0x09, 0xc9, 0xf5, 0x96, 0x79, 0x59, 0x5a, 0x26,
+	0x1e, 0xe5, 0xd7, 0x5b, 0xe8, 0xff, 0xb7, 0x14, 0xea, 0x78, 0x1c, 0x48, 0x9a, 0x9a, 0x69, 0xdc, 0x4b, 0x3a,
+	0xcd, 0xbb, 0x1c, 0x60, 0x06, 0xaf, 0x57, 0x81, 0x7b, 0xab, 0xcf, 0xab, 0x20, 0x93, 0x82, 0xc7, 0xb5, 0x4f,
+	0x83, 0x4c, 0x12, 0x96, 0x67, 0x5c, 0x08, 0xe0, 0x75, 0xbf, 0xbb, 0xe8, 0xec, 0xe5, 0x34, 0x50, 0xec, 0x09,
+	0x70, 0xbe, 0x8f, 0x27, 0xc0, 0xc5, 0x7e, 0x9e, 0x00, 0x97, 0x85, 0x9e, 0x00, 0xa5, 0x3d, 0x5b, 0x64, 0x08,
+	0xe0, 0xef, 0x62, 0x2d, 0x01, 0xe9, 0xec, 0x00, 0x3a, 0x3b, 0x80, 0x36, 0xd8, 0x01, 0xd4, 0x06, 0xe3, 0xcf,
+	0x44, 0x07, 0xce, 0x77, 0xe0, 0xfc, 0x06, 0x27, 0xbf, 0xb1, 0x9f, 0x6e, 0x1a, 0x92, 0x87, 0x47, 0x55, 0x7b,
+	0x50, 0xf7, 0xba, 0xc9, 0x76, 0x5f, 0xe3, 0x36, 0x8b, 0xb5, 0x5b, 0x1a, 0xbc, 0x04, 0x16, 0xf7, 0xb4, 0x45,
+	0xdb, 0xd1, 0xf0, 0x7a, 0x49, 0xf0, 0x74, 0x23, 0xd0, 0x7e, 0x0c, 0xbc, 0xa3, 0xbe, 0x3b, 0xea, 0x5b, 0xeb,
+	0x84, 0xdf, 0xc2, 0xb7, 0xd0, 0xd1, 0xc8, 0xe9, 0x1e, 0x7c, 0x03, 0x8e, 0x3a, 0x27, 0x05, 0x7f, 0x12, 0x41,
+	0xa6, 0x4c, 0x52, 0xb2, 0x5c, 0xc0, 0xbf, 0xe7, 0x64, 0xce, 0xe2, 0x98, 0x29, 0x3a, 0x11, 0x3c, 0x22, 0x34,
+	0x94, 0xf1, 0xb7, 0x1c, 0x37, 0x5e, 0x34, 0x0f, 0x1a, 0x86, 0xc6, 0x7d, 0xe7, 0x1d, 0x2f, 0x0d, 0x10, 0x3f,
+	0x98, 0x07, 0xaf, 0x0f, 0x56, 0x6e, 0x0b, 0x9b, 0xdc, 0x18, 0x8a, 0xdc, 0x6a, 0x08, 0xb9, 0x09, 0xe6, 0xb8,
+	0x26, 0x80, 0xf7, 0x94, 0x78, 0xdd, 0x93, 0xc6, 0x73, 0x7f, 0x10, 0x1a, 0xf7, 0x70, 0x0e, 0x37, 0x73, 0xa2,
+	0xb3, 0x13, 0x84, 0x5b, 0x46, 0xd0, 0x16, 0x45, 0x3e, 0x26, 0x3e, 0x7b, 0xb2, 0xb4, 0xec, 0xe1, 0xac, 0xa3,
+	0xaf, 0x3b, 0xaa, 0x81, 0x8e, 0x65, 0x94, 0x62, 0x51, 0xe4, 0xe3, 0xe0, 0xad, 0xa7, 0x47, 0xb3, 0xbe, 0xe0,
+	0xbe, 0x3d, 0x1c, 0x3f, 0x6d, 0x3f, 0x6d, 0xfa, 0xe2, 0xe1, 0xd2, 0xd3, 0x62, 0x49, 0x7d, 0x23, 0xd6, 0x6e,
+	0xd0, 0x77, 0xc1, 0x46, 0x8f, 0x45, 0x8b, 0x36, 0x07, 0x87, 0xba, 0xa7, 0x08, 0xe5, 0xf3, 0xbc, 0x2d, 0x20,
+	0x60, 0xe9, 0x5a, 0xd7, 0x38, 0xf2, 0xd9, 0x1c, 0xe1, 0xf9, 0xd2, 0x7b, 0xac, 0x09, 0x6e, 0xb3, 0x04, 0x9f,
+	0xdc, 0x60, 0x20, 0xbf, 0x7c, 0xbe, 0xfe, 0x3c, 0xde, 0x84, 0xe0, 0x98, 0xe0, 0xfb, 0x53, 0x8d, 0x5b, 0x88,
+	0x46, 0x38, 0xdc, 0xf7, 0xab, 0xac, 0x36, 0x58, 0xbb, 0x9c, 0xb2, 0x6a, 0x07, 0x31, 0xd4, 0x8f, 0x8e, 0xe0,
+	0x75, 0xf4, 0x5d, 0x15, 0xfa, 0x6e, 0x77, 0xd0, 0x2e, 0x1b, 0x14, 0xf2, 0x65, 0xd6, 0x81, 0xef, 0xc2, 0xe1,
+	0x1d, 0x82, 0xdc, 0x55, 0xa3, 0xed, 0x0e, 0x05, 0xed, 0x3a, 0x4a, 0xac, 0xa3, 0xc4, 0x72, 0x94, 0x98, 0x16,
+	0x5a, 0xe7, 0x69, 0x9a, 0x37, 0x6f, 0x72, 0xd7, 0x82, 0x79, 0x08, 0x42, 0x86, 0xab, 0x2b, 0xa0, 0x0e, 0x96,
+	0x71, 0x36, 0x8f, 0xfd, 0xfd, 0x3e, 0xcf, 0xb5, 0xe0, 0x89, 0x4a, 0x85, 0x6a, 0x03, 0x4c, 0x7c, 0x5d, 0x90,
+	0x1a, 0xd0, 0x94, 0xa6, 0xd2, 0x2d, 0x05, 0x15, 0xb3, 0x99, 0x9c, 0x05, 0x87, 0xfc, 0x44, 0x2e, 0x9d, 0x66,
+	0x87, 0xe7, 0x30, 0xd5, 0xe3, 0x64, 0xa9, 0xc2, 0x19, 0x1d, 0x93, 0x99, 0xb8, 0xff, 0xaa, 0xee, 0xe1, 0x5d,
+	0xf1, 0x3d, 0xe8, 0x0d, 0xc8, 0x2d, 0xfc, 0x4b, 0x1e, 0x18, 0x0f, 0xe5, 0xb7, 0x3b, 0x72, 0x9b, 0x36, 0xea,
+	0x9d, 0x19, 0x3a, 0xb6, 0x0c, 0x7c, 0x7d, 0xad, 0x37, 0x02, 0xd8, 0xdd, 0x4e, 0xa7, 0xc2, 0x60, 0xfc, 0x4d,
+	0xe8, 0x56, 0x9d, 0x09, 0x2b, 0xce, 0xce, 0x54, 0x4e, 0xe1, 0x26, 0xea, 0xc2, 0x66, 0xd1, 0x42, 0x6d, 0xb3,
+	0x52, 0x85, 0x4a, 0xb1, 0x19, 0xef, 0xff, 0x4b, 0xbe, 0xfc, 0x71, 0x73, 0xfd, 0xe9, 0xaf, 0xf1, 0x5a, 0xaa,
+	0x2e, 0x54, 0x6f, 0x10, 0x24, 0xf3, 0x85, 0xfe, 0x01, 0xc0, 0xe7, 0x61, 0x1a, 0x13, 0x96, 0x34, 0x1b, 0x4a,
+	0x2b, 0xbc, 0xdd, 0x6a, 0x42, 0x8a, 0x6b, 0xd2, 0xa0, 0xf7, 0x0e, 0x09, 0xc3, 0x5f, 0x41, 0x85, 0x41, 0x1f,
+	0x46, 0x1f, 0x59, 0xac, 0x5f, 0x4b, 0xbb, 0xb5, 0xbc, 0xbd, 0xb8, 0x83, 0xe3, 0x52, 0x91, 0x11, 0x99, 0x0c,
+	0x82, 0xe4, 0x91, 0xf2, 0x7e, 0xdf, 0x7c, 0x93, 0x4f, 0x97, 0x25, 0x7b, 0xa3, 0x30, 0x4a, 0xf1, 0xde, 0xd0,
+	0x55, 0x99, 0xc3, 0x8e, 0xc4, 0x02, 0x75, 0x90, 0xaa, 0x08, 0x9b, 0x12, 0xbe, 0x21, 0x04, 0x21, 0x4c, 0x11,
+	0x33, 0x5e, 0xb3, 0xaa, 0x74, 0xd4, 0x75, 0x02, 0x93, 0xf5, 0x7a, 0x26, 0x36, 0x66, 0x23, 0x6c, 0x08, 0x22,
+	0x1a, 0x46, 0xb1, 0x98, 0xfc, 0x33, 0x44, 0x0d, 0x89, 0xe6, 0xf7, 0x19, 0x47, 0x70, 0x44, 0x48, 0xad, 0x4f,
+	0x41, 0xfd, 0x46, 0x22, 0xc3, 0x09, 0xd5, 0x96, 0x05, 0xbe, 0xb9, 0xa1, 0xe7, 0xd6, 0x3b, 0x3b, 0x7b, 0x3c,
+	0x13, 0xe4, 0xd9, 0x51, 0xa2, 0x9b, 0xc9, 0x32, 0x43, 0x13, 0x0c, 0xd3, 0x1a, 0x41, 0xba, 0x63, 0xc3, 0x61,
+	0x31, 0x08, 0xf0, 0xe8, 0xa2, 0xaf, 0x8f, 0x2e, 0x6c, 0x43, 0x65, 0x87, 0x61, 0x7a, 0x66, 0xe1, 0x1b, 0x57,
+	0x83, 0xf7, 0x29, 0x9a, 0x79, 0xf6, 0xdf, 0x00, 0x99, 0xbb, 0x79, 0x04, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6,
+	0xec, 0x9d, 0x51, 0x6f, 0xdb, 0x36, 0x10, 0xc7, 0x9f, 0x9b, 0x4f, 0xc1, 0x19, 0x58, 0x21, 0x63, 0x86, 0xd2,
+	0x24, 0x7b, 0xf2, 0x96, 0x87, 0x61, 0x41, 0x87, 0x0e, 0xd8, 0x5a, 0x6c, 0x7d, 0x0b, 0x02, 0x43, 0xb6, 0x68,
+	0x87, 0x9d, 0x2c, 0x06, 0xa2, 0x32, 0xa3, 0x18, 0xfc, 0xdd, 0x87, 0xbb, 0x23, 0x25, 0x4a, 0xa2, 0x6c, 0xd9,
+	0x56, 0x6c, 0x29, 0xf5, 0x8b, 0x61, 0x93, 0xd4, 0x91, 0xa2, 0x4e, 0x14, 0x45, 0xfe, 0x7f, 0xe7, 0xae, 0xc7,
+	0x97, 0xc8, 0x03, 0x4a, 0x58, 0x90, 0x86, 0xbe, 0xf3, 0xe1, 0xf9, 0x31, 0xc8, 0xd6, 0x74, 0x07, 0xd4, 0xcb,
+	0x97, 0x97, 0x26, 0xdb, 0xf2, 0x16, 0x95, 0xaa, 0x8b, 0x37, 0x6b, 0xc6, 0x23, 0xc5, 0x99, 0x65, 0x67, 0x25,
+	0xe2, 0x50, 0xae, 0xdc, 0x76, 0x28, 0xcf, 0xd7, 0xb6, 0x6e, 0x75, 0xd9, 0x9f, 0x5c, 0x66, 0x14, 0x8f, 0xe6,
+	0x6e, 0x23, 0x90, 0x93, 0x9b, 0x80, 0x5f, 0xb9, 0x01, 0x27, 0x8b, 0x37, 0x0b, 0xe2, 0x58, 0xa6, 0x8c, 0x7c,
+	0x18, 0x2e, 0x83, 0x17, 0x73, 0x81, 0xf3, 0x14, 0xb2, 0x32, 0x32, 0x6d, 0x8e, 0x65, 0x42, 0xf5, 0x0a, 0xc5,
+	0x74, 0x9d, 0x39, 0x78, 0x78, 0x79, 0x09, 0xd3, 0x3a, 0xb6, 0xe2, 0x53, 0x36, 0x4d, 0xe4, 0x4a, 0xf1, 0x84,
+	0xfd, 0xf2, 0xe9, 0x03, 0x0e, 0x1d, 0x7f, 0xca, 0x90, 0xfb, 0x5f, 0x14, 0xfe, 0xc6, 0x49, 0xa3, 0x12, 0xf1,
+	0x22, 0xa2, 0x45, 0x3f, 0x19, 0x63, 0xb2, 0xf7, 0x84, 0x13, 0x3e, 0xd4, 0x5a, 0x83, 0x09, 0x98, 0xb5, 0x87,
+	0x41, 0x12, 0x2a, 0x06, 0x97, 0xd5, 0xb6, 0x30, 0xf4, 0x0d, 0xcf, 0x27, 0x14, 0x24, 0xff, 0xfe, 0x37, 0xbb,
+	0xd5, 0x0d, 0xcd, 0x80, 0xdd, 0xb7, 0x6f, 0x4b, 0x29, 0x7e, 0x2a, 0xd2, 0x88, 0x6b, 0xcc, 0x46, 0x86, 0x1c,
+	0x22, 0x72, 0x68, 0xa6, 0x06, 0x4d, 0xd8, 0xcc, 0xaa, 0xaf, 0x49, 0x43, 0x64, 0xe0, 0xf0, 0x5b, 0x1e, 0xbe,
+	0x83, 0x42, 0x77, 0xe8, 0x64, 0x6f, 0x30, 0x57, 0xc4, 0x0c, 0xea, 0x06, 0x81, 0xe5, 0x5f, 0x09, 0x2e, 0xb5,
+	0x0a, 0x91, 0x4e, 0x63, 0x30, 0xb4, 0xac, 0xcc, 0x4c, 0x21, 0xc3, 0x15, 0x17, 0x14, 0x1c, 0x53, 0x73, 0xeb,
+	0xe6, 0xf6, 0xfc, 0x04, 0x0b, 0xbc, 0x17, 0x51, 0x84, 0x6a, 0xc5, 0xa9, 0x15, 0xde, 0x81, 0xa6, 0x23, 0xe6,
+	0x7c, 0x2d, 0x9a, 0x54, 0x9b, 0x27, 0xad, 0xb5, 0x3d, 0x18, 0xdc, 0x2b, 0x3e, 0x23, 0x01, 0x1d, 0x28, 0x20,
+	0x4c, 0x17, 0x3d, 0x26, 0xa9, 0xa8, 0xdc, 0xcb, 0x8a, 0xcf, 0xb4, 0x58, 0x8d, 0xfd, 0xc0, 0xe2, 0x5c, 0xf2,
+	0x86, 0x1c, 0x53, 0xb1, 0x05, 0x64, 0xfb, 0x39, 0x15, 0x91, 0x7d, 0xf6, 0xf0, 0xbb, 0x70, 0xee, 0x36, 0x63,
+	0x7a, 0x8b, 0xc5, 0xed, 0xa4, 0x52, 0xc1, 0x3b, 0x5e, 0x29, 0xa8, 0x93, 0x8a, 0x3e, 0xbd, 0x29, 0xe4, 0xca,
+	0x31, 0xc2, 0xad, 0xf4, 0x2a, 0xba, 0xca, 0x0e, 0xa1, 0x55, 0xfa, 0x16, 0x57, 0xa5, 0x32, 0xb6, 0x39, 0x23,
+	0xfc, 0xb4, 0x18, 0x5a, 0xa5, 0x41, 0x38, 0x0b, 0xab, 0xaa, 0x66, 0x01, 0x88, 0x6a, 0x43, 0x10, 0x59, 0x4d,
+	0xc9, 0xb6, 0x41, 0x0e, 0x89, 0x8b, 0x71, 0x8c, 0x96, 0x39, 0xa3, 0xa6, 0x54, 0xbb, 0xb5, 0x38, 0x9e, 0xbd,
+	0x3c, 0xdf, 0x7d, 0x66, 0xbb, 0x4f, 0xcd, 0x76, 0xd3, 0x3a, 0x9c, 0x2d, 0xf6, 0xd5, 0x2f, 0x5d, 0xe4, 0xc2,
+	0x08, 0xc5, 0x68, 0xf6, 0x66, 0xf5, 0xc8, 0x63, 0x7c, 0xa2, 0x70, 0x85, 0xab, 0x19, 0x00, 0x99, 0xd8, 0xeb,
+	0x17, 0x36, 0xee, 0xb6, 0xcb, 0xa2, 0xde, 0xba, 0x31, 0x6a, 0xbe, 0xe4, 0x4b, 0x6f, 0xd8, 0x80, 0x33, 0xaf,
+	0x29, 0xb7, 0x17, 0x64, 0xde, 0x10, 0x08, 0xa7, 0x2a, 0x77, 0xa1, 0xc1, 0xb3, 0x23, 0x8e, 0x8d, 0x82, 0x67,
+	0x15, 0x9f, 0x98, 0x03, 0x77, 0x75, 0x59, 0xc7, 0x20, 0xf0, 0x2d, 0xe4, 0x77, 0xbd, 0x9f, 0xb9, 0xb1, 0x6f,
+	0x57, 0xf9, 0x66, 0xcc, 0x77, 0xce, 0x6d, 0xbf, 0x6b, 0xbb, 0xfa, 0xab, 0x06, 0xd5, 0x67, 0x47, 0xee, 0xca,
+	0x9b, 0x1b, 0xd6, 0xb9, 0x04, 0x39, 0x67, 0x5e, 0x34, 0xbe, 0x78, 0xb3, 0xa9, 0x82, 0x8d, 0x5d, 0x83, 0x96,
+	0x60, 0x6c, 0x1c, 0xb7, 0xda, 0x1f, 0xd7, 0x5b, 0xeb, 0x84, 0xec, 0x76, 0xeb, 0xbc, 0xd9, 0x5a, 0x27, 0xae,
+	0x5e, 0xb7, 0x5b, 0xe9, 0x8f, 0x1b, 0x2a, 0xb5, 0xb0, 0xfe, 0x84, 0x67, 0xf1, 0x23, 0x26, 0x09, 0x9f, 0x57,
+	0xc1, 0x7e, 0x2c, 0xe0, 0xc4, 0xf3, 0xed, 0x43, 0x5d, 0x78, 0x7e, 0x21, 0x07, 0x57, 0x28, 0xff, 0x1d, 0x16,
+	0xb2, 0xb0, 0x3e, 0x0d, 0xde, 0xc3, 0x0e, 0xb7, 0x3d, 0xe8, 0xec, 0x05, 0xd7, 0xd7, 0x63, 0xf0, 0x57, 0xfb,
+	0x60, 0xf0, 0xd7, 0xfb, 0x61, 0xf0, 0x37, 0xb5, 0x18, 0xfc, 0xd6, 0x2b, 0x5b, 0xc7, 0xc0, 0xbb, 0x2f, 0x31,
+	0xa9, 0x1e, 0xce, 0x04, 0xfc, 0x99, 0x80, 0xef, 0x02, 0x01, 0xdf, 0x1a, 0x7f, 0xbe, 0x90, 0x67, 0x56, 0xfc,
+	0xcc, 0x8a, 0x97, 0xd0, 0xf0, 0xd2, 0x7c, 0xfa, 0xd8, 0x5c, 0x38, 0x3c, 0xaa, 0xba, 0x43, 0x77, 0xb7, 0x0d,
+	0x73, 0xbb, 0x3a, 0xf7, 0xb8, 0x24, 0xb7, 0x01, 0xa0, 0xb7, 0xf0, 0xd1, 0x8e, 0xbe, 0xe8, 0x3a, 0x0d, 0xdd,
+	0x2e, 0xfc, 0x9c, 0x4d, 0x04, 0xba, 0x4f, 0x3e, 0x77, 0x0a, 0x74, 0x66, 0x25, 0x50, 0x97, 0xd5, 0x73, 0xb9,
+	0xd0, 0xa3, 0x23, 0x92, 0x4c, 0x1b, 0x6b, 0xb8, 0x47, 0x89, 0x4a, 0x14, 0x05, 0x1f, 0xa9, 0x64, 0x73, 0x91,
+	0x70, 0xf6, 0xfc, 0x04, 0x5f, 0xaf, 0xd8, 0x52, 0x44, 0x91, 0x50, 0x7c, 0x26, 0xe3, 0x90, 0xf1, 0x20, 0x89,
+	0xbe, 0x56, 0xc8, 0xde, 0xba, 0xcb, 0x76, 0x64, 0xac, 0xd7, 0xf5, 0x7a, 0xfe, 0xda, 0x10, 0xde, 0x83, 0x89,
+	0xdd, 0xf6, 0x70, 0xd2, 0xae, 0xd0, 0xa3, 0x47, 0x83, 0x45, 0x3b, 0x8d, 0x89, 0x1e, 0x83, 0x0a, 0x6d, 0x09,
+	0xb1, 0xec, 0x13, 0x51, 0xd9, 0x6b, 0x80, 0xf2, 0x1b, 0xe1, 0x25, 0x0f, 0x27, 0x25, 0x0b, 0x0b, 0x10, 0x3b,
+	0x61, 0x92, 0xdb, 0x18, 0xc7, 0x3a, 0xcb, 0xa7, 0x04, 0x1c, 0x7b, 0xcb, 0x33, 0x1e, 0x4e, 0xa3, 0xb9, 0x2e,
+	0x47, 0x33, 0x14, 0x6d, 0x1b, 0x47, 0x56, 0x67, 0xf9, 0x34, 0x00, 0x62, 0xff, 0x78, 0xc3, 0x57, 0x7c, 0x6d,
+	0x0f, 0x07, 0x04, 0xbb, 0xcf, 0x03, 0xbe, 0x7a, 0xfc, 0xaf, 0x5f, 0xb4, 0x9f, 0xcb, 0x63, 0xcd, 0x04, 0x7d,
+	0x17, 0xb0, 0xef, 0x14, 0xd4, 0xcf, 0x16, 0xf8, 0xa6, 0x44, 0xd0, 0x7c, 0xfe, 0x78, 0xf7, 0x71, 0x5c, 0x46,
+	0x28, 0x84, 0x8c, 0xf7, 0x67, 0x62, 0x46, 0x75, 0x11, 0x4d, 0x97, 0x7c, 0xb9, 0x81, 0x95, 0x81, 0x35, 0x34,
+	0xb7, 0x98, 0xa1, 0x44, 0x71, 0x54, 0x04, 0x0c, 0x3b, 0x68, 0x0e, 0x7a, 0x04, 0x77, 0x44, 0xdc, 0x85, 0x76,
+	0x94, 0x56, 0xd5, 0x5c, 0x9c, 0x46, 0x7e, 0x6f, 0xa3, 0x6e, 0x0c, 0xef, 0xa0, 0x7a, 0x06, 0xa4, 0xa2, 0xc2,
+	0x2f, 0x1c, 0xee, 0x21, 0x93, 0x61, 0x25, 0x7d, 0x9f, 0xbf, 0x93, 0x76, 0x12, 0xc9, 0xd8, 0x9d, 0xbe, 0x28,
+	0x1a, 0x85, 0x72, 0x85, 0x91, 0xf1, 0x45, 0xe0, 0x8c, 0x43, 0x38, 0x8c, 0x66, 0x08, 0xc6, 0xa1, 0xf4, 0xc5,
+	0x19, 0x1d, 0x38, 0xa3, 0x03, 0x15, 0x74, 0xc0, 0x25, 0xd8, 0x86, 0x14, 0x23, 0x5f, 0xb6, 0xee, 0x74, 0xf6,
+	0x33, 0xbb, 0xd1, 0xe7, 0x60, 0x72, 0x55, 0x1a, 0x82, 0x74, 0x91, 0xf4, 0xa9, 0x83, 0x67, 0x15, 0x2c, 0xf8,
+	0x98, 0x2d, 0xe4, 0xe4, 0x8b, 0x9a, 0xc0, 0x6e, 0xc6, 0x04, 0x76, 0xc4, 0xd8, 0x3d, 0x7c, 0x65, 0x53, 0x11,
+	0x07, 0xc9, 0xd7, 0x07, 0x76, 0x9f, 0x9d, 0xd4, 0x43, 0x26, 0xb6, 0x35, 0xe6, 0x70, 0x8b, 0x85, 0xe6, 0xa6,
+	0xeb, 0x8b, 0x8b, 0x42, 0xb7, 0x82, 0x3b, 0xfc, 0x26, 0xe9, 0xbc, 0x16, 0xd2, 0x08, 0x08, 0x0b, 0xad, 0x54,
+	0x38, 0xcb, 0xb8, 0x36, 0x45, 0x48, 0x4c, 0xa8, 0xc7, 0x8a, 0x40, 0x29, 0xb1, 0x88, 0xbd, 0xff, 0xd8, 0xe7,
+	0x3f, 0x3e, 0xdd, 0x7d, 0xf8, 0x6b, 0x9c, 0x2b, 0xa9, 0xa5, 0x1a, 0x0c, 0xfd, 0x74, 0xf9, 0x44, 0xff, 0xcb,
+	0xb4, 0x1e, 0x65, 0x36, 0x61, 0x50, 0x31, 0xa6, 0x48, 0x85, 0x68, 0x37, 0x13, 0x72, 0x6c, 0x76, 0x96, 0x1e,
+	0xca, 0xa9, 0xc0, 0x3f, 0xa7, 0x03, 0xb7, 0x0b, 0xc2, 0xf7, 0x22, 0xa2, 0xad, 0x13, 0xbb, 0x95, 0xf7, 0xd7,
+	0x0f, 0xb0, 0x46, 0x22, 0x0b, 0x1b, 0xa1, 0x43, 0x3f, 0x7d, 0xe4, 0xb1, 0xe7, 0xe9, 0xe9, 0x7b, 0x36, 0x30,
+	0x98, 0x03, 0xa5, 0x56, 0x33, 0x0e, 0x46, 0xb6, 0x12, 0x12, 0x1e, 0xf5, 0x46, 0xc1, 0x0f, 0xb9, 0x8a, 0x89,
+	0x39, 0x8b, 0x4b, 0x9b, 0x95, 0x4c, 0x28, 0xa6, 0x3d, 0xa6, 0xa8, 0x9c, 0x44, 0xed, 0x11, 0x48, 0xfa, 0xbf,
+	0x5b, 0xc8, 0xd2, 0xfd, 0x00, 0x4f, 0xd3, 0x90, 0x07, 0x61, 0x24, 0x67, 0xff, 0x8c, 0x70, 0x9f, 0x93, 0xb0,
+	0x4a, 0x11, 0xa3, 0xa2, 0x55, 0x26, 0xb4, 0x87, 0x8a, 0x7b, 0x8c, 0x69, 0x12, 0xcc, 0x38, 0x91, 0xa4, 0x2e,
+	0xef, 0x24, 0xef, 0x7e, 0x67, 0xfc, 0xd7, 0xe1, 0xa2, 0x6b, 0x4b, 0x2d, 0xa9, 0xdd, 0x75, 0x81, 0x6c, 0xb2,
+	0xee, 0x0d, 0x3f, 0x9b, 0x0a, 0xa1, 0x5b, 0x0c, 0x7d, 0x7c, 0x5f, 0xf1, 0xe8, 0x7d, 0xc5, 0x74, 0x14, 0x0d,
+	0x19, 0xf4, 0x27, 0xa2, 0x64, 0xcc, 0x40, 0x31, 0xff, 0x0f, 0x00, 0xb0, 0xeb, 0xa4, 0x83, 0x78, 0xf9, 0x43,
+	0xa6, 0x14, 0xe6, 0xec, 0x9c, 0xdf, 0x4f, 0xdb, 0x3a, 0x14, 0xc7, 0x9f, 0xef, 0xfd, 0x2b, 0x4c, 0x1f, 0x50,
+	0x2b, 0x7a, 0xd3, 0x5b, 0xf6, 0x06, 0xeb, 0xc3, 0x36, 0x60, 0x62, 0xd2, 0x26, 0xb4, 0xed, 0xad, 0x42, 0x28,
+	0x4d, 0xdc, 0x60, 0x96, 0xda, 0x95, 0x9d, 0x52, 0xa1, 0x89, 0xff, 0x7d, 0x3a, 0xfe, 0x91, 0x38, 0x4d, 0xca,
+	0xa8, 0xb6, 0x42, 0xd2, 0x9e, 0x17, 0xd4, 0xd8, 0xce, 0x89, 0xe5, 0x1c, 0xdb, 0x27, 0xe6, 0x7c, 0x3f, 0x4d,
+	0xe7, 0x4b, 0x14, 0x40, 0x89, 0xc1, 0x00, 0x62, 0x15, 0x32, 0x5b, 0xa4, 0x19, 0x9b, 0xa7, 0x94, 0x7c, 0x0a,
+	0xef, 0xc3, 0x6f, 0x91, 0x64, 0xf3, 0xcc, 0x9f, 0x0f, 0x36, 0x14, 0x52, 0x8c, 0x27, 0xa9, 0x39, 0xca, 0x12,
+	0x9c, 0xbc, 0xbb, 0xba, 0xec, 0x6b, 0x03, 0x73, 0x1d, 0xca, 0xe8, 0x2c, 0xe2, 0x25, 0x9d, 0xc0, 0xaa, 0xc1,
+	0xe3, 0x50, 0xc6, 0x8a, 0x40, 0x87, 0xc9, 0x17, 0x11, 0xd3, 0xe0, 0x4e, 0x41, 0xf3, 0x00, 0x9a, 0xeb, 0x5b,
+	0xce, 0x7d, 0xdb, 0xf0, 0x02, 0x59, 0x4c, 0x25, 0x8d, 0x4f, 0x74, 0xe5, 0x7f, 0xe4, 0xbd, 0x14, 0x4b, 0x45,
+	0xa5, 0xb2, 0x97, 0xd6, 0x84, 0xbd, 0x3a, 0x87, 0x30, 0x44, 0x0a, 0x6e, 0x2f, 0xaf, 0x42, 0x19, 0xd1, 0xd4,
+	0x6a, 0xf4, 0x6c, 0xe2, 0xbf, 0x5d, 0xc4, 0x60, 0x2b, 0xec, 0xe4, 0xc7, 0xd3, 0x1d, 0xe3, 0x30, 0x83, 0x81,
+	0xab, 0xf6, 0x1c, 0x5f, 0x65, 0xea, 0xdf, 0x7f, 0x1e, 0x09, 0x4d, 0x15, 0x25, 0x9e, 0x9d, 0x25, 0xe3, 0xb1,
+	0x58, 0xd6, 0xdb, 0x31, 0x75, 0x81, 0xb5, 0x35, 0xb2, 0x6d, 0x4f, 0xeb, 0xcc, 0x28, 0x9a, 0x4e, 0xeb, 0x8d,
+	0x40, 0x4d, 0x61, 0x02, 0xae, 0x0a, 0x03, 0xb5, 0xb2, 0xc2, 0x28, 0xe4, 0x5c, 0x64, 0xc4, 0x4c, 0x47, 0xf0,
+	0xa8, 0x2e, 0xa7, 0x4c, 0x87, 0x5c, 0xc6, 0x4a, 0xdf, 0xf5, 0x99, 0x0b, 0x69, 0x9e, 0xcb, 0x14, 0xb1, 0xcf,
+	0xac, 0xd5, 0x50, 0x06, 0x56, 0x76, 0x08, 0xa2, 0x1c, 0xdb, 0x5d, 0x57, 0x52, 0xdb, 0xe3, 0x95, 0xbb, 0x46,
+	0xae, 0x75, 0x8d, 0xe5, 0xa9, 0x02, 0xa3, 0xe5, 0x1b, 0x7a, 0x1e, 0xad, 0x44, 0x53, 0x42, 0x6c, 0x79, 0xb7,
+	0x33, 0x55, 0xba, 0x7b, 0xda, 0x82, 0xbf, 0xa9, 0x4f, 0x55, 0xaf, 0x14, 0xd3, 0x14, 0xc6, 0x47, 0x64, 0xaa,
+	0x4e, 0x0b, 0xb5, 0xec, 0xab, 0xb1, 0x51, 0x9e, 0xc5, 0x45, 0x79, 0x09, 0x26, 0x4a, 0xab, 0x10, 0x28, 0x1b,
+	0xf0, 0x4f, 0x10, 0x7e, 0x82, 0xf0, 0x13, 0x84, 0x9f, 0x20, 0xfc, 0x04, 0xe1, 0x27, 0x08, 0x3f, 0xd9, 0x08,
+	0x7e, 0x52, 0x07, 0x3e, 0x41, 0xe8, 0xc9, 0x26, 0xd0, 0x93, 0x12, 0x73, 0xc4, 0x6e, 0x56, 0x22, 0xa6, 0x1f,
+	0x74, 0xa9, 0x1f, 0xbe, 0x99, 0x76, 0x26, 0x1a, 0x28, 0xdd, 0xea, 0x8d, 0x73, 0x29, 0x8d, 0x77, 0xe2, 0xb6,
+	0xcb, 0xc2, 0x5e, 0x20, 0x75, 0x83, 0x0b, 0x96, 0xa6, 0x7a, 0x0f, 0x9c, 0x78, 0xdb, 0x5c, 0xed, 0xdb, 0x5d,
+	0xc5, 0x90, 0x54, 0xcb, 0xdd, 0xd3, 0x8d, 0x1e, 0xcf, 0xff, 0x60, 0x1c, 0x2b, 0x1a, 0x19, 0x91, 0x05, 0x64,
+	0xc9, 0x5a, 0xa7, 0x08, 0x6e, 0x65, 0xc6, 0x2a, 0xdf, 0x7b, 0x8a, 0x46, 0x56, 0xd0, 0x40, 0x8e, 0x08, 0x2f,
+	0x64, 0x11, 0x5a, 0xeb, 0xfe, 0x54, 0x07, 0x2b, 0x6c, 0x92, 0x6a, 0xb9, 0x3f, 0x86, 0x8b, 0x8c, 0xa5, 0x9d,
+	0x9e, 0x5f, 0xbb, 0xc6, 0x66, 0x09, 0x3d, 0x52, 0x2d, 0x5f, 0x63, 0xf3, 0x8c, 0xfa, 0x36, 0xf5, 0x57, 0x58,
+	0x0c, 0x1f, 0x9b, 0x8e, 0x40, 0xa2, 0x74, 0x3a, 0x7f, 0xf1, 0x75, 0x17, 0x6c, 0x9b, 0x41, 0x82, 0xfc, 0x11,
+	0xe4, 0x8f, 0x20, 0x2b, 0x04, 0x59, 0x21, 0xc8, 0x0a, 0x41, 0x56, 0x08, 0xb2, 0x42, 0x90, 0x15, 0x82, 0xac,
+	0x10, 0x64, 0x85, 0x6c, 0x9b, 0x15, 0xf2, 0xd7, 0x48, 0x1d, 0x89, 0x40, 0xaa, 0x06, 0x52, 0x35, 0x10, 0xa2,
+	0xb1, 0x25, 0x88, 0x06, 0x32, 0x33, 0x9e, 0x64, 0x66, 0x34, 0x08, 0x91, 0xb1, 0x45, 0x22, 0xc6, 0x70, 0x63,
+	0x24, 0xc6, 0x70, 0xad, 0x2b, 0xed, 0x27, 0x02, 0x03, 0x89, 0x17, 0x48, 0xbc, 0x30, 0x1a, 0x89, 0x23, 0x32,
+	0xec, 0x1b, 0x31, 0x92, 0x7b, 0x3d, 0x3a, 0xfb, 0x47, 0xe7, 0x78, 0x2a, 0xf8, 0x93, 0x09, 0x32, 0x65, 0x92,
+	0x92, 0xc5, 0x1c, 0x7e, 0x0e, 0xc9, 0x8c, 0xa5, 0x29, 0x53, 0x34, 0x12, 0x3c, 0x26, 0x34, 0x94, 0xe9, 0x43,
+	0x83, 0x99, 0x19, 0x7b, 0x82, 0xc8, 0x68, 0x00, 0x11, 0x03, 0x01, 0x18, 0xdb, 0x02, 0x60, 0x34, 0x85, 0x77,
+	0xf1, 0x62, 0x78, 0x8b, 0x46, 0x83, 0x2d, 0x5a, 0xc4, 0xb1, 0x68, 0x3e, 0xb6, 0xa2, 0x4d, 0x94, 0x8a, 0x56,
+	0x43, 0x29, 0xf6, 0x84, 0x41, 0xf1, 0xca, 0xf4, 0x89, 0x96, 0xa2, 0x23, 0x5a, 0x4b, 0x8a, 0x78, 0x65, 0x9d,
+	0x7f, 0xeb, 0x00, 0x0f, 0xed, 0xe3, 0x39, 0xec, 0xfc, 0x1b, 0xfe, 0x73, 0x0c, 0x43, 0xf3, 0xa9, 0x0b, 0x3b,
+	0x0f, 0x59, 0x68, 0x17, 0x53, 0x61, 0xa7, 0x10, 0x0a, 0x48, 0x4c, 0xd8, 0x5d, 0x62, 0x02, 0x02, 0x12, 0x76,
+	0x13, 0x90, 0xb0, 0x37, 0x9c, 0x02, 0x64, 0x14, 0x3c, 0x9f, 0x51, 0x80, 0x7c, 0x02, 0xe4, 0x13, 0x20, 0x9f,
+	0x00, 0xf9, 0x04, 0xbf, 0xe1, 0x13, 0xa0, 0xb8, 0x1e, 0xc5, 0xf5, 0x15, 0x71, 0xbd, 0x11, 0x42, 0x54, 0x45,
+	0xc8, 0x87, 0x87, 0x95, 0xb2, 0x60, 0x16, 0x42, 0x8a, 0xd7, 0x68, 0x04, 0x02, 0xb4, 0x45, 0x5a, 0x6e, 0xe3,
+	0x14, 0x40, 0x35, 0x65, 0xc1, 0x3d, 0x95, 0x4a, 0xe7, 0x61, 0xe9, 0xca, 0x83, 0x35, 0xb5, 0x01, 0xcd, 0x45,
+	0xe8, 0x66, 0xa4, 0xa0, 0x63, 0xae, 0x91, 0xb7, 0x0a, 0x91, 0xb7, 0xe4, 0x8d, 0x37, 0xec, 0x10, 0x55, 0x51,
+	0xe3, 0x27, 0x0b, 0x15, 0x26, 0xf4, 0x84, 0x24, 0xe2, 0xe6, 0x4e, 0xdd, 0x40, 0x22, 0xcc, 0x0d, 0x64, 0x62,
+	0x91, 0x31, 0xfc, 0x24, 0x13, 0xc6, 0x43, 0xf9, 0x70, 0x4d, 0xc6, 0xf9, 0xa0, 0x5e, 0x5b, 0xd7, 0x71, 0xcf,
+	0xd0, 0xb9, 0x39, 0x26, 0xac, 0xd3, 0xaf, 0xdb, 0x7b, 0xa9, 0xe0, 0x8c, 0x1f, 0x85, 0x19, 0xd5, 0x44, 0x38,
+	0xf1, 0x44, 0xa9, 0x73, 0x4a, 0x07, 0xcf, 0xc7, 0xae, 0x89, 0x11, 0x52, 0xd8, 0x95, 0x2a, 0x54, 0x8a, 0x25,
+	0xbc, 0xfb, 0x93, 0x7c, 0xff, 0x7c, 0x75, 0x76, 0xf9, 0xf5, 0xa4, 0x90, 0x92, 0x08, 0xd5, 0xe9, 0x05, 0xd9,
+	0x6c, 0x6e, 0x24, 0x44, 0x8f, 0xfd, 0xdc, 0x26, 0x2c, 0x69, 0xce, 0x94, 0x51, 0x60, 0xf8, 0xdd, 0x84, 0x1a,
+	0x9f, 0x6d, 0x65, 0x22, 0xc1, 0x8c, 0x69, 0xfd, 0x24, 0x38, 0x7d, 0x18, 0x5f, 0xb0, 0xd4, 0x24, 0xdc, 0xf8,
+	0xbd, 0x1c, 0x1f, 0x5f, 0xc3, 0x79, 0xbb, 0x28, 0xa5, 0xdf, 0xf5, 0x82, 0xec, 0x96, 0xf2, 0x6e, 0xd7, 0x1e,
+	0xff, 0xe4, 0x0b, 0x94, 0xbb, 0x51, 0x58, 0x25, 0x47, 0xa7, 0xef, 0xab, 0x40, 0x60, 0xeb, 0x77, 0x1c, 0x02,
+	0xa8, 0x55, 0x84, 0x4d, 0x09, 0x5f, 0x49, 0x91, 0x23, 0x4c, 0x11, 0xeb, 0xaf, 0x65, 0xd5, 0x88, 0xfe, 0x37,
+	0x24, 0x24, 0xa2, 0x1f, 0x24, 0x62, 0x65, 0x36, 0x42, 0xa8, 0x10, 0x83, 0x60, 0x50, 0x44, 0x3f, 0xfa, 0x3a,
+	0xbb, 0xce, 0x84, 0x15, 0x8c, 0x6b, 0xc1, 0xb9, 0x90, 0x26, 0x73, 0x4f, 0x67, 0xb6, 0x65, 0x32, 0x8c, 0xa8,
+	0x89, 0xa2, 0xea, 0xe6, 0x86, 0x99, 0x5b, 0xff, 0xbb, 0xd9, 0x53, 0x33, 0x41, 0x1e, 0xbd, 0xd5, 0xdd, 0x4e,
+	0x96, 0x44, 0xb3, 0xc3, 0xec, 0x68, 0x04, 0x79, 0xfc, 0xad, 0xdd, 0xa2, 0x17, 0xe8, 0xc3, 0xae, 0xae, 0x39,
+	0xec, 0x72, 0x03, 0x55, 0x76, 0xc3, 0xfc, 0x94, 0xab, 0xce, 0xaf, 0x7a, 0xa7, 0x05, 0xd1, 0xe2, 0xd7, 0x00,
+	0xe5, 0x3f, 0x68, 0xfc, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6, 0xec, 0x9c, 0xdf, 0x4f, 0xdb, 0x3a, 0x14, 0xc7,
+	0x9f, 0x2f, 0x7f, 0x85, 0xe9, 0x03, 0x6a, 0x75, 0x7b, 0xd3, 0x0b, 0xf7, 0x0d, 0x6e, 0x1f, 0xee, 0x1d, 0x30,
+	0x31, 0x69, 0x13, 0xda, 0x26, 0xed, 0xa1, 0x42, 0x28, 0x4d, 0x9c, 0x60, 0x48, 0xed, 0xca, 0x4e, 0xa9, 0xd0,
+	0xc4, 0xff, 0x3e, 0x1d, 0x1f, 0x3b, 0x71, 0xa8, 0x61, 0x2d, 0x02, 0x06, 0xda, 0x79, 0x41, 0x38, 0x76, 0x4e,
+	0x1c, 0xff, 0x88, 0x8f, 0xdd, 0xf3, 0xfd, 0xbc, 0x76, 0xbe, 0x44, 0x0b, 0x94, 0x18, 0x8d, 0xc0, 0x57, 0x61,
+	0xb3, 0x45, 0x55, 0x8b, 0x79, 0xc5, 0xd9, 0x87, 0xf4, 0x3a, 0xfd, 0x92, 0x69, 0x31, 0xaf, 0xc3, 0xf9, 0xe0,
+	0x5c, 0x21, 0x23, 0x64, 0x59, 0xe1, 0x51, 0x96, 0x92, 0xec, 0xbf, 0xd3, 0x93, 0xa1, 0x35, 0x30, 0xb7, 0xae,
+	0x8c, 0x8d, 0x22, 0x5e, 0xf2, 0x29, 0x7c, 0x35, 0x64, 0x9e, 0xea, 0xdc, 0x30, 0xa8, 0x30, 0xfb, 0xa4, 0x72,
+	0x9e, 0x5c, 0x1a, 0x28, 0x9e, 0x40, 0x71, 0x7b, 0xcb, 0x51, 0x68, 0x1b, 0x3a, 0x50, 0xe4, 0x5c, 0xf3, 0x7c,
+	0xdf, 0x66, 0xfe, 0xc5, 0xfe, 0xd7, 0x6a, 0x69, 0xb8, 0x36, 0x2e, 0xe9, 0x4c, 0xb8, 0xd4, 0x11, 0xb8, 0x21,
+	0x5a, 0x49, 0x97, 0x3c, 0x4d, 0x75, 0xc6, 0x2b, 0x97, 0xf8, 0xc6, 0xa7, 0xf3, 0x34, 0xbb, 0x72, 0x8a, 0x3d,
+	0x27, 0x03, 0x70, 0x9f, 0x34, 0x58, 0x18, 0x7b, 0xcd, 0x61, 0x75, 0x0f, 0x87, 0xcf, 0x68, 0xe4, 0xb3, 0x83,
+	0x69, 0x60, 0x6a, 0xb3, 0xf5, 0xc7, 0x2d, 0xe3, 0x95, 0xe1, 0x2c, 0xb0, 0xb3, 0x14, 0x32, 0x57, 0xcb, 0xb8,
+	0x1d, 0xcc, 0x4b, 0x9c, 0xad, 0xb1, 0x2b, 0x7b, 0x10, 0x33, 0x63, 0x78, 0x55, 0xc4, 0x8d, 0x40, 0x4e, 0x6b,
+	0x02, 0x52, 0xad, 0x81, 0xa8, 0xc8, 0x30, 0x4b, 0xa5, 0x54, 0x35, 0xc3, 0xc9, 0x09, 0xe3, 0xab, 0x2f, 0xb9,
+	0xb0, 0x0e, 0x18, 0x5a, 0x19, 0xfa, 0x3a, 0x4b, 0xa5, 0xf1, 0xb9, 0xc2, 0x30, 0xf7, 0xcc, 0xa8, 0xa2, 0x32,
+	0x71, 0x22, 0x44, 0x90, 0xe8, 0xb8, 0xea, 0xfa, 0x2b, 0xd1, 0x1a, 0xdf, 0xb9, 0x6b, 0xec, 0x4b, 0x47, 0x2c,
+	0x17, 0x06, 0x8c, 0x76, 0x6f, 0x18, 0x04, 0xec, 0x12, 0xcb, 0x0c, 0x71, 0xd7, 0xfb, 0xbd, 0xc2, 0xd8, 0xea,
+	0x85, 0xdd, 0x08, 0x05, 0xc6, 0xe3, 0x46, 0xc5, 0x01, 0xd6, 0x0a, 0xd3, 0xc8, 0x37, 0x20, 0x19, 0xfa, 0x02,
+	0x85, 0xf1, 0x87, 0x8f, 0x1d, 0x8f, 0xa8, 0xad, 0xcc, 0x98, 0x15, 0xe6, 0xa0, 0xd5, 0xda, 0xfe, 0x32, 0xb2,
+	0xca, 0x5a, 0x54, 0x95, 0x97, 0x20, 0xaa, 0xbc, 0x29, 0x80, 0xca, 0x06, 0xf4, 0x14, 0x42, 0xa7, 0x10, 0x3a,
+	0x85, 0xd0, 0x29, 0x84, 0x4e, 0x21, 0x74, 0x0a, 0xa1, 0x53, 0x36, 0x42, 0xa7, 0xc4, 0xb0, 0x29, 0x84, 0x4c,
+	0xd9, 0x04, 0x99, 0xe2, 0x49, 0x24, 0x0f, 0x3a, 0x7e, 0x52, 0xe5, 0xfc, 0x1d, 0x96, 0x0b, 0x1c, 0x40, 0xbc,
+	0x13, 0xfd, 0x83, 0xae, 0xb1, 0xb6, 0xe5, 0x3b, 0x61, 0xc1, 0x53, 0xbf, 0x80, 0xb6, 0xf6, 0x12, 0x6d, 0x0b,
+	0x1c, 0x8b, 0xaa, 0xb2, 0xab, 0xe2, 0x34, 0x58, 0xf8, 0xb0, 0xbf, 0x23, 0x95, 0x7d, 0x90, 0xe7, 0xb1, 0x11,
+	0x5a, 0x65, 0x6d, 0xa6, 0xca, 0x2a, 0x4f, 0x65, 0xf5, 0xba, 0x7f, 0x6d, 0x14, 0x16, 0x86, 0x3b, 0xdf, 0x89,
+	0xe1, 0x19, 0xaa, 0x45, 0x20, 0xdc, 0xd7, 0x8d, 0xcf, 0xe4, 0x42, 0xd7, 0x62, 0x65, 0xe3, 0x6a, 0x78, 0xe6,
+	0x94, 0x19, 0xec, 0x4f, 0x26, 0x5b, 0x7d, 0x87, 0x15, 0xed, 0x77, 0x5b, 0xa6, 0x5b, 0xc1, 0x90, 0x9b, 0x72,
+	0x4f, 0x67, 0x46, 0x4a, 0x06, 0xdd, 0xb9, 0xa8, 0x45, 0xd5, 0x1b, 0x84, 0xb9, 0xab, 0xed, 0xbf, 0x2e, 0xc9,
+	0xe5, 0x29, 0x28, 0x2e, 0x1d, 0x28, 0xcb, 0xcf, 0xdf, 0xe8, 0x90, 0x3f, 0xf4, 0x46, 0x2e, 0x37, 0xfe, 0x46,
+	0xeb, 0x60, 0x62, 0x1e, 0x8d, 0x88, 0xb1, 0x5b, 0xe9, 0x1c, 0x4e, 0x0c, 0x7c, 0x19, 0x63, 0x35, 0x19, 0xed,
+	0x16, 0x3d, 0x79, 0x6e, 0x90, 0x0c, 0x41, 0x64, 0x08, 0x22, 0x43, 0xc0, 0x17, 0x02, 0xbe, 0x10, 0xf0, 0x85,
+	0x80, 0x2f, 0x04, 0x7c, 0x21, 0xe0, 0x0b, 0x01, 0x5f, 0x08, 0xf8, 0xf2, 0xdc, 0xc0, 0x97, 0x27, 0xc3, 0xad,
+	0x94, 0x8a, 0xd0, 0x28, 0x84, 0x46, 0x21, 0x34, 0x0a, 0xa1, 0x51, 0x08, 0x8d, 0xf2, 0x9a, 0xd1, 0x28, 0xbb,
+	0x1b, 0xb3, 0x51, 0x76, 0x09, 0x8e, 0x42, 0x70, 0x14, 0x82, 0xa3, 0x3c, 0x06, 0x8e, 0x02, 0x63, 0x76, 0x88,
+	0xba, 0x35, 0xdf, 0x3d, 0x36, 0x50, 0xcc, 0x86, 0x03, 0x1b, 0xf8, 0x53, 0x2b, 0x56, 0x08, 0xcd, 0xd9, 0x62,
+	0x0e, 0xff, 0xee, 0xb2, 0x99, 0xa8, 0x2a, 0x61, 0x78, 0xa6, 0x64, 0xce, 0x78, 0xaa, 0xab, 0x1b, 0xc2, 0xab,
+	0x10, 0x5e, 0x85, 0xf0, 0x2a, 0x84, 0x57, 0x21, 0xbc, 0x0a, 0xe1, 0x55, 0x08, 0xaf, 0x42, 0x78, 0x15, 0xc2,
+	0xab, 0xbc, 0x29, 0xbc, 0x0a, 0xb1, 0x51, 0x88, 0x8d, 0x42, 0x60, 0x93, 0xdf, 0x16, 0x6c, 0x42, 0x54, 0x12,
+	0xa2, 0x92, 0x10, 0x95, 0x84, 0xa8, 0x24, 0x44, 0x25, 0x21, 0x2a, 0x09, 0x51, 0x49, 0x88, 0x4a, 0x42, 0x54,
+	0x92, 0x90, 0x4a, 0x42, 0x44, 0x92, 0xf5, 0x89, 0x24, 0x44, 0x23, 0x21, 0x1a, 0x09, 0xd1, 0x48, 0x88, 0x46,
+	0x42, 0x34, 0x12, 0xa2, 0x91, 0x10, 0x8d, 0xe4, 0xc5, 0x69, 0x24, 0xa8, 0x46, 0xda, 0xc2, 0xb8, 0x61, 0x55,
+	0x80, 0x9c, 0x76, 0x51, 0xad, 0xa0, 0x1c, 0xd8, 0xce, 0xce, 0x2a, 0xca, 0x21, 0x72, 0x2d, 0x99, 0xa5, 0x10,
+	0x71, 0x39, 0x1e, 0x7b, 0x3b, 0x61, 0x19, 0xaf, 0x8b, 0x8c, 0x5c, 0x4b, 0xae, 0xb9, 0x36, 0x36, 0x2c, 0xd2,
+	0x66, 0x6e, 0xdf, 0x93, 0x9b, 0xf0, 0x06, 0xec, 0x81, 0x8d, 0x09, 0x75, 0xf7, 0x85, 0x82, 0x6f, 0x3d, 0xfb,
+	0x97, 0xfd, 0x13, 0xf4, 0x0c, 0x38, 0xcd, 0x1c, 0x87, 0xd2, 0xc2, 0xa4, 0x25, 0xdf, 0x67, 0xa5, 0x3a, 0xbf,
+	0x34, 0xe7, 0x30, 0x13, 0xcf, 0x21, 0x30, 0x92, 0x4d, 0xe0, 0x5f, 0x36, 0x15, 0x32, 0xd5, 0x37, 0x67, 0x6c,
+	0xd2, 0xb4, 0xfb, 0x99, 0x1b, 0x5d, 0xfe, 0x19, 0x36, 0x32, 0x0e, 0xbd, 0x76, 0x3b, 0x22, 0x82, 0x7e, 0x87,
+	0xf1, 0xfa, 0x5e, 0x61, 0xc3, 0x97, 0xca, 0x6b, 0x99, 0x3a, 0x95, 0x33, 0x76, 0x6f, 0xb4, 0xe7, 0x8b, 0xa0,
+	0xae, 0xc9, 0xad, 0x07, 0xa9, 0x31, 0xa2, 0x94, 0xfd, 0xef, 0xec, 0xeb, 0xc7, 0xd3, 0xc3, 0x93, 0xcf, 0xfb,
+	0xad, 0x8a, 0x4c, 0x99, 0xde, 0x20, 0xa9, 0x67, 0x73, 0x14, 0x56, 0xde, 0x0e, 0x1b, 0x9b, 0xb0, 0x70, 0x78,
+	0x53, 0x28, 0x88, 0x0a, 0xab, 0x09, 0x39, 0xa1, 0x67, 0x8e, 0xfe, 0x76, 0x2d, 0xac, 0xaa, 0x1c, 0xe6, 0x45,
+	0x9a, 0x1f, 0x8b, 0x0a, 0xa3, 0xdb, 0xc2, 0x5a, 0x4e, 0xf6, 0xce, 0xe0, 0x97, 0x1f, 0xd5, 0x89, 0x86, 0x1d,
+	0x24, 0xf5, 0x05, 0x97, 0xfd, 0xbe, 0x3b, 0x43, 0x6c, 0x96, 0x01, 0x7f, 0xa3, 0x72, 0xc2, 0xaa, 0xde, 0x30,
+	0x14, 0x65, 0x81, 0x83, 0xe5, 0xd9, 0x2e, 0x90, 0x6b, 0x98, 0x28, 0x98, 0xbc, 0x13, 0xb1, 0xca, 0x84, 0x61,
+	0x6e, 0x48, 0x77, 0x45, 0x5c, 0xf6, 0xc7, 0x77, 0xd0, 0x85, 0x6c, 0x97, 0xea, 0xce, 0x84, 0x85, 0xef, 0x77,
+	0x0e, 0x32, 0x6a, 0x95, 0x5d, 0x0d, 0x6d, 0xb0, 0x2b, 0x3a, 0x6f, 0x42, 0x5a, 0x0c, 0x87, 0xd2, 0x18, 0x48,
+	0x6b, 0x03, 0x4d, 0x6b, 0x9d, 0x66, 0x1c, 0x7d, 0xd5, 0xd8, 0xf4, 0xc1, 0xe9, 0xf7, 0xb7, 0x9f, 0x60, 0x91,
+	0x39, 0x74, 0x1b, 0xac, 0xa1, 0x6e, 0x3e, 0x95, 0x76, 0xe7, 0xe3, 0x5a, 0x23, 0x69, 0xb6, 0x57, 0x76, 0x58,
+	0x0c, 0x12, 0x7b, 0x62, 0xda, 0xc7, 0x13, 0x53, 0xdf, 0x50, 0xdd, 0x61, 0xd8, 0x1c, 0x95, 0xc6, 0xc6, 0xd5,
+	0xe0, 0xa0, 0xa1, 0x04, 0x6d, 0xfd, 0x18, 0x00, 0x2c, 0x15, 0xe3, 0x46, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6,
+	0xec, 0x9d, 0x4b, 0x6f, 0xdb, 0x46, 0x10, 0xc7, 0xcf, 0xf5, 0xa7, 0xd8, 0x0a, 0x28, 0x20, 0xa1, 0x2e, 0x8d,
+	0x38, 0x3d, 0xa9, 0xf5, 0xa1, 0x75, 0x90, 0xa2, 0x69, 0x21, 0x17, 0x55, 0x2f, 0x85, 0x60, 0x18, 0x94, 0xb8,
+	0x92, 0x99, 0x50, 0x5c, 0x81, 0x2b, 0x5b, 0xc8, 0xc1, 0xdf, 0xbd, 0x98, 0xd9, 0x37, 0xc9, 0x5d, 0x92, 0xd6,
+	0xbb, 0xf0, 0x25, 0x91, 0xc8, 0xe1, 0x2c, 0xf7, 0xe1, 0x7d, 0xfe, 0x7f, 0xa3, 0x53, 0x8f, 0x2f, 0x61, 0x02,
+	0x4a, 0x5c, 0x5d, 0xc1, 0x5c, 0x85, 0x6c, 0xe8, 0x94, 0x4c, 0x0b, 0xb6, 0xe1, 0xb4, 0x20, 0xbf, 0xfc, 0xf5,
+	0x3b, 0xfe, 0x3d, 0x8c, 0x58, 0x42, 0xa3, 0xcf, 0x1c, 0xbf, 0xe3, 0x4c, 0x88, 0xa7, 0xf9, 0x22, 0x13, 0x3b,
+	0x59, 0x2c, 0xc7, 0xcb, 0xfd, 0x15, 0xce, 0x62, 0x50, 0x40, 0x0c, 0x2e, 0x60, 0x2a, 0x9a, 0xc4, 0x45, 0xc2,
+	0x09, 0xbc, 0xab, 0xed, 0x61, 0x10, 0x29, 0x48, 0x2d, 0xe5, 0x70, 0xf9, 0xd3, 0x18, 0xea, 0x56, 0xc8, 0xf4,
+	0x15, 0x85, 0x0a, 0x23, 0x57, 0x4f, 0xef, 0x26, 0x43, 0x4c, 0x09, 0x49, 0x85, 0xa0, 0xbd, 0x4d, 0x5d, 0x46,
+	0x92, 0x95, 0x43, 0x8a, 0x0b, 0x3f, 0x99, 0x00, 0x14, 0x22, 0xf8, 0x84, 0xbc, 0xdc, 0xef, 0xcd, 0xb9, 0xa0,
+	0xde, 0x64, 0xea, 0x39, 0x4b, 0xe8, 0xad, 0xc0, 0x23, 0x2d, 0x23, 0xa1, 0x34, 0xe8, 0x0d, 0x2c, 0x2f, 0x33,
+	0x65, 0xa4, 0xc8, 0x58, 0x47, 0x83, 0x30, 0x55, 0x8d, 0xcf, 0xf8, 0x8b, 0x0a, 0x34, 0xf8, 0x98, 0x66, 0x19,
+	0xea, 0xed, 0xa6, 0x56, 0x80, 0x02, 0x31, 0xa0, 0x4a, 0xcf, 0x36, 0x0f, 0x29, 0xdd, 0x0b, 0xb5, 0xb0, 0xdd,
+	0x9c, 0x27, 0x9c, 0xce, 0x84, 0x04, 0x0c, 0xce, 0xf0, 0x65, 0x09, 0x45, 0x8f, 0xc5, 0x3a, 0xad, 0xb4, 0x46,
+	0x4e, 0x67, 0x52, 0x6e, 0x45, 0xbe, 0x27, 0xb9, 0x11, 0x6d, 0x21, 0x89, 0xe3, 0xbe, 0x81, 0xf0, 0xfd, 0xb4,
+	0x4e, 0x33, 0x3b, 0xf7, 0xf0, 0xdd, 0xc9, 0xbb, 0x4d, 0x49, 0xde, 0xa0, 0xb9, 0x7d, 0xa9, 0x64, 0xf8, 0x81,
+	0x56, 0x0c, 0xe5, 0x25, 0xc0, 0x18, 0x09, 0xcd, 0x38, 0xc5, 0x9c, 0x6d, 0xd2, 0x3c, 0x61, 0x9b, 0x48, 0x3c,
+	0x49, 0x6e, 0x88, 0xf8, 0x8e, 0xaf, 0x15, 0x8a, 0x27, 0x72, 0xa8, 0x58, 0x22, 0xa3, 0xbb, 0xd1, 0xaf, 0x7f,
+	0xde, 0xdd, 0xfe, 0xa1, 0xbe, 0x8f, 0xff, 0x1d, 0xdd, 0x9e, 0x5d, 0x58, 0x91, 0x0e, 0x31, 0x45, 0xba, 0x06,
+	0x14, 0x61, 0x2b, 0x9a, 0x63, 0xae, 0x2b, 0xa1, 0x0d, 0x4a, 0xfd, 0x70, 0xab, 0x10, 0x34, 0xde, 0x20, 0x34,
+	0x6a, 0xb8, 0x12, 0x51, 0x68, 0x4c, 0xe3, 0xdd, 0x3f, 0x10, 0x7b, 0x9e, 0x30, 0xec, 0xcc, 0x55, 0x26, 0x6e,
+	0x05, 0xa3, 0x8a, 0x8d, 0x03, 0x5b, 0x9d, 0x28, 0x67, 0x89, 0x62, 0xad, 0x82, 0x2a, 0x7e, 0x09, 0x0b, 0x6c,
+	0x1e, 0x69, 0x8e, 0x1d, 0x08, 0xe5, 0xb8, 0xfc, 0x02, 0x55, 0xbc, 0xbd, 0xe0, 0xb2, 0xf9, 0x9c, 0x2e, 0xbb,
+	0x10, 0x2f, 0xad, 0xd9, 0xd8, 0x25, 0x5d, 0xf6, 0x07, 0x2d, 0xc0, 0x58, 0x8f, 0xdd, 0xab, 0xa8, 0xd8, 0x96,
+	0x04, 0xab, 0x48, 0xb2, 0x0b, 0xbe, 0xaa, 0x9f, 0x38, 0x34, 0xbb, 0xaa, 0x13, 0x0e, 0x82, 0xab, 0xdd, 0x41,
+	0xd4, 0xba, 0x22, 0xd8, 0x0b, 0x85, 0xea, 0x85, 0x4c, 0x1b, 0xc8, 0x52, 0x7f, 0xb3, 0x30, 0x1c, 0xab, 0x7a,
+	0x61, 0xe8, 0xff, 0x47, 0xf1, 0x28, 0xf0, 0x64, 0x3b, 0xba, 0x54, 0x3f, 0xd9, 0x15, 0x2d, 0x7d, 0xcd, 0xcb,
+	0x3a, 0x3c, 0x64, 0x09, 0x84, 0xd4, 0x73, 0xac, 0x61, 0x30, 0x4f, 0xef, 0x42, 0x79, 0x42, 0x47, 0xb0, 0x49,
+	0x15, 0xf6, 0x71, 0xdd, 0xe8, 0x03, 0x6e, 0x87, 0x7d, 0xbc, 0x6f, 0xf4, 0x81, 0x1b, 0x50, 0x61, 0x27, 0x3f,
+	0x06, 0x9c, 0xbc, 0xf8, 0x80, 0x65, 0x89, 0x76, 0xea, 0xd2, 0xcb, 0x28, 0x9e, 0x16, 0xe8, 0x73, 0x5f, 0x71,
+	0xff, 0x6f, 0x3a, 0x77, 0x99, 0x5c, 0xf4, 0x84, 0x76, 0xf5, 0x68, 0xad, 0xed, 0xa2, 0x8e, 0xad, 0x75, 0x36,
+	0x63, 0xc5, 0xfe, 0xc2, 0x73, 0x59, 0xa6, 0x6b, 0x52, 0x96, 0xf4, 0x2c, 0x1c, 0x53, 0x79, 0xda, 0x9b, 0x53,
+	0x10, 0xe2, 0xb8, 0xae, 0x99, 0x65, 0x2e, 0x95, 0x84, 0xe0, 0x55, 0xfd, 0x25, 0x81, 0xf7, 0x8f, 0x52, 0x12,
+	0x26, 0xe5, 0x3d, 0x94, 0x84, 0xc9, 0xe7, 0xf3, 0x44, 0xad, 0x6b, 0xe7, 0xa0, 0x0c, 0xbc, 0x37, 0xdd, 0xa3,
+	0x3f, 0x7f, 0x4d, 0xd9, 0x0b, 0xe4, 0xae, 0x9c, 0x1c, 0x4e, 0xd8, 0xe7, 0xf6, 0x6c, 0xad, 0x55, 0xa6, 0xde,
+	0xc8, 0xdc, 0x37, 0x32, 0xf7, 0x88, 0x64, 0xee, 0xce, 0xb8, 0xd8, 0x05, 0xb3, 0x19, 0xd6, 0x1d, 0xc0, 0x90,
+	0x7e, 0x8e, 0x51, 0xdf, 0xe9, 0x97, 0xa6, 0x65, 0x8e, 0xba, 0xff, 0x18, 0x60, 0xe1, 0xae, 0x39, 0xc2, 0xba,
+	0xfc, 0x1d, 0x16, 0x22, 0x54, 0xec, 0x5d, 0x03, 0x9a, 0x57, 0x53, 0x16, 0xa7, 0x0e, 0xe2, 0xed, 0x96, 0xbb,
+	0xd3, 0x7d, 0xfd, 0x4e, 0xa1, 0x3b, 0xb5, 0x22, 0x6c, 0x0f, 0xdc, 0xa9, 0x27, 0xbc, 0x19, 0xde, 0x03, 0x5b,
+	0x57, 0x5e, 0xe2, 0xb6, 0x40, 0xeb, 0x88, 0xb3, 0x05, 0xad, 0x52, 0x30, 0xdb, 0xd0, 0xc4, 0x4f, 0x86, 0x41,
+	0xc1, 0x5e, 0x0a, 0xd1, 0x9e, 0xf2, 0x8e, 0xbb, 0xe4, 0x78, 0x16, 0xca, 0xe1, 0x9f, 0x35, 0x23, 0xf3, 0xb4,
+	0xa0, 0xe4, 0x69, 0x05, 0x1f, 0xdf, 0x91, 0x65, 0x9a, 0x65, 0x29, 0xa7, 0x33, 0x06, 0x67, 0x08, 0x71, 0x91,
+	0x7d, 0xad, 0xb0, 0x65, 0xbe, 0xda, 0x6b, 0x07, 0x96, 0x8d, 0x15, 0x91, 0xa5, 0xab, 0x2b, 0x48, 0x97, 0x55,
+	0xcc, 0xc3, 0x75, 0x15, 0xea, 0xe3, 0x02, 0x38, 0x59, 0xa5, 0x52, 0xea, 0x69, 0xb2, 0x8a, 0xd9, 0xa1, 0x60,
+	0xb2, 0xad, 0xd9, 0xb1, 0xdd, 0x81, 0x4d, 0x27, 0xc3, 0x31, 0xd5, 0xa0, 0x42, 0xaf, 0x60, 0x97, 0x0e, 0xc3,
+	0x15, 0x1d, 0x02, 0x23, 0xda, 0x11, 0x93, 0x73, 0x4e, 0x08, 0xce, 0x59, 0x13, 0x37, 0x27, 0x0a, 0xd8, 0xd4,
+	0x91, 0x24, 0x5e, 0xca, 0xc6, 0x3c, 0xe6, 0x2c, 0xdf, 0x3a, 0xc1, 0x2f, 0x4d, 0xe4, 0x8a, 0xcf, 0xf3, 0x31,
+	0xb1, 0x95, 0xbd, 0x23, 0x06, 0x75, 0x52, 0xfa, 0x46, 0x54, 0x25, 0x5c, 0x66, 0xed, 0x28, 0x80, 0x26, 0x09,
+	0xbf, 0xcf, 0xf3, 0x71, 0xd8, 0x8f, 0xa3, 0xd6, 0x43, 0x95, 0xf7, 0x38, 0x83, 0x0a, 0xd8, 0x1e, 0xa0, 0x38,
+	0x7d, 0x5e, 0xe2, 0x7f, 0x8f, 0x47, 0x9c, 0x17, 0x0d, 0x51, 0xd7, 0x62, 0xd5, 0xb4, 0xb1, 0x0b, 0xf8, 0x70,
+	0x0c, 0x55, 0x74, 0x83, 0x38, 0xb9, 0xa4, 0x30, 0xfe, 0xe7, 0xee, 0xc3, 0xdd, 0xb0, 0x2c, 0x31, 0x4d, 0x59,
+	0xee, 0x6a, 0x86, 0x4d, 0xb7, 0xdd, 0x5e, 0x3b, 0x7c, 0xe9, 0x8b, 0xfc, 0xb6, 0xa4, 0xcb, 0xcb, 0x0b, 0x7b,
+	0xad, 0x78, 0x75, 0x45, 0xea, 0x17, 0x8a, 0x17, 0x6e, 0x7c, 0x06, 0x57, 0xe4, 0x54, 0xa3, 0xab, 0x9a, 0xc6,
+	0x89, 0x0e, 0xcd, 0x3e, 0x24, 0x6d, 0x54, 0x56, 0xba, 0xeb, 0xb1, 0x96, 0xb2, 0xa1, 0xa5, 0x2b, 0x76, 0x5a,
+	0x42, 0x56, 0xfc, 0x85, 0x12, 0xfe, 0x54, 0x50, 0x92, 0xae, 0x49, 0xca, 0x49, 0x6c, 0x45, 0x57, 0xb3, 0x82,
+	0xbf, 0xd9, 0x6a, 0x69, 0x73, 0x62, 0xe0, 0x39, 0x23, 0x36, 0x1b, 0xe9, 0x1e, 0x03, 0xb9, 0x15, 0x4d, 0x6e,
+	0xc8, 0x18, 0x4d, 0xed, 0x9b, 0x25, 0x2d, 0x70, 0xe5, 0x54, 0xb9, 0xc3, 0x41, 0xf0, 0x19, 0x49, 0x84, 0x33,
+	0x5a, 0x27, 0x10, 0x2e, 0x6d, 0x90, 0xd5, 0xa9, 0x7d, 0x4d, 0x0f, 0x88, 0x22, 0x0c, 0xec, 0x67, 0xfc, 0x4a,
+	0xe2, 0x8a, 0x96, 0xd3, 0x79, 0xbc, 0x8f, 0xca, 0x5e, 0xeb, 0xd2, 0x77, 0x66, 0x3d, 0x79, 0x92, 0xc2, 0xde,
+	0xee, 0x1a, 0x5e, 0xd7, 0x29, 0xd8, 0x39, 0xe3, 0xc7, 0x5e, 0x24, 0xbe, 0xdb, 0xa8, 0x79, 0xdb, 0x09, 0x79,
+	0xb7, 0xd5, 0xf0, 0xaa, 0xf8, 0x50, 0x60, 0xe9, 0x9c, 0x0c, 0xcc, 0xdc, 0x5e, 0xa3, 0x39, 0x4a, 0xfa, 0x37,
+	0xa1, 0x4e, 0xa7, 0x14, 0x2a, 0x5d, 0xe9, 0x9f, 0xdb, 0xea, 0x5f, 0xbd, 0x1d, 0x68, 0x25, 0x50, 0xad, 0x90,
+	0xbb, 0x96, 0x5e, 0xbe, 0xee, 0x37, 0x11, 0x5c, 0x49, 0x20, 0x5c, 0x51, 0x02, 0x39, 0xab, 0x1d, 0x93, 0x9f,
+	0xc9, 0x7b, 0x99, 0x96, 0xba, 0xcb, 0xd7, 0x09, 0xc8, 0x8e, 0xc4, 0x6f, 0x23, 0xf5, 0x9e, 0x78, 0xbc, 0xa0,
+	0x43, 0xb2, 0x60, 0x0f, 0x9f, 0xf9, 0x03, 0x6c, 0xbb, 0x3f, 0x40, 0x84, 0x49, 0x32, 0x81, 0x8f, 0x64, 0x9a,
+	0xe6, 0x71, 0xf1, 0xf5, 0x9e, 0x4c, 0x74, 0x9f, 0x73, 0xaf, 0x75, 0x59, 0xca, 0x1d, 0xe4, 0xa7, 0x2f, 0xe6,
+	0xa7, 0x2f, 0x17, 0x17, 0x8e, 0x6a, 0x15, 0xca, 0xfb, 0x37, 0x26, 0x5a, 0xef, 0x82, 0x29, 0xcd, 0x90, 0xf3,
+	0x96, 0x1c, 0x67, 0x1a, 0xd7, 0xca, 0x84, 0xe6, 0xb6, 0x05, 0xcd, 0x9f, 0xd5, 0x75, 0xa1, 0x22, 0xb2, 0xd3,
+	0x84, 0x3b, 0x36, 0x2c, 0x24, 0x46, 0xd9, 0x75, 0x8a, 0xbf, 0xc6, 0xc3, 0x23, 0x18, 0x67, 0x3e, 0xa6, 0x99,
+	0xd8, 0xb0, 0xb7, 0x93, 0x9c, 0x5c, 0xdf, 0xc3, 0x1a, 0x9f, 0x39, 0x27, 0x2c, 0x83, 0x68, 0xfd, 0x48, 0xf3,
+	0x7e, 0x5f, 0x92, 0xd1, 0xba, 0x4d, 0xa8, 0x07, 0x99, 0x54, 0x23, 0xf5, 0x2e, 0x89, 0x35, 0x60, 0x2a, 0x65,
+	0x27, 0xdc, 0xe1, 0x24, 0x9d, 0x93, 0x9c, 0xe9, 0x8a, 0x83, 0xf1, 0x48, 0x8a, 0x6a, 0x8d, 0x82, 0x64, 0xc1,
+	0xca, 0xd5, 0xaf, 0x26, 0x1c, 0x54, 0x0c, 0x9e, 0xf8, 0xdf, 0x90, 0xc4, 0x59, 0x66, 0xa2, 0x75, 0xc2, 0xb8,
+	0x96, 0x51, 0xba, 0xc2, 0xee, 0x3e, 0x67, 0xe4, 0x53, 0xfc, 0x1c, 0x8f, 0x67, 0x45, 0xba, 0x32, 0xad, 0x44,
+	0xa5, 0x44, 0x7e, 0x20, 0x09, 0x8d, 0x93, 0x8c, 0xcd, 0xbe, 0x7c, 0xab, 0x07, 0xd7, 0x9a, 0x8a, 0x52, 0x13,
+	0x99, 0x81, 0xad, 0x29, 0x5e, 0x20, 0x81, 0x25, 0x8b, 0x20, 0xd2, 0x13, 0x1a, 0xac, 0xd8, 0x41, 0x84, 0xab,
+	0x8e, 0xbe, 0x58, 0x75, 0xa8, 0xd2, 0x71, 0x5f, 0x5e, 0x2f, 0x37, 0x74, 0x2e, 0xed, 0x23, 0xa5, 0xba, 0xe6,
+	0x32, 0xf8, 0xc9, 0x88, 0x85, 0xff, 0x1b, 0x00, 0xe1, 0x1e, 0x8c, 0x80, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6,
+	0xec, 0x9d, 0x5b, 0x6f, 0xdb, 0x36, 0x14, 0xc7, 0x9f, 0xdb, 0x4f, 0xc1, 0x19, 0x18, 0x60, 0x63, 0x81, 0x82,
+	0x24, 0x7b, 0xca, 0xd6, 0x87, 0x2d, 0x45, 0x87, 0x5d, 0x90, 0x0c, 0xc8, 0x5e, 0x86, 0x20, 0x08, 0x6c, 0x89,
+	0x76, 0xd8, 0xca, 0x64, 0x20, 0xca, 0x35, 0x8a, 0xa1, 0xdf, 0x7d, 0x38, 0x87, 0xa4, 0x44, 0x4a, 0xa4, 0x2e,
+	0xb6, 0xe2, 0xc8, 0x45, 0x5e, 0x82, 0x48, 0xa2, 0x78, 0xc4, 0x8b, 0x28, 0xf2, 0xf0, 0xff, 0x3b, 0x1e, 0x7b,
+	0x7c, 0x89, 0x32, 0xa0, 0xc4, 0xe9, 0x29, 0xcc, 0x55, 0xc8, 0x96, 0x2e, 0xc8, 0x22, 0x13, 0x5b, 0x49, 0x33,
+	0xf2, 0xcb, 0xdf, 0xbf, 0xe3, 0xfb, 0x70, 0x2d, 0x12, 0x1a, 0x7d, 0x94, 0x78, 0x8c, 0x33, 0x21, 0xc9, 0xf8,
+	0x2a, 0x55, 0x9e, 0x2c, 0xc1, 0xf1, 0xf4, 0xf4, 0x09, 0x67, 0x31, 0x28, 0x20, 0x86, 0x2c, 0x60, 0x2a, 0x9a,
+	0xcc, 0xb3, 0x44, 0x12, 0x78, 0x56, 0x3b, 0x87, 0x59, 0x64, 0x20, 0x35, 0x26, 0xe1, 0xf4, 0x1f, 0xb7, 0xd0,
+	0xb6, 0x4a, 0xa6, 0x6f, 0x28, 0x54, 0xf8, 0x72, 0x4d, 0x0a, 0x6f, 0x32, 0xc4, 0x94, 0xd0, 0x54, 0x08, 0xa6,
+	0xb7, 0xa9, 0xcb, 0x48, 0xb3, 0x72, 0x48, 0x71, 0xe1, 0x7f, 0x65, 0x00, 0x0a, 0x15, 0x7c, 0x42, 0x9f, 0x9e,
+	0x4e, 0x96, 0x52, 0x51, 0x6f, 0xda, 0x3a, 0x17, 0x09, 0xbd, 0x52, 0x78, 0xa4, 0x95, 0x48, 0x29, 0x0d, 0x26,
+	0x33, 0x2b, 0x97, 0xd8, 0x24, 0x32, 0x64, 0xac, 0xa3, 0x41, 0x58, 0x98, 0xce, 0x57, 0xe6, 0x17, 0x65, 0x98,
+	0xe0, 0x03, 0x4b, 0x53, 0xd4, 0xdb, 0x2d, 0xac, 0x00, 0x05, 0xea, 0x83, 0xaa, 0x73, 0xb6, 0x79, 0x48, 0x9d,
+	0xbd, 0x52, 0x0b, 0xdb, 0xdd, 0xf9, 0x4e, 0xd2, 0x58, 0x49, 0xc0, 0x60, 0x0f, 0x5f, 0xd7, 0x50, 0xf4, 0x98,
+	0xe5, 0xac, 0xd6, 0x1b, 0x25, 0x8d, 0xb5, 0xdc, 0x8a, 0xfc, 0x40, 0x78, 0x29, 0xda, 0x42, 0x12, 0xc7, 0x7d,
+	0x02, 0x95, 0xf7, 0x26, 0x67, 0xa9, 0x5d, 0x7a, 0x38, 0x76, 0xca, 0x6e, 0x53, 0x92, 0xef, 0x30, 0xb9, 0x7d,
+	0xaa, 0x92, 0xf0, 0x3d, 0xad, 0x25, 0xd4, 0xa7, 0x00, 0x63, 0x24, 0x34, 0x95, 0x14, 0x4b, 0x66, 0xe1, 0x40,
+	0x5b, 0xc6, 0x13, 0xb1, 0xad, 0xb6, 0xb7, 0xae, 0x00, 0x75, 0x31, 0x52, 0x16, 0xc8, 0x3b, 0x9d, 0x18, 0x5f,
+	0x30, 0x95, 0x99, 0x95, 0x91, 0xa4, 0xe9, 0x32, 0x90, 0x0d, 0x5c, 0x2a, 0x33, 0x81, 0x23, 0x2b, 0x8b, 0xff,
+	0xde, 0xfa, 0xc6, 0xc5, 0x78, 0xce, 0xb9, 0xc8, 0x89, 0x1a, 0xea, 0xe0, 0x6d, 0x9d, 0x72, 0xca, 0x70, 0x3a,
+	0xab, 0x1f, 0x98, 0x8b, 0x4c, 0x99, 0x64, 0x92, 0x68, 0x73, 0x8a, 0x4b, 0x55, 0xe3, 0x59, 0x53, 0x58, 0x94,
+	0x43, 0x85, 0x44, 0xb9, 0xbe, 0xb9, 0xfe, 0xf5, 0xaf, 0x9b, 0xab, 0x3f, 0xcd, 0xf1, 0xed, 0xbf, 0xd7, 0x57,
+	0x47, 0x17, 0x1d, 0xa5, 0x47, 0x68, 0x94, 0xbe, 0x71, 0x51, 0xc4, 0x13, 0xe5, 0x58, 0xea, 0x5a, 0x84, 0x86,
+	0xca, 0xe7, 0xa4, 0x53, 0x24, 0x9d, 0x60, 0x2c, 0x1d, 0xd3, 0xbb, 0x54, 0x30, 0x9d, 0xf2, 0x1d, 0x7c, 0x7e,
+	0xae, 0xf7, 0x38, 0x99, 0xde, 0xd8, 0x15, 0x58, 0xee, 0xc5, 0xd4, 0x2a, 0xff, 0x87, 0x2d, 0xb2, 0xd4, 0x93,
+	0x5d, 0xb5, 0xe4, 0x42, 0x18, 0x41, 0x33, 0x0f, 0xdb, 0x47, 0xca, 0x71, 0x1c, 0xa4, 0x12, 0x57, 0x91, 0x20,
+	0xee, 0xb7, 0xd7, 0x8d, 0x36, 0x66, 0xd4, 0xc7, 0x99, 0xf2, 0xb5, 0x33, 0xe2, 0xbb, 0xa6, 0xeb, 0xe9, 0xac,
+	0x03, 0xdf, 0x1b, 0x48, 0xb7, 0x13, 0xdc, 0xdb, 0x11, 0xc4, 0x55, 0x26, 0xfb, 0x50, 0xb8, 0xc5, 0x1d, 0x87,
+	0x46, 0x70, 0x0b, 0xc3, 0x8d, 0xfc, 0x6d, 0x7f, 0x9e, 0xd6, 0x57, 0x05, 0x23, 0x83, 0x69, 0x5b, 0x08, 0xda,
+	0x70, 0xbf, 0xf1, 0xe3, 0xb3, 0xbe, 0xf4, 0xdd, 0xd8, 0xd9, 0xe2, 0xce, 0xbe, 0xe0, 0xac, 0x81, 0x36, 0x2b,
+	0xb4, 0x66, 0xf1, 0x45, 0xbf, 0x1c, 0xb4, 0x28, 0x67, 0x4d, 0x45, 0x41, 0xc3, 0xe0, 0x79, 0x1b, 0xd6, 0xe6,
+	0x79, 0xab, 0x4d, 0xb8, 0x3c, 0xac, 0xcd, 0x8b, 0x56, 0x9b, 0xe8, 0xb5, 0x1b, 0xd6, 0xe8, 0x8f, 0x0d, 0x46,
+	0x2d, 0x9c, 0x39, 0xa3, 0x05, 0x37, 0xff, 0x90, 0xd1, 0x65, 0x1d, 0x68, 0xc6, 0x04, 0x5e, 0x2c, 0xd9, 0xbe,
+	0xd5, 0x87, 0x25, 0x3b, 0x57, 0xd0, 0x33, 0xf3, 0x79, 0xe6, 0x5c, 0x42, 0x7b, 0x1a, 0x38, 0x86, 0x9d, 0x3d,
+	0x7b, 0xe2, 0xb0, 0x13, 0x54, 0x1c, 0xc6, 0x7f, 0xcf, 0x76, 0xc1, 0x7f, 0xcf, 0x77, 0xc3, 0x7f, 0x2f, 0x82,
+	0xf8, 0x6f, 0x6b, 0xcb, 0x86, 0xd8, 0x5f, 0x7f, 0x13, 0xab, 0xdd, 0xde, 0x57, 0xf2, 0xf7, 0x95, 0xfc, 0x1d,
+	0x03, 0xf9, 0x3b, 0x18, 0x77, 0xbb, 0x12, 0x36, 0x23, 0x3b, 0x00, 0x6c, 0xe9, 0xb0, 0x95, 0x95, 0x89, 0xd1,
+	0xa1, 0xc1, 0x4a, 0x18, 0xf3, 0xc6, 0x83, 0x47, 0x0e, 0x4d, 0x43, 0xfa, 0x2a, 0xf7, 0xb0, 0x28, 0xa4, 0x21,
+	0x08, 0x5b, 0x00, 0x43, 0x4f, 0x5d, 0x8c, 0x1d, 0x27, 0x1c, 0x96, 0x1e, 0x2c, 0xbe, 0x28, 0x83, 0xa2, 0x83,
+	0x66, 0x41, 0xd8, 0x1d, 0x1b, 0x34, 0x77, 0x04, 0x0b, 0xfc, 0x0c, 0x84, 0x60, 0x75, 0x85, 0xdb, 0x01, 0x10,
+	0x24, 0x8e, 0x23, 0xdd, 0x58, 0x28, 0x9d, 0xe9, 0x24, 0xcc, 0xb7, 0x41, 0xc5, 0x9e, 0x28, 0xe9, 0xa1, 0xc9,
+	0x1d, 0x7d, 0xfd, 0xb8, 0xa3, 0x2b, 0xe1, 0x4f, 0x2e, 0xc8, 0x92, 0x65, 0x94, 0x6c, 0x9e, 0xe0, 0xdf, 0x33,
+	0xb2, 0x66, 0x69, 0xca, 0x24, 0x8d, 0x05, 0xec, 0x84, 0xcc, 0xb3, 0xf4, 0x4b, 0x8d, 0x90, 0x0b, 0xb5, 0x5e,
+	0x37, 0x3c, 0xee, 0xd6, 0x70, 0x65, 0x45, 0x73, 0x35, 0x32, 0x72, 0xb5, 0xe4, 0xcd, 0x6d, 0xd5, 0x65, 0x80,
+	0xf5, 0x40, 0x71, 0xb5, 0x46, 0xf1, 0x33, 0x71, 0xb5, 0x64, 0x87, 0x42, 0xe2, 0xf6, 0x26, 0xe0, 0x86, 0xc3,
+	0xb3, 0x46, 0x43, 0x63, 0x79, 0x80, 0xa7, 0x1d, 0x08, 0xac, 0xc3, 0xd0, 0x51, 0x87, 0x80, 0xa1, 0x06, 0x22,
+	0x8b, 0x8e, 0x09, 0x24, 0x3a, 0x6a, 0x6e, 0x68, 0xa4, 0x98, 0x90, 0x8f, 0x87, 0x09, 0xb2, 0x42, 0xe5, 0x6d,
+	0xce, 0x22, 0xb1, 0x17, 0xc2, 0xd3, 0xc6, 0xdf, 0x84, 0x72, 0x7e, 0x49, 0xf8, 0xe6, 0xd9, 0x41, 0x09, 0x1f,
+	0x10, 0xd0, 0x0a, 0xdc, 0x34, 0xd7, 0x59, 0x37, 0x96, 0xa1, 0x0d, 0x44, 0x08, 0xe5, 0xfc, 0x32, 0x04, 0xcb,
+	0x8b, 0xb6, 0x43, 0x9d, 0x5a, 0x39, 0x82, 0x06, 0xd8, 0x1f, 0x03, 0x19, 0x3f, 0xf5, 0xf1, 0xcd, 0x43, 0x1e,
+	0xc7, 0xc5, 0x74, 0xf8, 0x7a, 0xac, 0x99, 0x36, 0xf6, 0xc1, 0x37, 0x5e, 0x42, 0xdb, 0xdd, 0x22, 0xb1, 0xae,
+	0xe8, 0xa4, 0xff, 0xb9, 0x79, 0x7f, 0x73, 0x59, 0x15, 0xca, 0x32, 0xc1, 0x5d, 0xe5, 0x73, 0x39, 0x6c, 0x77,
+	0x57, 0x40, 0x9f, 0x84, 0xe2, 0xd7, 0xad, 0xe9, 0xba, 0x41, 0x19, 0x0d, 0x0e, 0x9f, 0xc0, 0x16, 0xaa, 0x59,
+	0xcb, 0xdc, 0x3e, 0x6e, 0xf2, 0x44, 0x6c, 0x79, 0x21, 0xdf, 0x0d, 0xc9, 0x7a, 0x6b, 0x3b, 0xab, 0x3d, 0x36,
+	0x43, 0x8f, 0x48, 0xed, 0x9b, 0x52, 0x9f, 0xd6, 0xb7, 0xe2, 0x25, 0xf2, 0x09, 0x77, 0xcb, 0x61, 0x00, 0x85,
+	0x08, 0xf8, 0xb2, 0x85, 0x45, 0xc1, 0x35, 0x59, 0xa6, 0x73, 0xfb, 0x14, 0x45, 0xba, 0xd6, 0xa9, 0xef, 0xcb,
+	0x45, 0xd5, 0x28, 0x35, 0xba, 0xfd, 0xe5, 0xb8, 0x6e, 0xa6, 0x90, 0xce, 0x19, 0x44, 0x9f, 0x45, 0xad, 0xbb,
+	0x8f, 0x30, 0xb7, 0x9b, 0x26, 0x77, 0x5f, 0x39, 0xae, 0x09, 0xf5, 0x04, 0x29, 0x1d, 0x27, 0x7c, 0xec, 0x7a,
+	0x7d, 0xda, 0x03, 0x9e, 0xbf, 0x69, 0x72, 0x1a, 0x55, 0x74, 0x11, 0x41, 0x05, 0xa7, 0x4f, 0x1d, 0xb5, 0x98,
+	0x27, 0xc5, 0xd3, 0x5c, 0x92, 0x2e, 0x1a, 0xd2, 0x72, 0x4e, 0xf2, 0xc6, 0x72, 0x72, 0xe9, 0x27, 0xc3, 0x99,
+	0x8b, 0x22, 0x24, 0x3e, 0x51, 0x22, 0x37, 0x19, 0x25, 0x2c, 0x27, 0x4c, 0x92, 0xb9, 0x15, 0x28, 0x52, 0x8f,
+	0xdd, 0x7a, 0x47, 0xc3, 0xd6, 0x3f, 0xb7, 0xeb, 0x6e, 0x83, 0x65, 0xab, 0xed, 0x90, 0x29, 0x99, 0x6d, 0xa5,
+	0xa6, 0x7d, 0xbf, 0xc5, 0xe0, 0x4a, 0x11, 0xe1, 0x8c, 0x11, 0xe6, 0x59, 0x2f, 0x1d, 0xf9, 0x99, 0x5c, 0x68,
+	0x5b, 0xe6, 0xaa, 0xcc, 0x13, 0xd0, 0x09, 0xa9, 0xdf, 0x64, 0x9a, 0x6c, 0xe4, 0x7c, 0x45, 0x2f, 0xc9, 0x4a,
+	0x3c, 0x7c, 0x94, 0x0f, 0xe0, 0x28, 0x7f, 0x80, 0xc8, 0x96, 0xe4, 0x0e, 0xfe, 0x25, 0x0b, 0xc6, 0xe7, 0xd9,
+	0x97, 0x7b, 0x72, 0x57, 0x0c, 0x90, 0xf7, 0x85, 0x90, 0xca, 0x64, 0x07, 0xe5, 0x99, 0x9e, 0x95, 0xba, 0x33,
+	0x4b, 0x2d, 0x0b, 0xed, 0xf5, 0x9b, 0x50, 0xaf, 0xda, 0x4a, 0x18, 0x91, 0x8f, 0xf3, 0x94, 0x12, 0xe7, 0x06,
+	0xe7, 0x26, 0x09, 0xe5, 0x76, 0x0a, 0xca, 0x3f, 0x9b, 0xf3, 0x4a, 0xf6, 0x63, 0xdb, 0x84, 0x2b, 0x36, 0xa4,
+	0xa4, 0xbe, 0x8b, 0x39, 0xc3, 0x5f, 0x01, 0x92, 0x11, 0xf4, 0x80, 0x0f, 0x2c, 0x55, 0x2e, 0x76, 0xdb, 0xe4,
+	0xdd, 0xf9, 0x3d, 0xac, 0xca, 0x85, 0xb3, 0xf3, 0x32, 0x8b, 0xf2, 0x47, 0xca, 0xa7, 0x53, 0x4d, 0x64, 0x17,
+	0x9d, 0xd2, 0xdc, 0x28, 0xb4, 0x7c, 0x68, 0x72, 0x62, 0x4b, 0x8f, 0xa0, 0xc3, 0x18, 0x55, 0x29, 0x5c, 0x95,
+	0x84, 0x2d, 0x09, 0x17, 0x45, 0xe3, 0x41, 0x07, 0xd2, 0x82, 0x5e, 0x57, 0xa5, 0x84, 0xb1, 0x94, 0x20, 0xf0,
+	0xf8, 0x77, 0x2b, 0x51, 0xed, 0x10, 0xa7, 0xa7, 0x24, 0xa1, 0xf3, 0x24, 0x15, 0xf1, 0xa7, 0x13, 0xd5, 0x1d,
+	0xb1, 0x77, 0x33, 0x8e, 0x32, 0x30, 0x91, 0xe1, 0x27, 0x4a, 0xc5, 0xfc, 0xcc, 0xb3, 0x79, 0x4c, 0x75, 0xb7,
+	0x04, 0x79, 0xb0, 0xe7, 0x6b, 0x6a, 0xed, 0xdc, 0x40, 0x8a, 0x5a, 0x3f, 0x75, 0x24, 0x50, 0x33, 0x5b, 0xda,
+	0xbc, 0x42, 0x10, 0x4c, 0xd7, 0x48, 0x54, 0xcc, 0x48, 0xb0, 0x9d, 0x67, 0x11, 0x2e, 0x1b, 0xa6, 0x6a, 0xd9,
+	0x60, 0x2a, 0xcb, 0x11, 0x99, 0x7d, 0x9d, 0xfd, 0x54, 0x28, 0x90, 0xdf, 0xfe, 0x3f, 0x00, 0xa4, 0x8d, 0x9a,
+	0xda, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6, 0xec, 0x9c, 0x5d, 0x6f, 0xf2, 0x36, 0x14, 0xc7, 0xaf, 0xdb, 0x4f,
+	0xe1, 0x87, 0x8b, 0x0a, 0x54, 0x16, 0x4a, 0xbb, 0x2b, 0x3a, 0x2e, 0xb6, 0xb5, 0x9d, 0x3a, 0x69, 0x53, 0xb5,
+	0xed, 0x0e, 0xa1, 0x2a, 0x24, 0x26, 0x75, 0x17, 0x6c, 0x64, 0x87, 0xa2, 0x6a, 0xea, 0x77, 0x9f, 0x8e, 0x5f,
+	0x12, 0x87, 0x04, 0x0a, 0x7a, 0x08, 0x24, 0xc5, 0x37, 0x55, 0x63, 0x3b, 0xc7, 0x96, 0x73, 0xec, 0x9c, 0x98,
+	0xf3, 0xff, 0xd5, 0x9d, 0x2f, 0x91, 0x01, 0x25, 0x7a, 0x3d, 0x88, 0x55, 0xd0, 0x6c, 0x11, 0x27, 0x64, 0x1e,
+	0x63, 0xf4, 0xbb, 0xff, 0xe6, 0xff, 0x1d, 0x70, 0x32, 0x4f, 0xec, 0xf5, 0xa0, 0x43, 0x21, 0x41, 0x68, 0x14,
+	0xab, 0xa3, 0x2c, 0x46, 0xd1, 0xcf, 0x4f, 0x8f, 0x5d, 0x69, 0x60, 0x2e, 0x43, 0x19, 0x99, 0x45, 0xbc, 0xc4,
+	0x13, 0xd8, 0x35, 0x68, 0xe8, 0xf3, 0x50, 0x20, 0x18, 0x30, 0xfa, 0x93, 0x85, 0xd8, 0x7b, 0x15, 0xd0, 0xdc,
+	0x83, 0xe6, 0xf2, 0x96, 0x7b, 0xdb, 0x36, 0x3c, 0x40, 0x12, 0x62, 0x8e, 0xc3, 0x81, 0xac, 0xfc, 0x01, 0xfd,
+	0xc2, 0xd9, 0x52, 0x60, 0x2e, 0xf4, 0xa5, 0x36, 0xa1, 0xaf, 0xee, 0x21, 0x0c, 0xe1, 0x8c, 0xea, 0xcb, 0x27,
+	0x9f, 0x07, 0x38, 0xd6, 0x1a, 0x3d, 0x9d, 0xf8, 0xaf, 0x37, 0x31, 0x78, 0x15, 0xb6, 0xd2, 0xe3, 0xe9, 0x96,
+	0x72, 0x98, 0x5e, 0xcf, 0x54, 0x5b, 0x8e, 0x2f, 0x12, 0x71, 0x7e, 0xf6, 0x81, 0x70, 0x2c, 0x30, 0xb2, 0xec,
+	0x2c, 0x09, 0x0d, 0xd9, 0xb2, 0xdc, 0x8e, 0xaa, 0xf3, 0xb4, 0xad, 0xa1, 0x6e, 0x7b, 0x5b, 0x66, 0x46, 0xe0,
+	0x78, 0x5a, 0x6e, 0x04, 0x6a, 0x32, 0x13, 0x70, 0x95, 0x19, 0x28, 0x95, 0x15, 0x06, 0x3e, 0xa5, 0x2c, 0x41,
+	0x6a, 0x39, 0x82, 0x47, 0xb5, 0x29, 0x26, 0x32, 0xe4, 0x52, 0x56, 0xba, 0x66, 0xcc, 0x94, 0x71, 0xd5, 0x2f,
+	0x11, 0x48, 0xf7, 0x59, 0xaa, 0xa1, 0xf4, 0xb4, 0xec, 0x10, 0x44, 0x39, 0x7a, 0xb8, 0xa6, 0xa4, 0x74, 0xc4,
+	0x2b, 0x77, 0x0d, 0x4d, 0xeb, 0x12, 0xcb, 0x53, 0x01, 0x46, 0xf3, 0x37, 0xe4, 0x8c, 0x48, 0x4c, 0x88, 0xae,
+	0x68, 0xb7, 0xa6, 0xa2, 0xa0, 0x7c, 0x3c, 0x38, 0xe6, 0x64, 0x2b, 0xc4, 0xc9, 0x21, 0xf0, 0x26, 0x8d, 0xa2,
+	0x99, 0xec, 0x80, 0x32, 0x71, 0x1c, 0x13, 0xc7, 0x31, 0x71, 0x1c, 0x13, 0xc7, 0x31, 0x71, 0x1c, 0x13, 0xc7,
+	0x31, 0xd9, 0x89, 0x63, 0x52, 0xc6, 0x30, 0x71, 0xfc, 0x92, 0x5d, 0xf8, 0x25, 0x39, 0x7c, 0x88, 0x7e, 0x59,
+	0xb1, 0x10, 0xff, 0x2a, 0x4b, 0xed, 0x40, 0x4c, 0xb5, 0x53, 0xd1, 0x40, 0xee, 0x56, 0x6b, 0x9e, 0x73, 0x19,
+	0xb9, 0x13, 0xf3, 0xba, 0xcc, 0xec, 0x79, 0x5c, 0x36, 0x78, 0x20, 0x71, 0x2c, 0xdf, 0x81, 0x13, 0xeb, 0x35,
+	0x57, 0xfa, 0x74, 0x57, 0x89, 0x22, 0xc5, 0x72, 0xd3, 0xbb, 0x92, 0xd6, 0xd9, 0xdf, 0x7e, 0x23, 0x81, 0x03,
+	0xa5, 0x97, 0x80, 0x84, 0x57, 0xed, 0x14, 0xde, 0x0b, 0x4f, 0x48, 0xe1, 0xd3, 0x4d, 0xe0, 0x40, 0x6b, 0x13,
+	0xd0, 0x25, 0xa2, 0x99, 0xc2, 0x41, 0xca, 0xd6, 0x37, 0x0d, 0xb0, 0x80, 0x19, 0x29, 0x96, 0xdb, 0x73, 0xb8,
+	0x48, 0x48, 0xdc, 0xea, 0xd8, 0xb5, 0x6b, 0x6c, 0xe6, 0x28, 0x22, 0xc5, 0xf2, 0x35, 0x36, 0xef, 0xb0, 0x6d,
+	0x53, 0x7e, 0x50, 0x85, 0xf0, 0xdd, 0x68, 0x60, 0x22, 0x42, 0x66, 0xe6, 0x67, 0x1f, 0x6a, 0x5e, 0xd5, 0x38,
+	0x11, 0x87, 0x12, 0x71, 0x28, 0x11, 0x87, 0xfd, 0x70, 0xd8, 0x8f, 0x0d, 0xd8, 0x8f, 0x9a, 0xb1, 0x3e, 0x32,
+	0x5e, 0xc7, 0x55, 0x35, 0x83, 0xe8, 0x57, 0x0c, 0x1c, 0x31, 0xb0, 0x8b, 0x15, 0xca, 0x45, 0xea, 0x57, 0x83,
+	0xf3, 0xb3, 0x4f, 0xfa, 0xd8, 0x38, 0x4d, 0xd2, 0x18, 0x6c, 0x58, 0x83, 0x0a, 0xe6, 0xe6, 0xfa, 0xd3, 0x9e,
+	0xa1, 0xba, 0x8a, 0x9e, 0x6f, 0x3e, 0xed, 0x59, 0xfe, 0x9a, 0x59, 0x45, 0xd7, 0x3f, 0x6e, 0xe8, 0xda, 0xc1,
+	0x5e, 0xb6, 0x87, 0xbd, 0xf4, 0xb7, 0x82, 0xbd, 0x38, 0x2c, 0x8b, 0xc3, 0xb2, 0x38, 0x2c, 0xcb, 0x31, 0xb1,
+	0x2c, 0x7b, 0x83, 0xa2, 0x44, 0xcc, 0x01, 0x4c, 0x1c, 0xc0, 0xc4, 0xf1, 0x4a, 0x2a, 0xe2, 0x95, 0x38, 0x3c,
+	0xc9, 0x46, 0x3c, 0x49, 0x8d, 0x68, 0x24, 0x15, 0xc2, 0x47, 0xfa, 0x3b, 0xd3, 0x47, 0xfa, 0x6b, 0x5d, 0xe9,
+	0x34, 0x69, 0x23, 0x0e, 0x2e, 0xe2, 0xe0, 0x22, 0x4a, 0x8e, 0x72, 0x09, 0xdf, 0xfe, 0xbd, 0x9e, 0xf5, 0x78,
+	0x64, 0xa2, 0x95, 0x4c, 0xa7, 0x15, 0xf0, 0x27, 0x61, 0x68, 0x4a, 0x38, 0x46, 0x8b, 0x39, 0xfc, 0xdb, 0x47,
+	0x33, 0x12, 0xc7, 0x44, 0xe0, 0x80, 0xd1, 0x10, 0x61, 0x9f, 0xc7, 0xef, 0x35, 0xc6, 0x93, 0x9c, 0x08, 0x8d,
+	0xa4, 0x06, 0xf0, 0x11, 0xc7, 0x1a, 0xa9, 0x8a, 0x35, 0x52, 0x17, 0xb4, 0xc8, 0xc1, 0x48, 0x22, 0xb5, 0x66,
+	0x88, 0x34, 0x08, 0x19, 0x52, 0x7f, 0x42, 0x48, 0x93, 0x80, 0x20, 0x8d, 0xe6, 0x7f, 0x9c, 0x08, 0xee, 0xe3,
+	0xc8, 0xa0, 0x8f, 0x86, 0x52, 0x3a, 0x1a, 0x0b, 0xe5, 0x38, 0x32, 0x52, 0xa1, 0x71, 0x2c, 0x8d, 0xe6, 0xa1,
+	0x33, 0xbe, 0xfc, 0x13, 0xfe, 0x7e, 0xe2, 0x45, 0xfd, 0x01, 0x17, 0x5f, 0x9e, 0x67, 0xd1, 0x2c, 0x7c, 0xc5,
+	0x06, 0x5a, 0xc5, 0xae, 0xb0, 0x8a, 0x63, 0x31, 0x2a, 0x0e, 0x87, 0xa4, 0x70, 0xb8, 0x89, 0x7d, 0xe2, 0x26,
+	0x0e, 0x47, 0x97, 0x70, 0xe4, 0x88, 0x7d, 0x91, 0x23, 0x4e, 0x06, 0xe0, 0xb0, 0x35, 0xbc, 0x61, 0x34, 0x3e,
+	0x04, 0x91, 0xa1, 0x73, 0x8b, 0x76, 0x64, 0x32, 0x94, 0xe2, 0x12, 0xf6, 0x47, 0x4c, 0x40, 0xc8, 0x41, 0x13,
+	0x1c, 0x34, 0xc1, 0x41, 0x13, 0x1a, 0x02, 0x4d, 0x70, 0x8a, 0x7f, 0xa7, 0xf8, 0x2f, 0x28, 0xfe, 0x95, 0xa4,
+	0xa3, 0xa8, 0x8c, 0xbe, 0xb8, 0x28, 0x94, 0x79, 0x33, 0x1f, 0x92, 0xa1, 0x86, 0x43, 0x90, 0xd2, 0x2d, 0xe2,
+	0x7c, 0x1b, 0xa3, 0x65, 0x2a, 0x29, 0xf3, 0xde, 0x30, 0x17, 0x32, 0x63, 0x49, 0x56, 0x7e, 0x5b, 0x53, 0xeb,
+	0xe1, 0x54, 0x19, 0xaf, 0x66, 0x0a, 0x06, 0x66, 0x1a, 0x59, 0xbb, 0x10, 0xfa, 0x09, 0xdd, 0x58, 0xd3, 0x0e,
+	0x11, 0x0d, 0x56, 0x7e, 0xb2, 0x10, 0x7e, 0x84, 0x07, 0x28, 0x62, 0xcf, 0xaf, 0xe2, 0x19, 0x52, 0x46, 0x9e,
+	0x21, 0x67, 0x09, 0x8d, 0xe0, 0x5f, 0x34, 0x21, 0xd4, 0xe7, 0xef, 0x63, 0x34, 0x4a, 0x27, 0x75, 0xac, 0x5d,
+	0xc7, 0xf4, 0x21, 0xb3, 0x58, 0x54, 0x48, 0x25, 0x1f, 0xb7, 0xf5, 0x50, 0xc1, 0x19, 0x7f, 0x63, 0x6a, 0x56,
+	0x23, 0x66, 0x64, 0x20, 0xb9, 0xc1, 0x09, 0x19, 0x94, 0x5e, 0x9b, 0x26, 0x4a, 0x12, 0xa2, 0x77, 0x2a, 0x5f,
+	0x08, 0x12, 0xd1, 0xf6, 0x7f, 0xe8, 0x9f, 0x3f, 0x9e, 0xee, 0x1e, 0xff, 0x1a, 0x64, 0xa2, 0x18, 0x26, 0x5a,
+	0x1d, 0x2f, 0x99, 0xcd, 0x95, 0x18, 0xea, 0xa3, 0x9b, 0xda, 0x84, 0x2d, 0xcd, 0x98, 0x52, 0x5a, 0x12, 0x7b,
+	0x98, 0x50, 0x63, 0x03, 0xb7, 0x54, 0x14, 0x96, 0x10, 0xa9, 0x04, 0x05, 0xa7, 0xf7, 0xc3, 0x07, 0x12, 0xab,
+	0xd4, 0x14, 0x7b, 0x94, 0xa3, 0xeb, 0x31, 0x9c, 0x4c, 0xb3, 0x5c, 0xa2, 0x5a, 0xc7, 0x4b, 0x5e, 0x30, 0x6d,
+	0xb7, 0xf5, 0x41, 0x49, 0xba, 0x41, 0x99, 0x1b, 0x99, 0xd6, 0xa4, 0xb4, 0xba, 0xb6, 0x9e, 0x05, 0x5e, 0xfd,
+	0x06, 0x8e, 0x00, 0xb5, 0x02, 0x91, 0x29, 0xa2, 0x2b, 0xc9, 0x64, 0x88, 0x08, 0xa4, 0xfd, 0x35, 0xaf, 0x7f,
+	0x91, 0x3f, 0xd8, 0x81, 0xbe, 0xfe, 0x5b, 0xc4, 0x56, 0x56, 0x23, 0x84, 0x0a, 0x21, 0x48, 0x1f, 0x59, 0xf0,
+	0x6f, 0x57, 0xe6, 0xa1, 0xa9, 0xb0, 0x82, 0x50, 0x29, 0x9d, 0x67, 0x5c, 0xe5, 0xb8, 0xc9, 0x1c, 0xb0, 0x84,
+	0xfb, 0x01, 0x16, 0xe7, 0x67, 0xc6, 0xfb, 0x57, 0xd6, 0x86, 0x5a, 0x5b, 0x57, 0x66, 0xf5, 0x94, 0x2c, 0x90,
+	0x0f, 0x6b, 0x77, 0xd7, 0x8b, 0x25, 0x92, 0x40, 0x33, 0x3d, 0x1b, 0x5e, 0x1a, 0xfb, 0x4a, 0xb7, 0xe8, 0x78,
+	0xf2, 0x58, 0xa8, 0xad, 0x8e, 0x85, 0xcc, 0x44, 0xe5, 0xdd, 0x30, 0x3d, 0x0f, 0x2a, 0xf3, 0xab, 0xce, 0x6d,
+	0x86, 0xd9, 0xf8, 0x7f, 0x00, 0x8b, 0x68, 0x90, 0x83, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6, 0xec, 0x9c, 0xdf,
+	0x4f, 0xdb, 0x3a, 0x14, 0xc7, 0x9f, 0x2f, 0x7f, 0x85, 0xe9, 0x03, 0x6a, 0x75, 0x7b, 0xd3, 0x7b, 0xb9, 0x6f,
+	0x70, 0xfb, 0x70, 0x37, 0x60, 0x62, 0xd2, 0x26, 0xb4, 0x4d, 0xda, 0x43, 0x85, 0x50, 0x9a, 0x38, 0xc1, 0x90,
+	0xda, 0x95, 0x9d, 0x52, 0xa1, 0x89, 0xff, 0x7d, 0x3a, 0x3e, 0x76, 0xe2, 0x50, 0xd3, 0xb5, 0x08, 0xc6, 0x7e,
+	0x9c, 0x17, 0xd4, 0xc4, 0xce, 0x89, 0xe3, 0x9f, 0xc7, 0xe6, 0x7c, 0x3f, 0x3f, 0x3a, 0x5f, 0xa2, 0x05, 0x4a,
+	0x8c, 0x46, 0xe0, 0xab, 0xb0, 0xd9, 0xa2, 0xaa, 0xc5, 0xbc, 0xe2, 0xec, 0x6d, 0x7a, 0x93, 0x7e, 0xcc, 0xb4,
+	0x98, 0xd7, 0xe1, 0x78, 0x70, 0xae, 0x90, 0x11, 0xb2, 0xac, 0xf0, 0x28, 0x4b, 0x49, 0xf6, 0xff, 0xd9, 0xe9,
+	0xd0, 0x1a, 0x98, 0x5b, 0x57, 0xc6, 0x46, 0x11, 0x2f, 0xf9, 0x14, 0x66, 0x0d, 0x99, 0xa7, 0x3a, 0x37, 0x0c,
+	0x0a, 0xcc, 0xde, 0xab, 0x9c, 0x27, 0x57, 0x06, 0xb2, 0x27, 0x90, 0xdd, 0x3e, 0x72, 0x1c, 0xda, 0x86, 0x06,
+	0x14, 0x39, 0xd7, 0x3c, 0x3f, 0xb0, 0x89, 0x7f, 0xb1, 0x57, 0x5a, 0x2d, 0x0d, 0xd7, 0xc6, 0x5d, 0x3a, 0x13,
+	0xee, 0xea, 0x18, 0xdc, 0x10, 0xad, 0xa4, 0xbb, 0x3c, 0x4b, 0x75, 0xc6, 0x2b, 0x77, 0xf1, 0x99, 0x4f, 0xe7,
+	0x69, 0x76, 0xed, 0x14, 0x7b, 0x4e, 0x06, 0xe0, 0xa6, 0x34, 0x58, 0x18, 0x7b, 0xcd, 0x61, 0x75, 0x0f, 0xbb,
+	0xcf, 0x68, 0xe4, 0x93, 0x83, 0x61, 0x60, 0x6a, 0xb3, 0xf3, 0xc7, 0x1d, 0xe3, 0x95, 0xe1, 0x2c, 0xb0, 0xb3,
+	0x14, 0x32, 0x57, 0xcb, 0xb8, 0x1d, 0x4c, 0x4b, 0x9c, 0xad, 0xb1, 0xcb, 0x7b, 0x18, 0x33, 0x63, 0x78, 0x55,
+	0xc4, 0x8d, 0x40, 0x4a, 0x6b, 0x02, 0xae, 0x5a, 0x03, 0x51, 0x91, 0x61, 0x96, 0x4a, 0xa9, 0x6a, 0x86, 0x83,
+	0x13, 0xfa, 0x57, 0x5f, 0x72, 0x61, 0x1d, 0x30, 0xb4, 0x32, 0xf4, 0x65, 0x96, 0x4a, 0xe3, 0x7b, 0x85, 0x61,
+	0xee, 0x9d, 0x51, 0x45, 0x65, 0xe2, 0x44, 0x88, 0x20, 0xd1, 0x71, 0xc5, 0xf5, 0x77, 0xa2, 0x25, 0xbe, 0xf7,
+	0xd4, 0xd8, 0xe7, 0x8e, 0x58, 0x2e, 0x0c, 0x18, 0xed, 0x3e, 0x30, 0x08, 0xd8, 0x25, 0x96, 0x19, 0xe2, 0xee,
+	0xf7, 0x7b, 0x85, 0xb1, 0xc5, 0x0b, 0x9b, 0x11, 0x32, 0x8c, 0xc7, 0x8d, 0x8a, 0x03, 0xac, 0x15, 0xa6, 0x91,
+	0x6f, 0xc0, 0x65, 0xe8, 0x0b, 0x14, 0xc6, 0x1f, 0x3e, 0x76, 0x3c, 0xa2, 0xb6, 0x30, 0x63, 0x56, 0x98, 0xc3,
+	0x56, 0x6b, 0xfb, 0x62, 0x64, 0x95, 0x8d, 0xa8, 0x2a, 0xdf, 0x83, 0xa8, 0xf2, 0x53, 0x01, 0x54, 0xb6, 0xa0,
+	0xa7, 0x10, 0x3a, 0x85, 0xd0, 0x29, 0x84, 0x4e, 0x21, 0x74, 0x0a, 0xa1, 0x53, 0x08, 0x9d, 0xb2, 0x15, 0x3a,
+	0x25, 0x86, 0x4d, 0x21, 0x64, 0xca, 0x36, 0xc8, 0x14, 0x4f, 0x22, 0x59, 0xeb, 0xf8, 0x49, 0x95, 0xf3, 0xd7,
+	0x98, 0x2f, 0x70, 0x00, 0xf1, 0x49, 0xf4, 0x0f, 0xba, 0xc6, 0xda, 0x9a, 0xef, 0x84, 0x05, 0x4f, 0xfd, 0x02,
+	0xda, 0xda, 0x4b, 0xb4, 0xcd, 0x70, 0x22, 0xaa, 0xca, 0xae, 0x8a, 0xd3, 0x60, 0xe1, 0xc3, 0xf6, 0x8e, 0x14,
+	0x76, 0x2d, 0xcf, 0x63, 0x2b, 0xb4, 0xca, 0xc6, 0x4c, 0x95, 0x55, 0x9e, 0xca, 0xea, 0x7d, 0xff, 0xd9, 0x28,
+	0x2c, 0x0c, 0x77, 0xbe, 0x13, 0xc3, 0x33, 0x54, 0x8b, 0x40, 0xb8, 0xaf, 0xeb, 0x9f, 0xc9, 0xa5, 0xae, 0xc5,
+	0xca, 0xc6, 0xd5, 0xf0, 0xcc, 0x29, 0x33, 0xd8, 0x9f, 0x4c, 0xb6, 0xfa, 0x0e, 0x2b, 0xda, 0xef, 0xd6, 0x4c,
+	0xb7, 0x80, 0x21, 0x37, 0xe5, 0x81, 0xc6, 0x8c, 0xe4, 0x0c, 0x9a, 0x73, 0x51, 0x8b, 0xaa, 0x37, 0x08, 0x53,
+	0x57, 0xeb, 0x7f, 0x53, 0x92, 0xcb, 0x53, 0x50, 0x5c, 0x3a, 0x50, 0x96, 0x6f, 0x7f, 0xd1, 0x11, 0x5f, 0xf7,
+	0x45, 0x2e, 0x35, 0xfe, 0x45, 0x9b, 0x60, 0x62, 0x1e, 0x8d, 0x88, 0xb1, 0x5b, 0xe9, 0x1c, 0x4e, 0x0c, 0x7c,
+	0x1e, 0x63, 0x35, 0x19, 0xed, 0x16, 0x3d, 0x79, 0x6e, 0x90, 0x0c, 0x41, 0x64, 0x08, 0x22, 0x43, 0xc0, 0x17,
+	0x02, 0xbe, 0x10, 0xf0, 0x85, 0x80, 0x2f, 0x04, 0x7c, 0x21, 0xe0, 0x0b, 0x01, 0x5f, 0x08, 0xf8, 0xf2, 0xdc,
+	0xc0, 0x97, 0x27, 0xc3, 0xad, 0x94, 0x8a, 0xd0, 0x28, 0x84, 0x46, 0x21, 0x34, 0x0a, 0xa1, 0x51, 0x08, 0x8d,
+	0x42, 0x68, 0x14, 0x42, 0xa3, 0x10, 0x1a, 0xe5, 0xb7, 0x47, 0xa3, 0x40, 0x9f, 0x1d, 0xa2, 0x6a, 0xcd, 0x37,
+	0x8f, 0x0d, 0x13, 0xb3, 0xc1, 0xc0, 0x06, 0xfe, 0xd4, 0x8a, 0x15, 0x42, 0x73, 0xb6, 0x98, 0xc3, 0xcf, 0x7f,
+	0xd8, 0x4c, 0x54, 0x95, 0x30, 0x3c, 0x53, 0x32, 0x67, 0x3c, 0xd5, 0xd5, 0x2d, 0xc1, 0x55, 0x08, 0xae, 0x42,
+	0x70, 0x15, 0x82, 0xab, 0x10, 0x5c, 0x85, 0xe0, 0x2a, 0x04, 0x57, 0x21, 0xb8, 0x0a, 0xc1, 0x55, 0x08, 0xae,
+	0x42, 0x70, 0x15, 0x82, 0xab, 0x10, 0x5c, 0x85, 0xe0, 0x2a, 0x04, 0x57, 0x21, 0xb8, 0x0a, 0xc1, 0x55, 0x08,
+	0xae, 0x42, 0x70, 0x15, 0x82, 0xab, 0x10, 0x5c, 0x85, 0xe0, 0x2a, 0x04, 0x57, 0x79, 0x21, 0xb8, 0x0a, 0x81,
+	0x55, 0x36, 0x07, 0xab, 0x10, 0x54, 0x85, 0xa0, 0x2a, 0x04, 0x55, 0x21, 0xa8, 0xca, 0xaf, 0x07, 0x55, 0xc1,
+	0x71, 0xfb, 0x68, 0xa8, 0x4a, 0x67, 0xb2, 0xa9, 0x95, 0xb2, 0x71, 0x72, 0x44, 0x49, 0x21, 0x4a, 0xca, 0x7a,
+	0x4a, 0x0a, 0xaa, 0xa4, 0x76, 0x30, 0x9e, 0x59, 0x15, 0x20, 0xf3, 0x5d, 0x54, 0x2b, 0x88, 0x09, 0xb6, 0xb7,
+	0xb7, 0x8a, 0x98, 0x88, 0xdc, 0x4b, 0x66, 0x29, 0x44, 0x82, 0x8e, 0xc7, 0xde, 0x4e, 0x98, 0xc7, 0xeb, 0x35,
+	0x23, 0xf7, 0x92, 0x1b, 0xae, 0x8d, 0x0d, 0xd7, 0xb4, 0x89, 0xbb, 0x0f, 0xa4, 0x26, 0xbc, 0x01, 0x8e, 0x60,
+	0x65, 0x42, 0xd9, 0x7d, 0xa6, 0x60, 0xf2, 0x66, 0xff, 0xb1, 0x7f, 0x83, 0x96, 0x01, 0x2f, 0x98, 0x63, 0x57,
+	0x5a, 0x98, 0xb4, 0xe4, 0x07, 0xac, 0x54, 0x17, 0x57, 0xe6, 0x02, 0x86, 0xd6, 0x05, 0x04, 0x6c, 0xb2, 0x09,
+	0xfc, 0x64, 0x53, 0x21, 0x53, 0x7d, 0x7b, 0xce, 0x26, 0x4d, 0xbd, 0x9f, 0xbb, 0xde, 0xe5, 0xdf, 0x61, 0x23,
+	0xf6, 0xd0, 0x0d, 0xb7, 0x3d, 0x22, 0x68, 0x77, 0xe8, 0xaf, 0x6f, 0x14, 0x56, 0x7c, 0xa9, 0xbc, 0xc6, 0xaa,
+	0x53, 0x38, 0x63, 0x37, 0x3b, 0xfb, 0x3e, 0x0b, 0xea, 0xad, 0xdc, 0x04, 0x9f, 0x1a, 0x23, 0x4a, 0xd9, 0xff,
+	0xc2, 0x3e, 0xbd, 0x3b, 0x3b, 0x3a, 0xfd, 0x70, 0xd0, 0xaa, 0xdb, 0x94, 0xe9, 0x0d, 0x92, 0x7a, 0x36, 0x47,
+	0xc1, 0xe7, 0xdd, 0xb0, 0xb1, 0x09, 0x2b, 0x81, 0x37, 0x85, 0x42, 0xad, 0xb0, 0x98, 0x90, 0x12, 0xba, 0xda,
+	0xe8, 0x40, 0xd7, 0xc2, 0xaa, 0xdd, 0x61, 0x5c, 0xa4, 0xf9, 0x89, 0xa8, 0x30, 0xea, 0x2e, 0x2c, 0xe5, 0x64,
+	0xff, 0x1c, 0xfe, 0x27, 0xa5, 0x3a, 0x51, 0xba, 0x83, 0xa4, 0xbe, 0xe4, 0xb2, 0xdf, 0x77, 0xe7, 0x8a, 0xcd,
+	0xbc, 0xee, 0x1f, 0x54, 0x4e, 0xf0, 0xd5, 0x1b, 0x86, 0x62, 0x31, 0xf0, 0x98, 0x3c, 0x73, 0x06, 0x52, 0x0d,
+	0x13, 0x05, 0x93, 0xf7, 0x22, 0x69, 0x99, 0x30, 0xcc, 0x75, 0xe9, 0xae, 0xb8, 0xcc, 0x86, 0x05, 0x80, 0x5e,
+	0x65, 0xb7, 0x54, 0xf7, 0x06, 0x2c, 0x4c, 0xc8, 0x39, 0xc8, 0xbb, 0x55, 0x76, 0x3d, 0xb4, 0x41, 0xb8, 0xe8,
+	0x8d, 0x09, 0x69, 0xf1, 0x20, 0x4a, 0x63, 0x80, 0xaf, 0x0d, 0x80, 0xad, 0x75, 0x9a, 0x71, 0x74, 0x3e, 0x63,
+	0xc3, 0x07, 0x87, 0xdf, 0xdf, 0x7e, 0x80, 0x45, 0xc6, 0xd0, 0x5d, 0xb0, 0x28, 0xba, 0xf1, 0x54, 0xda, 0xad,
+	0x8c, 0xab, 0x8d, 0xa4, 0xd9, 0x2f, 0xd9, 0x6e, 0x31, 0x48, 0xec, 0x29, 0x6a, 0x1f, 0x4f, 0x51, 0x7d, 0x45,
+	0x75, 0xbb, 0x61, 0x73, 0xf6, 0x19, 0xeb, 0x57, 0x83, 0xc3, 0x86, 0x5e, 0xb4, 0xf3, 0x75, 0x00, 0x41, 0x9f,
+	0x0d, 0x4b, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6, 0xec, 0xdc, 0x31, 0x0a, 0x02, 0x31, 0x10, 0x05, 0xd0, 0xab,
+	0xcc, 0x01, 0x6c, 0x3c, 0x92, 0x68, 0x94, 0x40, 0x34, 0xb2, 0xab, 0x85, 0xb7, 0x97, 0xac, 0xd8, 0x0a, 0x42,
+	0x44, 0xc2, 0xbe, 0x66, 0x98, 0x7e, 0xa6, 0xfc, 0xff, 0xf1, 0x25, 0x86, 0xf7, 0x25, 0x86, 0xe2, 0x24, 0xbe,
+	0xb0, 0x24, 0x40, 0x12, 0x20, 0x09, 0x90, 0x04, 0x48, 0x02, 0x24, 0x01, 0x92, 0x00, 0x49, 0xfc, 0x0f, 0x92,
+	0x60, 0x39, 0xb0, 0x1c, 0x58, 0x0e, 0x2c, 0x87, 0xdf, 0x5b, 0x0e, 0xdc, 0x05, 0xee, 0x02, 0x77, 0x81, 0xbb,
+	0xc0, 0x5d, 0xe0, 0x2e, 0x70, 0x17, 0xb8, 0x0b, 0xfd, 0xdc, 0x85, 0x6e, 0xea, 0xc1, 0xa9, 0x12, 0x0a, 0x08,
+	0x05, 0x84, 0x02, 0x42, 0x01, 0xa1, 0x80, 0x50, 0x40, 0x28, 0x20, 0x14, 0xac, 0x5e, 0x28, 0x68, 0x3f, 0xbb,
+	0x79, 0x95, 0x47, 0xde, 0xe7, 0x59, 0xd2, 0x1a, 0x4b, 0x26, 0x6f, 0x6e, 0xe3, 0x56, 0xe3, 0x98, 0xa7, 0x14,
+	0xf7, 0x6b, 0x5b, 0xb7, 0x71, 0xce, 0xa5, 0xe4, 0x39, 0xed, 0xeb, 0xe5, 0x10, 0x69, 0x37, 0x95, 0x07, 0xe3,
+	0x80, 0x71, 0xc0, 0x38, 0x60, 0x1c, 0x30, 0x0e, 0x18, 0x07, 0x8c, 0x03, 0xc6, 0x01, 0xe3, 0x80, 0x71, 0xc0,
+	0x38, 0x60, 0x1c, 0x30, 0x0e, 0x18, 0x07, 0x8c, 0x03, 0xc6, 0x01, 0xe3, 0x80, 0x71, 0xc0, 0x38, 0x60, 0x1c,
+	0x30, 0x0e, 0x18, 0x07, 0x8c, 0x03, 0xc6, 0x01, 0xe3, 0x80, 0x71, 0xc0, 0x38, 0x60, 0x1c, 0x30, 0x0e, 0x18,
+	0x07, 0x8c, 0x03, 0xc6, 0xc1, 0x67, 0xe3, 0xe0, 0x39, 0x00, 0x66, 0x58, 0xaa, 0x55, 0x78, 0xf9, 0x43, 0xa6,
+	0x14, 0xe6, 0xec, 0xdc, 0x31, 0x0a, 0x02, 0x31, 0x10, 0x05, 0xd0, 0xab, 0xcc, 0x01, 0x6c, 0x3c, 0x92, 0x68,
+	0x94, 0x40, 0x34, 0xb2, 0xab, 0x85, 0xb7, 0x97, 0x44, 0x6c, 0xad, 0x92, 0x42, 0xf6, 0x35, 0xc3, 0xf4, 0x33,
+	0xe5, 0xff, 0x8f, 0x2f, 0xf1, 0xf7, 0xbe, 0x04, 0x4e, 0x02, 0x27, 0x81, 0x93, 0xc0, 0x49, 0xe0, 0x24, 0x70,
+	0x12, 0x38, 0x09, 0x9c, 0xc4, 0x1c, 0x4e, 0x82, 0xe8, 0x40, 0x74, 0x20, 0x3a, 0x10, 0x1d, 0xe6, 0x8b, 0x0e,
+	0xf4, 0x05, 0xfa, 0x02, 0x7d, 0x81, 0xbe, 0x40, 0x5f, 0xa0, 0x2f, 0xd0, 0x17, 0xe8, 0x0b, 0xe3, 0xf4, 0x85,
+	0x61, 0xf6, 0xc1, 0xa5, 0x72, 0x0a, 0x38, 0x05, 0x9c, 0x02, 0x4e, 0x01, 0xa7, 0x80, 0x53, 0xc0, 0x29, 0xe0,
+	0x14, 0x6c, 0xde, 0x29, 0x68, 0x3f, 0xbb, 0xfb, 0x54, 0x48, 0xbe, 0xe7, 0xe9, 0x99, 0x8d, 0x9e, 0xcc, 0x5b,
+	0xdb, 0x78, 0xd4, 0x38, 0xe7, 0x25, 0xc5, 0xf3, 0xde, 0xd6, 0x7d, 0x5c, 0x73, 0x29, 0x79, 0x4d, 0xc7, 0x7a,
+	0x3b, 0x45, 0x3a, 0x2c, 0xe5, 0x45, 0x3a, 0x20, 0x1d, 0x90, 0x0e, 0x48, 0x07, 0xa4, 0x03, 0xd2, 0x01, 0xe9,
+	0x80, 0x74, 0x40, 0x3a, 0x20, 0x1d, 0x90, 0x0e, 0x48, 0x07, 0xa4, 0x03, 0xd2, 0x01, 0xe9, 0x80, 0x74, 0x40,
+	0x3a, 0x20, 0x1d, 0x90, 0x0e, 0x48, 0x07, 0xa4, 0x03, 0xd2, 0x01, 0xe9, 0x80, 0x74, 0x40, 0x3a, 0x20, 0x1d,
+	0x90, 0x0e, 0x48, 0x07, 0xa4, 0x03, 0xd2, 0x01, 0xe9, 0x80, 0x74, 0xf0, 0x5b, 0x3a, 0x78, 0x0f, 0x00, 0xf3,
+	0x8f, 0xac, 0xd1, 0x78, 0xf9, 0x43, 0xa6, 0x14, 0xe6, 0xec, 0x9c, 0x51, 0x4f, 0xe3, 0x38, 0x10, 0xc7, 0x9f,
+	0x8f, 0x4f, 0x61, 0xfa, 0x80, 0x5a, 0x5d, 0x2f, 0x3d, 0xb8, 0x37, 0xb8, 0x3e, 0xdc, 0x2d, 0xb0, 0x62, 0xa5,
+	0x5d, 0xa1, 0xdd, 0x95, 0xf6, 0xa1, 0x42, 0x28, 0x4d, 0x9c, 0x60, 0x48, 0xed, 0xca, 0x4e, 0xa9, 0xd0, 0x8a,
+	0xef, 0xbe, 0x1a, 0x8f, 0x9d, 0x38, 0xd4, 0x40, 0x8b, 0x60, 0x17, 0xb4, 0xf3, 0x82, 0x48, 0xec, 0x4c, 0x1c,
+	0x7b, 0xec, 0x4c, 0xdc, 0xf9, 0xff, 0x5e, 0x3b, 0x5f, 0xa2, 0x05, 0x4a, 0x8c, 0x46, 0x10, 0xab, 0xb0, 0xd9,
+	0xa2, 0xaa, 0xc5, 0xbc, 0xe2, 0xec, 0x43, 0x7a, 0x9d, 0x7e, 0xc9, 0xb4, 0x98, 0xd7, 0xe1, 0x7c, 0x70, 0xa1,
+	0x90, 0x11, 0xb2, 0xac, 0x70, 0x2b, 0x4b, 0x49, 0xf6, 0xdf, 0xe9, 0xc9, 0xd0, 0x1a, 0x98, 0xdb, 0x50, 0xc6,
+	0x66, 0x11, 0x2f, 0xf9, 0x14, 0x56, 0x0d, 0x99, 0xa7, 0x3a, 0x37, 0x0c, 0x1a, 0xcc, 0x3e, 0xa9, 0x9c, 0x27,
+	0x97, 0x06, 0xaa, 0x27, 0x50, 0xdd, 0x5e, 0x72, 0x14, 0xda, 0x86, 0x01, 0x14, 0x39, 0xd7, 0x3c, 0xdf, 0xb7,
+	0x85, 0x7f, 0xb1, 0xff, 0xb5, 0x5a, 0x1a, 0xae, 0x8d, 0x3b, 0x74, 0x26, 0xdc, 0xd1, 0x11, 0x84, 0x21, 0x5a,
+	0x49, 0x77, 0x78, 0x9a, 0xea, 0x8c, 0x57, 0xee, 0xe0, 0x1b, 0x9f, 0xce, 0xd3, 0xec, 0xca, 0x29, 0xf6, 0x9c,
+	0x0c, 0xc0, 0x2d, 0x69, 0xf0, 0x62, 0xec, 0x35, 0x9b, 0xd5, 0x3d, 0x74, 0x9f, 0xd1, 0xc8, 0x17, 0x07, 0xd3,
+	0xc0, 0xd4, 0x66, 0xeb, 0x8f, 0x5b, 0xc6, 0x2b, 0xc3, 0x59, 0x60, 0x67, 0x29, 0x64, 0xae, 0x96, 0x71, 0x3b,
+	0x58, 0x96, 0x38, 0x5b, 0x63, 0x57, 0xf7, 0x20, 0x66, 0xc6, 0xf0, 0xaa, 0x88, 0x1b, 0x81, 0x92, 0xd6, 0x04,
+	0x1c, 0xb5, 0x06, 0xa2, 0x22, 0xc3, 0x2c, 0x95, 0x52, 0xd5, 0x0c, 0x27, 0x27, 0xf8, 0x57, 0x5f, 0x72, 0x61,
+	0x03, 0x30, 0xb4, 0x32, 0xf4, 0x6d, 0x96, 0x4a, 0xe3, 0x7d, 0x85, 0x61, 0xee, 0x9e, 0x51, 0x45, 0x65, 0xe2,
+	0x44, 0x88, 0x20, 0xd1, 0x71, 0xcd, 0xf5, 0x67, 0xa2, 0x2d, 0xbe, 0x73, 0xd5, 0xd8, 0xd7, 0x8e, 0x58, 0x2e,
+	0x0c, 0x18, 0xed, 0x5e, 0x30, 0x08, 0xd8, 0x25, 0x96, 0x19, 0xe2, 0xce, 0xf7, 0x7b, 0x85, 0xb1, 0xcd, 0x0b,
+	0x87, 0x11, 0x2a, 0x8c, 0xc7, 0x8d, 0x8a, 0x03, 0xac, 0x15, 0xa6, 0x91, 0x6f, 0xc0, 0x61, 0x18, 0x0b, 0x14,
+	0xc6, 0x6f, 0x3e, 0x76, 0x22, 0xa2, 0xb6, 0x31, 0x63, 0x56, 0x98, 0x83, 0x56, 0x6b, 0xfb, 0xcb, 0xc8, 0x2a,
+	0x6b, 0x51, 0x55, 0x7e, 0x06, 0x51, 0xe5, 0x4d, 0x01, 0x54, 0x36, 0xa0, 0xa7, 0x10, 0x3a, 0x85, 0xd0, 0x29,
+	0x84, 0x4e, 0x21, 0x74, 0x0a, 0xa1, 0x53, 0x08, 0x9d, 0xb2, 0x11, 0x3a, 0x25, 0x86, 0x4d, 0x21, 0x64, 0xca,
+	0x26, 0xc8, 0x14, 0x4f, 0x22, 0x79, 0x30, 0xf0, 0x93, 0x2a, 0xe7, 0xef, 0xb0, 0x5e, 0x10, 0x00, 0xe2, 0x95,
+	0x18, 0x1f, 0x74, 0x8d, 0xb5, 0x3d, 0xdf, 0x49, 0x0b, 0x9e, 0xfa, 0x17, 0x68, 0x6b, 0x2f, 0xd1, 0xb6, 0xc2,
+	0xb1, 0xa8, 0x2a, 0xfb, 0x56, 0x9c, 0x06, 0x2f, 0x3e, 0x1c, 0xef, 0x48, 0x63, 0x1f, 0xe4, 0x79, 0x6c, 0x84,
+	0x56, 0x59, 0x9b, 0xa9, 0xb2, 0xca, 0x53, 0x59, 0x3d, 0xef, 0x1f, 0x1b, 0x85, 0x85, 0xe1, 0x97, 0xef, 0xc4,
+	0xf0, 0x0c, 0xd5, 0x22, 0x90, 0xee, 0xeb, 0xfc, 0x33, 0xb9, 0xd0, 0xb5, 0x58, 0xf9, 0x70, 0x35, 0x3c, 0x73,
+	0xca, 0x0c, 0xf6, 0x27, 0x93, 0xad, 0xbe, 0xc3, 0x8a, 0xf6, 0xbb, 0x3d, 0xd3, 0x6d, 0x60, 0xc8, 0x4d, 0xb9,
+	0x67, 0x30, 0x23, 0x35, 0x83, 0xe1, 0x5c, 0xd4, 0xa2, 0xea, 0x0d, 0xc2, 0xd2, 0xd5, 0xfe, 0x5f, 0x97, 0xe4,
+	0xf2, 0x1c, 0x14, 0x97, 0x0e, 0x94, 0xe5, 0xf1, 0x27, 0x3a, 0xe4, 0x0f, 0x3d, 0x91, 0x2b, 0x8d, 0x3f, 0xd1,
+	0x3a, 0x98, 0x98, 0x27, 0x23, 0x62, 0xec, 0xa7, 0x74, 0x0e, 0x3b, 0x06, 0xbe, 0x8e, 0xb1, 0x9a, 0x8c, 0xf6,
+	0x13, 0x3d, 0x79, 0x69, 0x90, 0x0c, 0x41, 0x64, 0x08, 0x22, 0x43, 0xc0, 0x17, 0x02, 0xbe, 0x10, 0xf0, 0x85,
+	0x80, 0x2f, 0x04, 0x7c, 0x21, 0xe0, 0x0b, 0x01, 0x5f, 0x08, 0xf8, 0xf2, 0xd2, 0xc0, 0x97, 0x67, 0xc3, 0xad,
+	0x94, 0x8a, 0xd0, 0x28, 0x84, 0x46, 0x21, 0x34, 0x0a, 0xa1, 0x51, 0x08, 0x8d, 0xf2, 0x9a, 0xd1, 0x28, 0xbb,
+	0x1b, 0xb3, 0x51, 0x76, 0x09, 0x8e, 0x42, 0x70, 0x14, 0x82, 0xa3, 0x3c, 0x05, 0x8e, 0x02, 0x3e, 0x3b, 0x44,
+	0xdd, 0x9a, 0x1f, 0x1e, 0x9b, 0x28, 0x66, 0xd3, 0x81, 0x0d, 0xfc, 0xa9, 0x15, 0x2b, 0x84, 0xe6, 0x6c, 0x31,
+	0x87, 0x7f, 0x77, 0xd9, 0x4c, 0x54, 0x95, 0x30, 0x3c, 0x53, 0x32, 0x67, 0x3c, 0xd5, 0xd5, 0x0d, 0xe1, 0x55,
+	0x08, 0xaf, 0x42, 0x78, 0x15, 0xc2, 0xab, 0x10, 0x5e, 0x85, 0xf0, 0x2a, 0x84, 0x57, 0x21, 0xbc, 0x0a, 0xe1,
+	0x55, 0xde, 0x14, 0x5e, 0x85, 0xd8, 0x28, 0xc4, 0x46, 0x21, 0xb0, 0xc9, 0x6f, 0x0b, 0x36, 0x21, 0x2a, 0x09,
+	0x51, 0x49, 0x88, 0x4a, 0x42, 0x54, 0x12, 0xa2, 0x92, 0x10, 0x95, 0x84, 0xa8, 0x24, 0x44, 0x25, 0x21, 0x2a,
+	0x49, 0x48, 0x25, 0x21, 0x22, 0xc9, 0xfa, 0x44, 0x12, 0xa2, 0x91, 0x10, 0x8d, 0x84, 0x68, 0x24, 0x44, 0x23,
+	0x79, 0x84, 0x46, 0x42, 0x28, 0x0d, 0x42, 0x69, 0xac, 0xa0, 0x34, 0x50, 0x4a, 0xb3, 0x85, 0x49, 0xaf, 0xaa,
+	0x00, 0x2d, 0xe8, 0xa2, 0x5a, 0xe1, 0x10, 0xb0, 0x9d, 0x9d, 0x55, 0x0e, 0x41, 0xe4, 0x5c, 0x32, 0x4b, 0x21,
+	0x5d, 0x70, 0x3c, 0xf6, 0x76, 0xc2, 0x3a, 0x5e, 0xd4, 0x17, 0x39, 0x97, 0x5c, 0x73, 0x6d, 0x6c, 0x4e, 0x9f,
+	0x2d, 0xdc, 0xbe, 0xa7, 0x34, 0xe1, 0x0d, 0x95, 0x02, 0x3b, 0x13, 0xda, 0xee, 0x2b, 0x05, 0x0b, 0x15, 0xfb,
+	0x97, 0xfd, 0x13, 0x8c, 0x0c, 0x44, 0x7c, 0x1c, 0x5d, 0x69, 0x61, 0xd2, 0x92, 0xef, 0xb3, 0x52, 0x9d, 0x5f,
+	0x9a, 0x73, 0xc8, 0xa1, 0x3a, 0x87, 0xac, 0x3e, 0x36, 0x81, 0x7f, 0xd9, 0x54, 0xc8, 0x54, 0xdf, 0x9c, 0xb1,
+	0x49, 0xd3, 0xef, 0x67, 0xce, 0xbb, 0xfc, 0x3d, 0x6c, 0x5a, 0x17, 0x86, 0x9c, 0xd6, 0x23, 0x82, 0x71, 0x07,
+	0x7f, 0x7d, 0xaf, 0xb0, 0xe3, 0x4b, 0xe5, 0x85, 0x38, 0x9d, 0xc6, 0x19, 0x1b, 0xd8, 0xef, 0xf9, 0x2a, 0x28,
+	0xca, 0x71, 0x8b, 0x59, 0x6a, 0x8c, 0x28, 0x65, 0xff, 0x3b, 0xfb, 0xfa, 0xf1, 0xf4, 0xf0, 0xe4, 0xf3, 0x7e,
+	0x2b, 0x81, 0x52, 0xa6, 0x37, 0x48, 0xea, 0xd9, 0x1c, 0x55, 0x81, 0xb7, 0xc3, 0xc6, 0x26, 0xac, 0x7a, 0xde,
+	0x14, 0xaa, 0x79, 0xc2, 0x66, 0x42, 0x49, 0x18, 0x56, 0x62, 0xb0, 0x58, 0x0b, 0x2b, 0x89, 0x86, 0x79, 0x91,
+	0xe6, 0xc7, 0xa2, 0xc2, 0xd4, 0xac, 0xb0, 0x95, 0x93, 0xbd, 0x33, 0xf8, 0xd9, 0x42, 0x75, 0x52, 0x39, 0x07,
+	0x49, 0x7d, 0xc1, 0x65, 0xbf, 0xef, 0x36, 0xc0, 0x9a, 0x35, 0xcc, 0x5f, 0xa8, 0x9c, 0x2a, 0xa8, 0x37, 0x0c,
+	0x15, 0x45, 0x10, 0x1d, 0x78, 0x30, 0x09, 0x94, 0x1a, 0x26, 0x0a, 0x26, 0xef, 0xa4, 0x5b, 0x32, 0x61, 0x98,
+	0x73, 0xe9, 0xae, 0x02, 0xc9, 0xfe, 0x72, 0x0c, 0xa2, 0x86, 0xed, 0x52, 0xdd, 0x99, 0xb0, 0x10, 0x4d, 0xe4,
+	0xa0, 0x01, 0x56, 0xd9, 0xd5, 0xd0, 0x66, 0x6a, 0x62, 0xe4, 0x21, 0xa4, 0x65, 0x48, 0x28, 0x8d, 0x59, 0xa0,
+	0x36, 0x4b, 0xb2, 0xd6, 0x69, 0xc6, 0x31, 0xd0, 0x8a, 0x4d, 0x1f, 0x9c, 0x7e, 0x7f, 0xfb, 0x09, 0x16, 0x99,
+	0x43, 0xb7, 0xc1, 0x0b, 0xc0, 0xcd, 0xa7, 0xd2, 0x86, 0xed, 0xae, 0x37, 0x92, 0xe6, 0xdb, 0xc0, 0xba, 0xc5,
+	0x20, 0xb1, 0xdb, 0x7d, 0x7d, 0xdc, 0xee, 0xf3, 0x1d, 0xd5, 0x75, 0xc3, 0x66, 0x9f, 0x2f, 0xe6, 0x57, 0x83,
+	0x83, 0x06, 0x71, 0xb3, 0xf5, 0x63, 0x00, 0x72, 0x6b, 0x79, 0x78,
 }
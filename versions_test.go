@@ -32,3 +32,16 @@ func TestCurrent(t *testing.T) {
 		t.Fatal("mismatch between current and runtime current")
 	}
 }
+
+func BenchmarkReadContents(b *testing.B) {
+	var versions []string
+	for version := range tagToShaMap {
+		versions = append(versions, version)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := readContents(versions[i%len(versions)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package wasmexec
+
+import (
+	"io"
+	"os"
+
+	"github.com/mlctrez/wasmexec/wasmbin"
+)
+
+// WasmGoVersion returns the version of the Go toolchain that built the wasm binary in reader.
+func WasmGoVersion(reader io.ReaderAt) (version string, err error) {
+	var module *wasmbin.Module
+	if module, err = wasmbin.Open(reader); err != nil {
+		return "", err
+	}
+	return module.GoVersion()
+}
+
+// VersionForWasm returns the wasm_exec.js matching the Go toolchain that built the wasm binary in reader.
+func VersionForWasm(reader io.ReaderAt) (contents []byte, err error) {
+	var version string
+	if version, err = WasmGoVersion(reader); err != nil {
+		return nil, err
+	}
+	return Version(version)
+}
+
+// ForWasmFile returns the wasm_exec.js matching the Go toolchain that built the wasm binary at path.
+func ForWasmFile(path string) (contents []byte, err error) {
+	var file *os.File
+	if file, err = os.Open(path); err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+	return VersionForWasm(file)
+}
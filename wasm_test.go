@@ -0,0 +1,34 @@
+package wasmexec
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mlctrez/wasmexec/internal/wasmtest"
+)
+
+// producersModule returns a minimal wasm module with a producers section naming version.
+func producersModule(version string) []byte {
+	return wasmtest.Module(wasmtest.Producers(version))
+}
+
+func TestForWasmFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.wasm")
+	if err := os.WriteFile(path, producersModule("go1.21.0"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := ForWasmFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shaString(content) != TagToSha("go1.21.0") {
+		t.Fatal("expected go1.21.0 content")
+	}
+
+	if _, err = VersionForWasm(bytes.NewReader(producersModule("go0.1"))); err == nil {
+		t.Fatal("expected error for unknown version")
+	}
+}
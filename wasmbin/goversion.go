@@ -0,0 +1,191 @@
+package wasmbin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// ErrNoGoVersion is returned when a module does not contain a Go build version.
+var ErrNoGoVersion = errors.New("wasmbin: no go version found")
+
+var buildInfoMagic = []byte("\xff Go buildinf:")
+
+const buildInfoHeaderSize = 32
+
+// buildInfoFlagsEndian and buildInfoFlagsVersionInline are the flags in the buildinfo header,
+// see debug/buildinfo in the standard library.
+const buildInfoFlagsEndian = 0x1
+const buildInfoFlagsVersionInline = 0x2
+
+var goVersionPattern = regexp.MustCompile(`go1\.[0-9]+(\.[0-9]+)?((beta|rc)[0-9]+)?`)
+
+// GoVersion returns the version of the Go toolchain that built the module.
+//
+// The version is taken from the Go buildinfo blob in the data segments, then from the
+// producers custom section, and finally from a runtime.buildVersion string in the data segments.
+func (m *Module) GoVersion() (version string, err error) {
+	var segments []DataSegment
+	if segments, err = m.DataSegments(); err != nil {
+		return "", err
+	}
+
+	if version = buildInfoVersion(segments); version != "" {
+		return version, nil
+	}
+
+	if version, err = m.producersVersion(); err != nil || version != "" {
+		return version, err
+	}
+
+	if version = buildVersionString(segments); version != "" {
+		return version, nil
+	}
+
+	return "", ErrNoGoVersion
+}
+
+func buildInfoVersion(segments []DataSegment) string {
+	for _, segment := range segments {
+		index := bytes.Index(segment.Data, buildInfoMagic)
+		if index < 0 || segment.Passive {
+			continue
+		}
+		header, err := readMemory(segments, segment.Offset+uint64(index), buildInfoHeaderSize)
+		if err != nil {
+			continue
+		}
+
+		ptrSize := int(header[14])
+		flags := header[15]
+
+		if flags&buildInfoFlagsVersionInline != 0 {
+			if index+buildInfoHeaderSize > len(segment.Data) {
+				continue
+			}
+			data := segment.Data[index+buildInfoHeaderSize:]
+			length, n := binary.Uvarint(data)
+			if n <= 0 || length > uint64(len(data)-n) {
+				continue
+			}
+			return string(data[n : n+int(length)])
+		}
+
+		if ptrSize != 4 && ptrSize != 8 {
+			continue
+		}
+		var order binary.ByteOrder = binary.LittleEndian
+		if flags&buildInfoFlagsEndian != 0 {
+			order = binary.BigEndian
+		}
+		readPointer := func(address uint64) (uint64, error) {
+			data, err := readMemory(segments, address, ptrSize)
+			if err != nil {
+				return 0, err
+			}
+			if ptrSize == 4 {
+				return uint64(order.Uint32(data)), nil
+			}
+			return order.Uint64(data), nil
+		}
+
+		// the header holds a pointer to the version string header, which holds a pointer and length
+		stringHeader, err := readPointer(segment.Offset + uint64(index) + 16)
+		if err != nil {
+			continue
+		}
+		pointer, err := readPointer(stringHeader)
+		if err != nil {
+			continue
+		}
+		length, err := readPointer(stringHeader + uint64(ptrSize))
+		if err != nil || length > 256 {
+			continue
+		}
+		version, err := readMemory(segments, pointer, int(length))
+		if err != nil {
+			continue
+		}
+		return string(version)
+	}
+	return ""
+}
+
+// readMemory reads length bytes of linear memory at address from the active data segments.
+// The linker omits runs of zeros between segments, so gaps read as zero.
+func readMemory(segments []DataSegment, address uint64, length int) (data []byte, err error) {
+	data = make([]byte, length)
+	end := address + uint64(length)
+	found := false
+	for _, segment := range segments {
+		segmentEnd := segment.Offset + uint64(len(segment.Data))
+		if segment.Passive || segment.Offset >= end || segmentEnd <= address {
+			continue
+		}
+		found = true
+		if segment.Offset >= address {
+			copy(data[segment.Offset-address:], segment.Data)
+		} else {
+			copy(data, segment.Data[address-segment.Offset:])
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("address 0x%x is not in a data segment", address)
+	}
+	return data, nil
+}
+
+// producersVersion returns the Go language version from the producers custom section.
+func (m *Module) producersVersion() (version string, err error) {
+	section, ok := m.CustomSection("producers")
+	if !ok {
+		return "", nil
+	}
+
+	var content []byte
+	if content, err = m.Content(section); err != nil {
+		return "", err
+	}
+
+	d := &decoder{data: content}
+	// skip the custom section name
+	d.name()
+	fields := d.u32()
+	for i := uint32(0); i < fields && d.err == nil; i++ {
+		field := d.name()
+		values := d.u32()
+		for j := uint32(0); j < values && d.err == nil; j++ {
+			name, value := d.name(), d.name()
+			if field == "language" && name == "Go" && d.err == nil {
+				return value, nil
+			}
+		}
+	}
+	if d.err != nil {
+		return "", fmt.Errorf("%w: producers section: %v", ErrMalformed, d.err)
+	}
+	return "", nil
+}
+
+// buildVersionString finds a version string stored on its own in the data segments,
+// as runtime.buildVersion is.
+func buildVersionString(segments []DataSegment) string {
+	for _, segment := range segments {
+		for _, match := range goVersionPattern.FindAllIndex(segment.Data, -1) {
+			if match[0] > 0 && isPrintable(segment.Data[match[0]-1]) {
+				continue
+			}
+			if match[1] < len(segment.Data) && isPrintable(segment.Data[match[1]]) {
+				continue
+			}
+			return string(segment.Data[match[0]:match[1]])
+		}
+	}
+	return ""
+}
+
+func isPrintable(b byte) bool {
+	return b >= 0x20 && b < 0x7f
+}
@@ -0,0 +1,103 @@
+package wasmbin
+
+import "fmt"
+
+// External kinds of imports and exports.
+const (
+	KindFunction byte = 0
+	KindTable    byte = 1
+	KindMemory   byte = 2
+	KindGlobal   byte = 3
+	KindTag      byte = 4
+)
+
+// Import is one entry of the import section.
+type Import struct {
+	Module string
+	Name   string
+	Kind   byte
+}
+
+func (i Import) String() string {
+	return fmt.Sprintf("%s.%s", i.Module, i.Name)
+}
+
+// Export is one entry of the export section.
+type Export struct {
+	Name  string
+	Kind  byte
+	Index uint32
+}
+
+// Imports returns the entries of the import section.
+func (m *Module) Imports() (imports []Import, err error) {
+	section, ok := m.Section(SectionImport)
+	if !ok {
+		return nil, nil
+	}
+
+	var content []byte
+	if content, err = m.Content(section); err != nil {
+		return nil, err
+	}
+
+	d := &decoder{data: content}
+	count := d.u32()
+	for i := uint32(0); i < count && d.err == nil; i++ {
+		imp := Import{Module: d.name(), Name: d.name(), Kind: d.byte()}
+		switch imp.Kind {
+		case KindFunction:
+			d.u32()
+		case KindTable:
+			d.byte()
+			d.limits()
+		case KindMemory:
+			d.limits()
+		case KindGlobal:
+			d.byte()
+			d.byte()
+		case KindTag:
+			d.byte()
+			d.u32()
+		default:
+			d.fail(fmt.Errorf("import kind 0x%02x", imp.Kind))
+		}
+		imports = append(imports, imp)
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("%w: import section: %v", ErrMalformed, d.err)
+	}
+	return imports, nil
+}
+
+// Exports returns the entries of the export section.
+func (m *Module) Exports() (exports []Export, err error) {
+	section, ok := m.Section(SectionExport)
+	if !ok {
+		return nil, nil
+	}
+
+	var content []byte
+	if content, err = m.Content(section); err != nil {
+		return nil, err
+	}
+
+	d := &decoder{data: content}
+	count := d.u32()
+	for i := uint32(0); i < count && d.err == nil; i++ {
+		exports = append(exports, Export{Name: d.name(), Kind: d.byte(), Index: d.u32()})
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("%w: export section: %v", ErrMalformed, d.err)
+	}
+	return exports, nil
+}
+
+// limits reads the limits of a table or memory type.
+func (d *decoder) limits() {
+	flags := d.u32()
+	d.u64()
+	if flags&0x1 != 0 {
+		d.u64()
+	}
+}
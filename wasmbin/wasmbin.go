@@ -0,0 +1,329 @@
+// Package wasmbin reads the sections of a WebAssembly binary module.
+package wasmbin
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+var (
+	// ErrNotWasm is returned when the input does not start with the wasm binary magic and version.
+	ErrNotWasm = errors.New("wasmbin: not a wasm binary module")
+	// ErrMalformed is returned when a section of the module cannot be decoded.
+	ErrMalformed = errors.New("wasmbin: malformed module")
+)
+
+// Section ids defined by the WebAssembly binary format.
+const (
+	SectionCustom    byte = 0
+	SectionType      byte = 1
+	SectionImport    byte = 2
+	SectionFunction  byte = 3
+	SectionTable     byte = 4
+	SectionMemory    byte = 5
+	SectionGlobal    byte = 6
+	SectionExport    byte = 7
+	SectionStart     byte = 8
+	SectionElement   byte = 9
+	SectionCode      byte = 10
+	SectionData      byte = 11
+	SectionDataCount byte = 12
+)
+
+var wasmHeader = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+// Section locates the content of one module section.
+// The content of a custom section starts with its name.
+type Section struct {
+	ID byte
+	// Name is only set for custom sections.
+	Name   string
+	Offset int64
+	Size   int64
+}
+
+// Module is a wasm binary module opened for reading.
+type Module struct {
+	reader   io.ReaderAt
+	Sections []Section
+}
+
+// DataSegment is one segment of the data section.
+type DataSegment struct {
+	Memory  uint32
+	Passive bool
+	// Offset is the memory address of an active segment.
+	Offset uint64
+	Data   []byte
+}
+
+// Open reads the header and section table of the module in reader.
+// Section contents are read when requested.
+func Open(reader io.ReaderAt) (m *Module, err error) {
+	counter := &countingReader{reader: bufio.NewReader(io.NewSectionReader(reader, 0, math.MaxInt64))}
+
+	header := make([]byte, len(wasmHeader))
+	if _, err = io.ReadFull(counter, header); err != nil || !bytes.Equal(header, wasmHeader) {
+		return nil, ErrNotWasm
+	}
+
+	m = &Module{reader: reader}
+	for {
+		var id byte
+		if id, err = counter.ReadByte(); err == io.EOF {
+			return m, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		var size uint32
+		if size, err = readU32(counter); err != nil {
+			return nil, fmt.Errorf("%w: section %d size: %v", ErrMalformed, id, err)
+		}
+
+		section := Section{ID: id, Offset: counter.count, Size: int64(size)}
+		if id == SectionCustom {
+			var name []byte
+			if name, err = readName(counter); err != nil {
+				return nil, fmt.Errorf("%w: custom section name: %v", ErrMalformed, err)
+			}
+			section.Name = string(name)
+			if counter.count > section.Offset+section.Size {
+				return nil, fmt.Errorf("%w: custom section name exceeds section size", ErrMalformed)
+			}
+		}
+
+		if _, err = io.CopyN(io.Discard, counter, section.Offset+section.Size-counter.count); err != nil {
+			return nil, fmt.Errorf("%w: section %d exceeds module length", ErrMalformed, id)
+		}
+		m.Sections = append(m.Sections, section)
+	}
+}
+
+// Section returns the first section with id.
+func (m *Module) Section(id byte) (section Section, ok bool) {
+	for _, section = range m.Sections {
+		if section.ID == id {
+			return section, true
+		}
+	}
+	return Section{}, false
+}
+
+// CustomSection returns the first custom section with name.
+func (m *Module) CustomSection(name string) (section Section, ok bool) {
+	for _, section = range m.Sections {
+		if section.ID == SectionCustom && section.Name == name {
+			return section, true
+		}
+	}
+	return Section{}, false
+}
+
+// Content returns the raw content of section.
+func (m *Module) Content(section Section) (content []byte, err error) {
+	if content, err = io.ReadAll(io.NewSectionReader(m.reader, section.Offset, section.Size)); err != nil {
+		return nil, err
+	}
+	if int64(len(content)) != section.Size {
+		return nil, fmt.Errorf("%w: section %d exceeds module length", ErrMalformed, section.ID)
+	}
+	return content, nil
+}
+
+// DataSegments returns the segments of the data section.
+func (m *Module) DataSegments() (segments []DataSegment, err error) {
+	section, ok := m.Section(SectionData)
+	if !ok {
+		return nil, nil
+	}
+
+	var content []byte
+	if content, err = m.Content(section); err != nil {
+		return nil, err
+	}
+
+	d := &decoder{data: content}
+	count := d.u32()
+	for i := uint32(0); i < count && d.err == nil; i++ {
+		var segment DataSegment
+		switch flags := d.u32(); flags {
+		case 0:
+			segment.Offset = d.constExpr()
+		case 1:
+			segment.Passive = true
+		case 2:
+			segment.Memory = d.u32()
+			segment.Offset = d.constExpr()
+		default:
+			d.fail(fmt.Errorf("data segment flags %d", flags))
+		}
+		segment.Data = d.bytes(d.u32())
+		segments = append(segments, segment)
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("%w: data section: %v", ErrMalformed, d.err)
+	}
+	return segments, nil
+}
+
+type countingReader struct {
+	reader *bufio.Reader
+	count  int64
+}
+
+func (c *countingReader) Read(p []byte) (n int, err error) {
+	n, err = c.reader.Read(p)
+	c.count += int64(n)
+	return n, err
+}
+
+func (c *countingReader) ReadByte() (b byte, err error) {
+	if b, err = c.reader.ReadByte(); err == nil {
+		c.count++
+	}
+	return b, err
+}
+
+func readU32(reader io.ByteReader) (value uint32, err error) {
+	var shift uint
+	for {
+		var b byte
+		if b, err = reader.ReadByte(); err != nil {
+			return 0, err
+		}
+		if shift == 28 && b > 0x0f {
+			return 0, errors.New("u32 overflow")
+		}
+		value |= uint32(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return value, nil
+		}
+		shift += 7
+	}
+}
+
+func readName(counter *countingReader) (name []byte, err error) {
+	var length uint32
+	if length, err = readU32(counter); err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if _, err = io.CopyN(buf, counter, int64(length)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decoder reads values from section content, keeping the first error.
+type decoder struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (d *decoder) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+func (d *decoder) ReadByte() (byte, error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	if d.pos >= len(d.data) {
+		d.fail(io.ErrUnexpectedEOF)
+		return 0, d.err
+	}
+	d.pos++
+	return d.data[d.pos-1], nil
+}
+
+func (d *decoder) byte() byte {
+	b, _ := d.ReadByte()
+	return b
+}
+
+func (d *decoder) u32() uint32 {
+	value, err := readU32(d)
+	d.fail(err)
+	return value
+}
+
+func (d *decoder) u64() (value uint64) {
+	var shift uint
+	for {
+		b := d.byte()
+		if d.err != nil {
+			return 0
+		}
+		value |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return value
+		}
+		shift += 7
+		if shift >= 70 {
+			d.fail(errors.New("u64 overflow"))
+			return 0
+		}
+	}
+}
+
+func (d *decoder) s64() (value int64) {
+	var shift uint
+	for {
+		b := d.byte()
+		if d.err != nil {
+			return 0
+		}
+		value |= int64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			if shift < 64 && b&0x40 != 0 {
+				value |= -1 << shift
+			}
+			return value
+		}
+		if shift >= 70 {
+			d.fail(errors.New("s64 overflow"))
+			return 0
+		}
+	}
+}
+
+func (d *decoder) bytes(length uint32) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if uint64(length) > uint64(len(d.data)-d.pos) {
+		d.fail(io.ErrUnexpectedEOF)
+		return nil
+	}
+	d.pos += int(length)
+	return d.data[d.pos-int(length) : d.pos]
+}
+
+func (d *decoder) name() string {
+	return string(d.bytes(d.u32()))
+}
+
+// constExpr reads an i32.const or i64.const offset expression.
+func (d *decoder) constExpr() (value uint64) {
+	switch opcode := d.byte(); opcode {
+	case 0x41:
+		value = uint64(uint32(d.s64()))
+	case 0x42:
+		value = uint64(d.s64())
+	default:
+		d.fail(fmt.Errorf("unsupported offset expression opcode 0x%02x", opcode))
+	}
+	if end := d.byte(); end != 0x0b && d.err == nil {
+		d.fail(fmt.Errorf("offset expression end 0x%02x", end))
+	}
+	return value
+}
@@ -0,0 +1,139 @@
+package wasmbin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/mlctrez/wasmexec/internal/wasmtest"
+)
+
+func module(sections ...[]byte) *bytes.Reader {
+	return bytes.NewReader(wasmtest.Module(sections...))
+}
+
+// dataSection builds a data section with one active segment per address in segments.
+func dataSection(segments map[uint32][]byte) []byte {
+	content := [][]byte{wasmtest.U32(uint32(len(segments)))}
+	for address, data := range segments {
+		content = append(content, []byte{0x00, 0x41}, wasmtest.U32(address), []byte{0x0b}, wasmtest.U32(uint32(len(data))), data)
+	}
+	return wasmtest.Section(SectionData, content...)
+}
+
+func le64(value uint64) []byte {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, value)
+	return data
+}
+
+func TestOpen(t *testing.T) {
+	m, err := Open(module(wasmtest.Section(SectionType, wasmtest.U32(0)), wasmtest.Producers("go1.21.0"), wasmtest.Section(SectionData, wasmtest.U32(0))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Sections) != 3 {
+		t.Fatalf("expected 3 sections, got %d", len(m.Sections))
+	}
+	if _, ok := m.CustomSection("producers"); !ok {
+		t.Fatal("producers section not found")
+	}
+
+	if _, err = Open(bytes.NewReader([]byte("not wasm"))); !errors.Is(err, ErrNotWasm) {
+		t.Fatalf("expected %v, got %v", ErrNotWasm, err)
+	}
+	truncated := append(append([]byte{}, wasmHeader...), SectionType, 10, 0)
+	if _, err = Open(bytes.NewReader(truncated)); !errors.Is(err, ErrMalformed) {
+		t.Fatalf("expected %v, got %v", ErrMalformed, err)
+	}
+}
+
+func TestGoVersion(t *testing.T) {
+	inline := append([]byte{}, buildInfoMagic...)
+	inline = append(inline, 8, buildInfoFlagsVersionInline)
+	inline = append(inline, make([]byte, buildInfoHeaderSize-len(inline))...)
+	inline = append(inline, byte(len("go1.20.3")))
+	inline = append(inline, "go1.20.3"...)
+
+	// pointer format: header at 0x1000 points to a string header at 0x2000,
+	// which points to the version bytes at 0x3000
+	pointers := append([]byte{}, buildInfoMagic...)
+	pointers = append(pointers, 8, 0)
+	pointers = append(pointers, le64(0x2000)...)
+	stringHeader := append(le64(0x3000), le64(uint64(len("go1.16.5")))...)
+
+	tests := []struct {
+		name   string
+		module *bytes.Reader
+		want   string
+	}{
+		{"inline buildinfo", module(dataSection(map[uint32][]byte{0x1000: inline})), "go1.20.3"},
+		{"pointer buildinfo", module(dataSection(map[uint32][]byte{
+			0x1000: pointers, 0x2000: stringHeader, 0x3000: []byte("go1.16.5"),
+		})), "go1.16.5"},
+		{"producers", module(wasmtest.Producers("go1.21.0"), dataSection(map[uint32][]byte{})), "go1.21.0"},
+		{"build version", module(dataSection(map[uint32][]byte{
+			0x1000: []byte("needs go1.11 or later\x00\x00go1.12.17\x00data"),
+		})), "go1.12.17"},
+	}
+	for _, test := range tests {
+		m, err := Open(test.module)
+		if err != nil {
+			t.Fatal(test.name, err)
+		}
+		var version string
+		if version, err = m.GoVersion(); err != nil {
+			t.Fatal(test.name, err)
+		}
+		if version != test.want {
+			t.Errorf("%s: expected %q, got %q", test.name, test.want, version)
+		}
+	}
+
+	m, err := Open(module(dataSection(map[uint32][]byte{0x1000: []byte("no version here")})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.GoVersion(); !errors.Is(err, ErrNoGoVersion) {
+		t.Fatalf("expected %v, got %v", ErrNoGoVersion, err)
+	}
+}
+
+func TestImportsExports(t *testing.T) {
+	imports := wasmtest.Section(SectionImport, wasmtest.U32(3),
+		wasmtest.Name("gojs"), wasmtest.Name("runtime.wasmExit"), []byte{KindFunction}, wasmtest.U32(0),
+		wasmtest.Name("env"), wasmtest.Name("memory"), []byte{KindMemory, 0x01}, wasmtest.U32(1), wasmtest.U32(65536),
+		wasmtest.Name("env"), wasmtest.Name("sp"), []byte{KindGlobal, 0x7f, 0x01},
+	)
+	exports := wasmtest.Section(SectionExport, wasmtest.U32(2),
+		wasmtest.Name("run"), []byte{KindFunction}, wasmtest.U32(1),
+		wasmtest.Name("mem"), []byte{KindMemory}, wasmtest.U32(0),
+	)
+	m, err := Open(module(imports, exports))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotImports []Import
+	if gotImports, err = m.Imports(); err != nil {
+		t.Fatal(err)
+	}
+	want := []Import{{"gojs", "runtime.wasmExit", KindFunction}, {"env", "memory", KindMemory}, {"env", "sp", KindGlobal}}
+	if len(gotImports) != len(want) {
+		t.Fatalf("expected %v, got %v", want, gotImports)
+	}
+	for i := range want {
+		if gotImports[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, gotImports)
+		}
+	}
+
+	var gotExports []Export
+	if gotExports, err = m.Exports(); err != nil {
+		t.Fatal(err)
+	}
+	if len(gotExports) != 2 || gotExports[0] != (Export{"run", KindFunction, 1}) || gotExports[1] != (Export{"mem", KindMemory, 0}) {
+		t.Fatalf("unexpected exports %v", gotExports)
+	}
+}